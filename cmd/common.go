@@ -1,12 +1,24 @@
 package cmd
 
 import (
+	"flag"
+	"fmt"
+	"io"
 	"os"
+	"strings"
 
+	"gopkg.in/yaml.v3"
+
+	"github.com/hedon954/go-matcher/internal/config"
 	"github.com/hedon954/go-matcher/internal/log"
 	"github.com/hedon954/go-matcher/pkg/safe"
 )
 
+const (
+	serverEnvPrefix = "GOMATCHER_SERVER_"
+	matchEnvPrefix  = "GOMATCHER_MATCH_"
+)
+
 func init() {
 	startSafe()
 }
@@ -31,3 +43,151 @@ func GetConfigPath(envKey, defaultPath string) string {
 	}
 	return defaultPath
 }
+
+// Configs holds the effective configs of the server.
+type Configs struct {
+	Server *config.LayeredLoader[config.ServerConfig]
+	Match  *config.LayeredLoader[config.MatchConfig]
+
+	// PrintOnly means the "config" subcommand is given,
+	// the effective configs should be printed instead of starting the server.
+	PrintOnly bool
+}
+
+// overrides is the repeatable -set flag.
+type overrides []string
+
+func (o *overrides) String() string { return strings.Join(*o, ",") }
+
+func (o *overrides) Set(s string) error {
+	*o = append(*o, s)
+	return nil
+}
+
+// LoadConfigs loads the configs from the layers, the later ones take precedence:
+//  1. the defaults
+//  2. the server config file, then the match config file and the remote source (etcd or nacos) configured by the server config
+//  3. the GOMATCHER_SERVER_* and GOMATCHER_MATCH_* environment variables, e.g. GOMATCHER_SERVER_HTTP_PORT=5051
+//  4. the -set flags, e.g. -set server.http_port=5051 -set match.glicko2.905.match_timeout_sec=60
+//
+// The args are the command line arguments without the program name,
+// starting with the "config" subcommand to print the effective configs.
+func LoadConfigs(name string, args []string) *Configs {
+	var res Configs
+	if len(args) > 0 && args[0] == "config" {
+		res.PrintOnly = true
+		args = args[1:]
+	}
+
+	var sets overrides
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	serverConfigPath := fs.String("server-config",
+		GetConfigPath("SERVER_CONFIG_PATH", "cmd/server_conf_tmp.yml"),
+		"the server config file, empty means no file")
+	fs.Var(&sets, "set", "override a config value, e.g. server.http_port=5051, repeatable")
+	_ = fs.Parse(args)
+
+	serverOverrides, matchOverrides, err := splitOverrides(sets)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	var serverSources []config.Configer[config.ServerConfig]
+	if *serverConfigPath != "" {
+		serverSources = append(serverSources, config.NewFileLoader[config.ServerConfig](*serverConfigPath, config.AsLayer()))
+	}
+	res.Server = config.NewLayeredLoader("server", config.DefaultServerConfig(), serverSources,
+		append(config.EnvOverrides(serverEnvPrefix), serverOverrides...)...)
+	res.Match = config.NewLayeredLoader("match", config.DefaultMatchConfig(), newMatchConfigSources(res.Server.Get()),
+		append(config.EnvOverrides(matchEnvPrefix), matchOverrides...)...)
+	return &res
+}
+
+// splitOverrides splits the -set flags into the overrides of the server config and the match config.
+func splitOverrides(sets []string) (server, match []config.Override, err error) {
+	for _, s := range sets {
+		o, err := config.ParseOverride(s)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(o.Path) < 2 {
+			return nil, nil, fmt.Errorf("invalid override %q, should start with server. or match.", s)
+		}
+		switch o.Path[0] {
+		case "server":
+			server = append(server, config.Override{Path: o.Path[1:], Value: o.Value})
+		case "match":
+			match = append(match, config.Override{Path: o.Path[1:], Value: o.Value})
+		default:
+			return nil, nil, fmt.Errorf("invalid override %q, should start with server. or match.", s)
+		}
+	}
+	return server, match, nil
+}
+
+// newMatchConfigSources returns the sources of the match config configured by the server config,
+// the watched file and the remote source are both optional.
+func newMatchConfigSources(sc *config.ServerConfig) []config.Configer[config.MatchConfig] {
+	var sources []config.Configer[config.MatchConfig]
+	if sc.MatchConfigPath != "" {
+		sources = append(sources, config.NewFileLoader[config.MatchConfig](sc.MatchConfigPath,
+			config.WithFileWatch(), config.AsLayer()))
+	}
+	switch {
+	case sc.EtcdServer != nil:
+		sources = append(sources, config.NewEtcdLoader("/go-matcher/match_config", sc.EtcdServer, config.AsLayer()))
+	case len(sc.NacosServers) > 0:
+		sources = append(sources,
+			config.NewNacosLoader(sc.NacosNamespaceID, "GO-MATCHER", "match_config", sc.NacosServers, config.AsLayer()))
+	}
+	return sources
+}
+
+// Print prints the effective configs as yaml, the secrets such as the passwords and the tokens are masked.
+func (c *Configs) Print(w io.Writer) error {
+	m := map[string]any{
+		"server": c.Server.Get(),
+		"match":  c.Match.Get(),
+	}
+	bs, err := yaml.Marshal(m)
+	if err != nil {
+		return err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(bs, &node); err != nil {
+		return err
+	}
+	maskPasswords(&node)
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	defer func() { _ = enc.Close() }()
+	return enc.Encode(&node)
+}
+
+// maskedSuffixes are the suffixes of the keys of the secrets masked when printing the configs,
+// e.g. password, admin_token and share_token.secret.
+var maskedSuffixes = []string{"password", "token", "secret"}
+
+func isMaskedKey(k string) bool {
+	k = strings.ToLower(k)
+	for _, s := range maskedSuffixes {
+		if strings.HasSuffix(k, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func maskPasswords(n *yaml.Node) {
+	if n.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if k, v := n.Content[i], n.Content[i+1]; isMaskedKey(k.Value) && v.Kind == yaml.ScalarNode && v.Value != "" {
+				v.Value = "******"
+			}
+		}
+	}
+	for _, c := range n.Content {
+		maskPasswords(c)
+	}
+}
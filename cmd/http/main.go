@@ -1,22 +1,23 @@
 package main
 
 import (
+	"os"
+
 	"github.com/hedon954/go-matcher/cmd"
 	"github.com/hedon954/go-matcher/internal/api/apihttp"
-	"github.com/hedon954/go-matcher/internal/config"
 )
 
 func main() {
 	defer cmd.StopSafe()
-	sc := config.NewFileLoader[config.ServerConfig](
-		cmd.GetConfigPath("SERVER_CONFIG_PATH", "cmd/server_conf_tmp.yml"))
-	mc := config.NewNacosLoader(
-		sc.Get().NacosNamespaceID,
-		"GO-MATCHER",
-		"match_config",
-		sc.Get().NacosServers)
+	configs := cmd.LoadConfigs("http", os.Args[1:])
+	if configs.PrintOnly {
+		if err := configs.Print(os.Stdout); err != nil {
+			panic(err)
+		}
+		return
+	}
 
-	infra := apihttp.NewInfra(sc, mc)
+	infra := apihttp.NewInfra(configs.Server, configs.Match)
 	defer infra.Stop()
 	infra.Start()
 }
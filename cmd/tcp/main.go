@@ -7,21 +7,22 @@ import (
 
 	"github.com/hedon954/go-matcher/cmd"
 	"github.com/hedon954/go-matcher/internal/api/apitcp"
-	"github.com/hedon954/go-matcher/internal/config"
 	"github.com/hedon954/go-matcher/pkg/zinx/zconfig"
 )
 
 func main() {
 	defer cmd.StopSafe()
-	mc := config.NewFileLoader[config.ServerConfig]("cmd/server_conf_tmp.yml")
+	configs := cmd.LoadConfigs("tcp", os.Args[1:])
+	if configs.PrintOnly {
+		if err := configs.Print(os.Stdout); err != nil {
+			panic(err)
+		}
+		return
+	}
+
 	_, _, shutdown := apitcp.SetupTCPServer(
-		mc,
-		config.NewNacosLoader(
-			mc.Get().NacosNamespaceID,
-			"GO-MATCHER",
-			"match_config",
-			mc.Get().NacosServers,
-		),
+		configs.Server,
+		configs.Match,
 		zconfig.Load("cmd/zinx_conf_tmp.yml"),
 	)
 	defer shutdown()
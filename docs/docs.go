@@ -15,9 +15,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/match/accept_invite": {
+        "/admin/drain": {
             "post": {
-                "description": "accept an invitation based on the request",
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "stop accepting new groups to all the queues, the groups already matching keep matching until the timeout,\nthen the rest are canceled. The queues keep draining until undrained.",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,9 +30,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "accept an invitation",
+                "summary": "drain all the match queues for maintenance",
                 "parameters": [
                     {
                         "type": "string",
@@ -36,12 +41,12 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Accept Invite Request Body",
-                        "name": "AcceptInviteReq",
+                        "description": "Drain Request Body",
+                        "name": "DrainReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/apihttp.AcceptInviteReq"
+                            "$ref": "#/definitions/apihttp.DrainReq"
                         }
                     }
                 ],
@@ -57,23 +62,37 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/match/cancel_match/{uid}": {
+        "/admin/groups/{id}/cancel_match": {
             "post": {
-                "description": "cancel match",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "take the group out of the queue and back to the group, regardless of its captain",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "cancel match",
+                "summary": "force a group to cancel matching",
                 "parameters": [
                     {
                         "type": "string",
@@ -82,9 +101,9 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "type": "string",
-                        "description": "player uid",
-                        "name": "uid",
+                        "type": "integer",
+                        "description": "Group ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -101,23 +120,37 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/match/change_role": {
+        "/admin/groups/{id}/dissolve": {
             "post": {
-                "description": "change a player's role based on the request",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "dissolve the group in invite or match state, regardless of its captain",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "change a player's role",
+                "summary": "force to dissolve a group",
                 "parameters": [
                     {
                         "type": "string",
@@ -126,13 +159,11 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Change Role Request Body",
-                        "name": "ChangeRoleReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/apihttp.ChangeRoleReq"
-                        }
+                        "type": "integer",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -147,43 +178,60 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/match/create_group": {
+        "/admin/pause": {
             "post": {
-                "description": "create a new group based on the request",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "stop matching the groups in all the queues until resumed, including the queues created later",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "create a new group",
+                "summary": "pause all the match queues",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Request ID",
                         "name": "x-request-id",
                         "in": "header"
-                    },
-                    {
-                        "description": "Create Group Request Body",
-                        "name": "CreateGroup",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pto.CreateGroup"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
                         "schema": {
                             "type": "string"
                         }
@@ -191,37 +239,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/dissolve_group/{uid}": {
-            "post": {
-                "description": "dissolve a group based on the request",
-                "consumes": [
-                    "application/json"
+        "/admin/queues": {
+            "get": {
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "list the glicko2 match queues with their sizes and wait times",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "dissolve a group",
+                "summary": "list the match queues",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Request ID",
                         "name": "x-request-id",
                         "in": "header"
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "uid",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Concrete Error Msg",
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.ListQueuesRsp"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
                         "schema": {
                             "type": "string"
                         }
@@ -229,9 +284,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/enter_group": {
+        "/admin/queues/{key}/drain": {
             "post": {
-                "description": "enter a group based on the request",
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "stop accepting new groups to the queue, the groups already matching keep matching until the timeout,\nthen the rest are canceled. The queue keeps draining until undrained.",
                 "consumes": [
                     "application/json"
                 ],
@@ -239,9 +299,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "enter a group",
+                "summary": "drain a match queue for maintenance",
                 "parameters": [
                     {
                         "type": "string",
@@ -250,12 +310,19 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Enter Group Request Body",
-                        "name": "EnterGroupReq",
+                        "type": "string",
+                        "description": "Queue Key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Drain Request Body",
+                        "name": "DrainReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/apihttp.EnterGroupReq"
+                            "$ref": "#/definitions/apihttp.DrainReq"
                         }
                     }
                 ],
@@ -271,23 +338,37 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/match/exit_game": {
-            "post": {
-                "description": "exit game",
-                "consumes": [
-                    "application/json"
+        "/admin/queues/{key}/groups": {
+            "get": {
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "list the groups waiting in the glicko2 match queue, the longest waiting first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "exit game",
+                "summary": "list the groups in a match queue",
                 "parameters": [
                     {
                         "type": "string",
@@ -296,13 +377,11 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Exit Game Request Body",
-                        "name": "ExitGameReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/apihttp.ExitGameReq"
-                        }
+                        "type": "string",
+                        "description": "Queue Key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -312,8 +391,14 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
                         "schema": {
                             "type": "string"
                         }
@@ -321,19 +406,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/exit_group/{uid}": {
+        "/admin/queues/{key}/pause": {
             "post": {
-                "description": "exit a group based on the request",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "stop matching the groups in the queue until resumed, the groups keep waiting in the queue",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "exit a group",
+                "summary": "pause a match queue",
                 "parameters": [
                     {
                         "type": "string",
@@ -343,8 +430,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "uid",
+                        "description": "Queue Key",
+                        "name": "key",
                         "in": "path",
                         "required": true
                     }
@@ -355,23 +442,37 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/match/invite": {
+        "/admin/queues/{key}/resume": {
             "post": {
-                "description": "invite a player based on the request",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "resume matching the groups in the paused queue",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "invite a player",
+                "summary": "resume a match queue",
                 "parameters": [
                     {
                         "type": "string",
@@ -380,13 +481,11 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Invite Request Body",
-                        "name": "InviteReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/apihttp.InviteReq"
-                        }
+                        "type": "string",
+                        "description": "Queue Key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -396,8 +495,14 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
                         "schema": {
                             "type": "string"
                         }
@@ -405,19 +510,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/kick_player": {
+        "/admin/queues/{key}/undrain": {
             "post": {
-                "description": "kick a player based on the request",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "accept new groups to the draining queue again",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "kick a player",
+                "summary": "stop draining a match queue",
                 "parameters": [
                     {
                         "type": "string",
@@ -426,13 +533,11 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Kick Player Request Body",
-                        "name": "KickPlayerReq",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/apihttp.KickPlayerReq"
-                        }
+                        "type": "string",
+                        "description": "Queue Key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -442,8 +547,14 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
                         "schema": {
                             "type": "string"
                         }
@@ -451,43 +562,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/ready/{uid}": {
+        "/admin/resume": {
             "post": {
-                "description": "ready",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "resume matching the groups in all the queues, including the queues paused separately",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "ready",
+                "summary": "resume all the match queues",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Request ID",
                         "name": "x-request-id",
                         "in": "header"
-                    },
-                    {
-                        "type": "string",
-                        "description": "player uid",
-                        "name": "uid",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Concrete Error Msg",
+                        "description": "ok",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
                         "schema": {
                             "type": "string"
                         }
@@ -495,19 +607,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/refuse_invite": {
+        "/admin/rooms/{id}/clear": {
             "post": {
-                "description": "refuse an invitation based on the request",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
                 ],
+                "description": "clear the room, its groups go back to invite state, the custom room not started is dissolved",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "match service"
+                    "admin"
                 ],
-                "summary": "refuse an invitation",
+                "summary": "clear a room",
                 "parameters": [
                     {
                         "type": "string",
@@ -516,12 +630,113 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Refuse Invite Request Body",
-                        "name": "RefuseInviteReq",
+                        "type": "integer",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/undrain": {
+            "post": {
+                "security": [
+                    {
+                        "AdminToken": []
+                    }
+                ],
+                "description": "accept new groups to the queues again, the queues drained separately keep draining",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "stop draining all the match queues",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Missing Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid Admin Token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/accept_invite": {
+            "post": {
+                "description": "accept an invitation based on the request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "accept an invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Accept Invite Request Body",
+                        "name": "AcceptInviteReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/apihttp.RefuseInviteReq"
+                            "$ref": "#/definitions/apihttp.AcceptInviteReq"
                         }
                     }
                 ],
@@ -541,9 +756,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/set_nearby_join_group": {
+        "/match/accept_match": {
             "post": {
-                "description": "set whether group can be entered from nearby players list",
+                "description": "accept the matched room in ready check",
                 "consumes": [
                     "application/json"
                 ],
@@ -553,7 +768,7 @@ const docTemplate = `{
                 "tags": [
                     "match service"
                 ],
-                "summary": "set nearby join group",
+                "summary": "accept match",
                 "parameters": [
                     {
                         "type": "string",
@@ -562,12 +777,12 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Set Nearby Join Group Request Body",
-                        "name": "SetNearbyJoinGroupReq",
+                        "description": "Accept Match Request Body",
+                        "name": "AcceptMatchReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/apihttp.SetNearbyJoinGroupReq"
+                            "$ref": "#/definitions/apihttp.AcceptMatchReq"
                         }
                     }
                 ],
@@ -587,9 +802,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/set_recent_join_group": {
+        "/match/cancel_match/{uid}": {
             "post": {
-                "description": "set whether group can be entered from recent players list",
+                "description": "cancel match",
                 "consumes": [
                     "application/json"
                 ],
@@ -599,7 +814,7 @@ const docTemplate = `{
                 "tags": [
                     "match service"
                 ],
-                "summary": "set recent join group",
+                "summary": "cancel match",
                 "parameters": [
                     {
                         "type": "string",
@@ -608,12 +823,56 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Set Recent Join Group Request Body",
-                        "name": "SetRecentJoinGroupReq",
+                        "type": "string",
+                        "description": "player uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/change_role": {
+            "post": {
+                "description": "change a player's role based on the request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "change a player's role",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Change Role Request Body",
+                        "name": "ChangeRoleReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/apihttp.SetRecentJoinGroupReq"
+                            "$ref": "#/definitions/apihttp.ChangeRoleReq"
                         }
                     }
                 ],
@@ -633,9 +892,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/set_voice_state": {
+        "/match/create_custom_room": {
             "post": {
-                "description": "set player voice state",
+                "description": "create a custom room with manual team setup, the group of the captain is placed in the first team slot",
                 "consumes": [
                     "application/json"
                 ],
@@ -645,7 +904,7 @@ const docTemplate = `{
                 "tags": [
                     "match service"
                 ],
-                "summary": "set voice state",
+                "summary": "create custom room",
                 "parameters": [
                     {
                         "type": "string",
@@ -654,12 +913,12 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Set Voice State Request Body",
-                        "name": "SetVoiceStateReq",
+                        "description": "Create Custom Room Request Body",
+                        "name": "CreateCustomRoomReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/apihttp.SetVoiceStateReq"
+                            "$ref": "#/definitions/apihttp.CreateCustomRoomReq"
                         }
                     }
                 ],
@@ -679,9 +938,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/start_match/{uid}": {
+        "/match/create_group": {
             "post": {
-                "description": "start to match",
+                "description": "create a new group based on the request",
                 "consumes": [
                     "application/json"
                 ],
@@ -691,7 +950,7 @@ const docTemplate = `{
                 "tags": [
                     "match service"
                 ],
-                "summary": "start match",
+                "summary": "create a new group",
                 "parameters": [
                     {
                         "type": "string",
@@ -699,12 +958,54 @@ const docTemplate = `{
                         "name": "x-request-id",
                         "in": "header"
                     },
+                    {
+                        "description": "Create Group Request Body",
+                        "name": "CreateGroup",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pto.CreateGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/decline_match": {
+            "post": {
+                "description": "decline the matched room in ready check",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "decline match",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "player uid",
-                        "name": "uid",
-                        "in": "path",
-                        "required": true
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Decline Match Request Body",
+                        "name": "DeclineMatchReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.DeclineMatchReq"
+                        }
                     }
                 ],
                 "responses": {
@@ -723,9 +1024,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/match/unready/{uid}": {
+        "/match/dissolve_group/{uid}": {
             "post": {
-                "description": "unready",
+                "description": "dissolve a group based on the request",
                 "consumes": [
                     "application/json"
                 ],
@@ -735,7 +1036,7 @@ const docTemplate = `{
                 "tags": [
                     "match service"
                 ],
-                "summary": "unready",
+                "summary": "dissolve a group",
                 "parameters": [
                     {
                         "type": "string",
@@ -745,7 +1046,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "player uid",
+                        "description": "User ID",
                         "name": "uid",
                         "in": "path",
                         "required": true
@@ -757,19 +1058,13 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "string"
-                        }
                     }
                 }
             }
         },
-        "/match/upload_player_attr": {
+        "/match/enter_group": {
             "post": {
-                "description": "upload player attr",
+                "description": "enter a group based on the request",
                 "consumes": [
                     "application/json"
                 ],
@@ -779,7 +1074,7 @@ const docTemplate = `{
                 "tags": [
                     "match service"
                 ],
-                "summary": "upload player attr",
+                "summary": "enter a group",
                 "parameters": [
                     {
                         "type": "string",
@@ -788,12 +1083,12 @@ const docTemplate = `{
                         "in": "header"
                     },
                     {
-                        "description": "Upload Player Attr Request Body",
-                        "name": "UploadPlayerAttrReq",
+                        "description": "Enter Group Request Body",
+                        "name": "EnterGroupReq",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/apihttp.UploadPlayerAttrReq"
+                            "$ref": "#/definitions/apihttp.EnterGroupReq"
                         }
                     }
                 ],
@@ -812,86 +1107,1789 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "apihttp.AcceptInviteReq": {
+        },
+        "/match/exit_game": {
+            "post": {
+                "description": "exit game",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "exit game",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Exit Game Request Body",
+                        "name": "ExitGameReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.ExitGameReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/exit_group/{uid}": {
+            "post": {
+                "description": "exit a group based on the request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "exit a group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/exit_spectate": {
+            "post": {
+                "description": "leave the room as a spectator",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "exit spectate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Exit Spectate Request Body",
+                        "name": "ExitSpectateReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.ExitSpectateReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/invite": {
+            "post": {
+                "description": "invite a player based on the request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "invite a player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Invite Request Body",
+                        "name": "InviteReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.InviteReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/join_custom_room": {
+            "post": {
+                "description": "join a custom room by the invite code with the whole group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "join custom room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Join Custom Room Request Body",
+                        "name": "JoinCustomRoomReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.JoinCustomRoomReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/kick_player": {
+            "post": {
+                "description": "kick a player based on the request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "kick a player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Kick Player Request Body",
+                        "name": "KickPlayerReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.KickPlayerReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/leave_custom_room": {
+            "post": {
+                "description": "leave the custom room with the whole group, the room is dissolved if the owner leaves",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "leave custom room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Leave Custom Room Request Body",
+                        "name": "LeaveCustomRoomReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.LeaveCustomRoomReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/move_custom_room_player": {
+            "post": {
+                "description": "move the group of the target player to another team slot of the custom room",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "move custom room player",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Move Custom Room Player Request Body",
+                        "name": "MoveCustomRoomPlayerReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.MoveCustomRoomPlayerReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/nearby_groups/{uid}": {
+            "post": {
+                "description": "list the joinable groups of the nearby players, the nearest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "nearby groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "player uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/open_backfill": {
+            "post": {
+                "description": "open slots of a team in an in-progress room to be filled by the matching players",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "open backfill",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Open Backfill Request Body",
+                        "name": "OpenBackfillReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.OpenBackfillReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/ready/{uid}": {
+            "post": {
+                "description": "ready",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "ready",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "player uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/received_invites/{uid}": {
+            "post": {
+                "description": "list the pending invitations received by the player, the latest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "list received invitations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "player uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/recent_groups/{uid}": {
+            "post": {
+                "description": "list the joinable groups of the recent teammates and opponents, the latest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "recent groups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "player uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/refuse_invite": {
+            "post": {
+                "description": "refuse an invitation based on the request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "refuse an invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Refuse Invite Request Body",
+                        "name": "RefuseInviteReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.RefuseInviteReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/report_location": {
+            "post": {
+                "description": "report the coordinates of the player to be found by nearby players",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "report location",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Report Location Request Body",
+                        "name": "ReportLocationReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.ReportLocationReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/revoke_invite": {
+            "post": {
+                "description": "revoke the pending invitation sent by the inviter",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "revoke an invitation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Revoke Invite Request Body",
+                        "name": "RevokeInviteReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.RevokeInviteReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/send_group_message": {
+            "post": {
+                "description": "send a text, emote or quick chat message to the group, available until the game starts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "send group message",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Send Group Message Request Body",
+                        "name": "SendGroupMessageReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.SendGroupMessageReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/sent_invites/{uid}": {
+            "post": {
+                "description": "list the pending invitations sent by the player, the latest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "list sent invitations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "player uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/set_clan_channel_join_group": {
+            "post": {
+                "description": "set whether group can be entered from clan channel by the clan members of the group players",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "set clan channel join group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Set Clan Channel Join Group Request Body",
+                        "name": "SetClanChannelJoinGroupReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.SetClanChannelJoinGroupReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/set_friend_join_group": {
+            "post": {
+                "description": "set whether group can be entered from the friends of the group players",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "set friend join group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Set Friend Join Group Request Body",
+                        "name": "SetFriendJoinGroupReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.SetFriendJoinGroupReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/set_nearby_join_group": {
+            "post": {
+                "description": "set whether group can be entered from nearby players list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "set nearby join group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Set Nearby Join Group Request Body",
+                        "name": "SetNearbyJoinGroupReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.SetNearbyJoinGroupReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/set_recent_join_group": {
+            "post": {
+                "description": "set whether group can be entered from recent players list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "set recent join group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Set Recent Join Group Request Body",
+                        "name": "SetRecentJoinGroupReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.SetRecentJoinGroupReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/set_voice_state": {
+            "post": {
+                "description": "set player voice state",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "set voice state",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Set Voice State Request Body",
+                        "name": "SetVoiceStateReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.SetVoiceStateReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/set_world_channel_join_group": {
+            "post": {
+                "description": "set whether group can be entered from world channel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "set world channel join group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Set World Channel Join Group Request Body",
+                        "name": "SetWorldChannelJoinGroupReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.SetWorldChannelJoinGroupReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/share_group/{uid}": {
+            "post": {
+                "description": "generate a share token of the group, other players can enter the group with it before it expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "share group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "player uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/spectate": {
+            "post": {
+                "description": "watch the game of a matched room as a spectator",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "spectate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Spectate Request Body",
+                        "name": "SpectateReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.SpectateReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/start_custom_room": {
+            "post": {
+                "description": "start the game of the custom room once every team slot has players",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "start custom room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Start Custom Room Request Body",
+                        "name": "StartCustomRoomReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.StartCustomRoomReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/start_match/{uid}": {
+            "post": {
+                "description": "start to match",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "start match",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "player uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/unready/{uid}": {
+            "post": {
+                "description": "unready",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "unready",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "player uid",
+                        "name": "uid",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/match/upload_player_attr": {
+            "post": {
+                "description": "upload player attr",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "match service"
+                ],
+                "summary": "upload player attr",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Request ID",
+                        "name": "x-request-id",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Upload Player Attr Request Body",
+                        "name": "UploadPlayerAttrReq",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/apihttp.UploadPlayerAttrReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Concrete Error Msg",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apihttp.AcceptInviteReq": {
+            "type": "object",
+            "required": [
+                "group_id",
+                "invitee_info",
+                "inviter_uid"
+            ],
+            "properties": {
+                "group_id": {
+                    "type": "integer"
+                },
+                "invitee_info": {
+                    "$ref": "#/definitions/pto.PlayerInfo"
+                },
+                "inviter_uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.AcceptMatchReq": {
+            "type": "object",
+            "required": [
+                "room_id",
+                "uid"
+            ],
+            "properties": {
+                "room_id": {
+                    "type": "integer"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.ChangeRoleReq": {
+            "type": "object",
+            "required": [
+                "captain_uid",
+                "role",
+                "target_uid"
+            ],
+            "properties": {
+                "captain_uid": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/entry.GroupRole"
+                },
+                "target_uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.CreateCustomRoomReq": {
+            "type": "object",
+            "required": [
+                "team_limit",
+                "team_player_limit",
+                "uid"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "team_limit": {
+                    "type": "integer"
+                },
+                "team_player_limit": {
+                    "type": "integer"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.CreateCustomRoomRsp": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "room_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "apihttp.CreateGroupRsp": {
+            "type": "object",
+            "properties": {
+                "group_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "apihttp.DeclineMatchReq": {
+            "type": "object",
+            "required": [
+                "room_id",
+                "uid"
+            ],
+            "properties": {
+                "room_id": {
+                    "type": "integer"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.DrainReq": {
+            "type": "object",
+            "properties": {
+                "timeout_sec": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "apihttp.EnterGroupReq": {
+            "type": "object",
+            "required": [
+                "group_id",
+                "player_info"
+            ],
+            "properties": {
+                "group_id": {
+                    "type": "integer"
+                },
+                "player_info": {
+                    "$ref": "#/definitions/pto.EnterGroup"
+                }
+            }
+        },
+        "apihttp.ExitGameReq": {
+            "type": "object",
+            "required": [
+                "room_id",
+                "uid"
+            ],
+            "properties": {
+                "room_id": {
+                    "type": "integer"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.ExitSpectateReq": {
+            "type": "object",
+            "required": [
+                "room_id",
+                "uid"
+            ],
+            "properties": {
+                "room_id": {
+                    "type": "integer"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.InviteReq": {
+            "type": "object",
+            "required": [
+                "invitee_uid",
+                "inviter_uid"
+            ],
+            "properties": {
+                "invitee_uid": {
+                    "type": "string"
+                },
+                "inviter_uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.JoinCustomRoomReq": {
+            "type": "object",
+            "required": [
+                "code",
+                "uid"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.JoinableGroupsRsp": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pto.JoinableGroup"
+                    }
+                }
+            }
+        },
+        "apihttp.KickPlayerReq": {
+            "type": "object",
+            "required": [
+                "captain_uid",
+                "kicked_uid"
+            ],
+            "properties": {
+                "captain_uid": {
+                    "type": "string"
+                },
+                "kicked_uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.LeaveCustomRoomReq": {
+            "type": "object",
+            "required": [
+                "room_id",
+                "uid"
+            ],
+            "properties": {
+                "room_id": {
+                    "type": "integer"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.ListQueueGroupsRsp": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apihttp.QueueGroupInfo"
+                    }
+                }
+            }
+        },
+        "apihttp.ListQueuesRsp": {
+            "type": "object",
+            "properties": {
+                "drain_until": {
+                    "description": "DrainUntil is the deadline of draining all the queues in unix seconds, 0 means not draining",
+                    "type": "integer"
+                },
+                "paused": {
+                    "type": "boolean"
+                },
+                "queues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apihttp.QueueInfo"
+                    }
+                }
+            }
+        },
+        "apihttp.MoveCustomRoomPlayerReq": {
+            "type": "object",
+            "required": [
+                "room_id",
+                "slot",
+                "target_uid",
+                "uid"
+            ],
+            "properties": {
+                "room_id": {
+                    "type": "integer"
+                },
+                "slot": {
+                    "type": "integer"
+                },
+                "target_uid": {
+                    "type": "string"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.OpenBackfillReq": {
             "type": "object",
             "required": [
-                "group_id",
-                "invitee_info",
-                "inviter_uid"
+                "room_id",
+                "slots",
+                "team_id"
             ],
             "properties": {
-                "group_id": {
+                "room_id": {
                     "type": "integer"
                 },
-                "invitee_info": {
-                    "$ref": "#/definitions/pto.PlayerInfo"
+                "slots": {
+                    "type": "integer"
                 },
-                "inviter_uid": {
-                    "type": "string"
+                "team_id": {
+                    "type": "integer"
                 }
             }
         },
-        "apihttp.ChangeRoleReq": {
+        "apihttp.PendingInvitesRsp": {
             "type": "object",
-            "required": [
-                "captain_uid",
-                "role",
-                "target_uid"
-            ],
             "properties": {
-                "captain_uid": {
+                "invites": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pto.PendingInvite"
+                    }
+                }
+            }
+        },
+        "apihttp.QueueGroupInfo": {
+            "type": "object",
+            "properties": {
+                "group_id": {
+                    "type": "integer"
+                },
+                "mmr": {
+                    "type": "number"
+                },
+                "players": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "queue": {
                     "type": "string"
                 },
-                "role": {
-                    "$ref": "#/definitions/entry.GroupRole"
+                "star": {
+                    "type": "integer"
                 },
-                "target_uid": {
+                "type": {
                     "type": "string"
+                },
+                "wait_sec": {
+                    "type": "integer"
                 }
             }
         },
-        "apihttp.CreateGroupRsp": {
+        "apihttp.QueueInfo": {
             "type": "object",
             "properties": {
-                "group_id": {
+                "drain_until": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "max_wait_sec": {
+                    "type": "integer"
+                },
+                "normal_groups": {
+                    "type": "integer"
+                },
+                "normal_wait": {
+                    "$ref": "#/definitions/glicko2.WaitStats"
+                },
+                "paused": {
+                    "type": "boolean"
+                },
+                "players": {
+                    "type": "integer"
+                },
+                "team_groups": {
                     "type": "integer"
+                },
+                "team_wait": {
+                    "$ref": "#/definitions/glicko2.WaitStats"
                 }
             }
         },
-        "apihttp.EnterGroupReq": {
+        "apihttp.RefuseInviteReq": {
             "type": "object",
             "required": [
                 "group_id",
-                "player_info"
+                "invitee_uid",
+                "inviter_uid"
             ],
             "properties": {
                 "group_id": {
                     "type": "integer"
                 },
-                "player_info": {
-                    "$ref": "#/definitions/pto.EnterGroup"
+                "invitee_uid": {
+                    "type": "string"
+                },
+                "inviter_uid": {
+                    "type": "string"
+                },
+                "refuse_msg": {
+                    "type": "string"
                 }
             }
         },
-        "apihttp.ExitGameReq": {
+        "apihttp.ReportLocationReq": {
             "type": "object",
             "required": [
-                "room_id",
                 "uid"
             ],
             "properties": {
-                "room_id": {
-                    "type": "integer"
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
                 },
                 "uid": {
                     "type": "string"
                 }
             }
         },
-        "apihttp.InviteReq": {
+        "apihttp.RevokeInviteReq": {
             "type": "object",
             "required": [
                 "invitee_uid",
@@ -906,39 +2904,49 @@ const docTemplate = `{
                 }
             }
         },
-        "apihttp.KickPlayerReq": {
+        "apihttp.SendGroupMessageReq": {
             "type": "object",
             "required": [
-                "captain_uid",
-                "kicked_uid"
+                "content",
+                "type",
+                "uid"
             ],
             "properties": {
-                "captain_uid": {
+                "content": {
                     "type": "string"
                 },
-                "kicked_uid": {
+                "type": {
+                    "$ref": "#/definitions/pto.GroupMessageType"
+                },
+                "uid": {
                     "type": "string"
                 }
             }
         },
-        "apihttp.RefuseInviteReq": {
+        "apihttp.SetClanChannelJoinGroupReq": {
             "type": "object",
             "required": [
-                "group_id",
-                "invitee_uid",
-                "inviter_uid"
+                "captain_uid"
             ],
             "properties": {
-                "group_id": {
-                    "type": "integer"
-                },
-                "invitee_uid": {
-                    "type": "string"
+                "allow": {
+                    "type": "boolean"
                 },
-                "inviter_uid": {
+                "captain_uid": {
                     "type": "string"
+                }
+            }
+        },
+        "apihttp.SetFriendJoinGroupReq": {
+            "type": "object",
+            "required": [
+                "captain_uid"
+            ],
+            "properties": {
+                "allow": {
+                    "type": "boolean"
                 },
-                "refuse_msg": {
+                "captain_uid": {
                     "type": "string"
                 }
             }
@@ -991,6 +2999,61 @@ const docTemplate = `{
                 }
             }
         },
+        "apihttp.SetWorldChannelJoinGroupReq": {
+            "type": "object",
+            "required": [
+                "captain_uid"
+            ],
+            "properties": {
+                "allow": {
+                    "type": "boolean"
+                },
+                "captain_uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.ShareGroupRsp": {
+            "type": "object",
+            "properties": {
+                "expire_at": {
+                    "type": "integer"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.SpectateReq": {
+            "type": "object",
+            "required": [
+                "room_id",
+                "uid"
+            ],
+            "properties": {
+                "room_id": {
+                    "type": "integer"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "apihttp.StartCustomRoomReq": {
+            "type": "object",
+            "required": [
+                "room_id",
+                "uid"
+            ],
+            "properties": {
+                "room_id": {
+                    "type": "integer"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
         "apihttp.UploadPlayerAttrReq": {
             "type": "object",
             "required": [
@@ -1051,6 +3114,23 @@ const docTemplate = `{
                 "PlayerVoiceStateUnmute"
             ]
         },
+        "glicko2.WaitStats": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "p50": {
+                    "type": "integer"
+                },
+                "p95": {
+                    "type": "integer"
+                },
+                "p99": {
+                    "type": "integer"
+                }
+            }
+        },
         "pto.CreateGroup": {
             "type": "object",
             "required": [
@@ -1077,6 +3157,13 @@ const docTemplate = `{
                 "rank": {
                     "type": "integer"
                 },
+                "region_pings": {
+                    "description": "RegionPings is the ping(ms) measured by the client to each game server region.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
                 "star": {
                     "type": "integer"
                 },
@@ -1111,6 +3198,17 @@ const docTemplate = `{
                 "rank": {
                     "type": "integer"
                 },
+                "region_pings": {
+                    "description": "RegionPings is the ping(ms) measured by the client to each game server region.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "shareToken": {
+                    "description": "ShareToken is the token from the share link, required if Source is EnterGroupSourceTypeShare",
+                    "type": "string"
+                },
                 "source": {
                     "$ref": "#/definitions/pto.EnterGroupSourceType"
                 },
@@ -1155,17 +3253,116 @@ const docTemplate = `{
         "pto.Glicko2Info": {
             "type": "object",
             "properties": {
+                "account_create_sec": {
+                    "description": "AccountCreateSec is the unix time the player's account was created",
+                    "type": "integer"
+                },
+                "match_count": {
+                    "description": "MatchCount is the total matches the player has played",
+                    "type": "integer"
+                },
                 "mmr": {
                     "type": "number"
                 },
                 "rank": {
                     "type": "integer"
                 },
+                "rd": {
+                    "description": "RD is the rating deviation of the player",
+                    "type": "number"
+                },
                 "star": {
                     "type": "integer"
                 }
             }
         },
+        "pto.GroupMessageType": {
+            "type": "integer",
+            "enum": [
+                1,
+                2,
+                3
+            ],
+            "x-enum-comments": {
+                "GroupMessageTypeEmote": "emote id",
+                "GroupMessageTypeQuick": "preset quick chat id",
+                "GroupMessageTypeText": "free text"
+            },
+            "x-enum-varnames": [
+                "GroupMessageTypeText",
+                "GroupMessageTypeEmote",
+                "GroupMessageTypeQuick"
+            ]
+        },
+        "pto.JoinableGroup": {
+            "type": "object",
+            "properties": {
+                "captain": {
+                    "type": "string"
+                },
+                "distanceKm": {
+                    "description": "DistanceKm is the distance to the nearby player, only for the nearby list.",
+                    "type": "number"
+                },
+                "gameMode": {
+                    "$ref": "#/definitions/constant.GameMode"
+                },
+                "groupID": {
+                    "type": "integer"
+                },
+                "modeVersion": {
+                    "type": "integer"
+                },
+                "playedAtSec": {
+                    "description": "PlayedAtSec is the last time played with the recent player, only for the recent list.",
+                    "type": "integer"
+                },
+                "playerCount": {
+                    "type": "integer"
+                },
+                "playerLimit": {
+                    "type": "integer"
+                },
+                "relation": {
+                    "description": "Relation is how the player met the recent player, only for the recent list.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pto.RecentRelation"
+                        }
+                    ]
+                },
+                "uid": {
+                    "description": "UID is the nearby or recent player in the group.",
+                    "type": "string"
+                }
+            }
+        },
+        "pto.PendingInvite": {
+            "type": "object",
+            "properties": {
+                "createSec": {
+                    "type": "integer"
+                },
+                "expireSec": {
+                    "type": "integer"
+                },
+                "gameMode": {
+                    "$ref": "#/definitions/constant.GameMode"
+                },
+                "groupID": {
+                    "type": "integer"
+                },
+                "inviteeUID": {
+                    "type": "string"
+                },
+                "inviterUID": {
+                    "type": "string"
+                },
+                "modeVersion": {
+                    "type": "integer"
+                }
+            }
+        },
         "pto.PlayerInfo": {
             "type": "object",
             "required": [
@@ -1192,6 +3389,13 @@ const docTemplate = `{
                 "rank": {
                     "type": "integer"
                 },
+                "region_pings": {
+                    "description": "RegionPings is the ping(ms) measured by the client to each game server region.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
                 "star": {
                     "type": "integer"
                 },
@@ -1199,6 +3403,21 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "pto.RecentRelation": {
+            "type": "integer",
+            "enum": [
+                1,
+                2
+            ],
+            "x-enum-comments": {
+                "RecentRelationOpponent": "played in the opposite team",
+                "RecentRelationTeammate": "played in the same team"
+            },
+            "x-enum-varnames": [
+                "RecentRelationTeammate",
+                "RecentRelationOpponent"
+            ]
         }
     }
 }`
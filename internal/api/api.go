@@ -31,12 +31,14 @@ func init() {
 }
 
 type API struct {
+	SC config.Configer[config.ServerConfig]
 	MS service.Match
 	M  *matcher.Matcher
 	PM *entry.PlayerMgr
 	GM *entry.GroupMgr
 	TM *entry.TeamMgr
 	RM *entry.RoomMgr
+	SM *entry.StateMachines
 }
 
 // Start initializes the api components and starts them.
@@ -61,6 +63,7 @@ func Start(
 
 	// init api
 	api = NewAPI(mc, groupChannel, roomChannel, dt, NewGlicko2Matcher(roomChannel, matchConf, mgrs), mgrs)
+	api.SC = sc
 
 	// if not in testing mode, reload entries
 	// TODO: find a better way.
@@ -90,13 +93,16 @@ func Start(
 func NewAPI(configer config.Configer[config.MatchConfig],
 	groupChannel chan entry.Group, roomChannel chan common.Result,
 	dt timer.Operator[int64], gm *glicko2.Matcher, mgrs *entry.Mgrs) *API {
+	m := matcher.New(groupChannel, gm)
 	api := &API{
 		PM: mgrs.PlayerMgr,
 		GM: mgrs.GroupMgr,
 		TM: mgrs.TeamMgr,
 		RM: mgrs.RoomMgr,
-		M:  matcher.New(groupChannel, gm),
-		MS: matchimpl.NewDefault(configer, mgrs, groupChannel, roomChannel, dt),
+		SM: mgrs.SM,
+		M:  m,
+		MS: matchimpl.NewDefault(configer, mgrs, groupChannel, roomChannel, dt,
+			matchimpl.WithBackfill(m), matchimpl.WithQueuePaused(gm.QueuePaused)),
 	}
 	return api
 }
@@ -122,6 +128,7 @@ func NewEntryManagers() *entry.Mgrs {
 		GroupMgr:  entry.NewGroupMgr(0),
 		TeamMgr:   entry.NewTeamMgr(0),
 		RoomMgr:   entry.NewRoomMgr(0),
+		SM:        entry.NewStateMachines(),
 	}
 }
 
@@ -237,6 +244,7 @@ func (api *API) reloadPlayers(playerData map[constant.GameMode][][]byte) error {
 
 //nolint:dupl
 func (api *API) reloadGroups(groupData map[constant.GameMode][][]byte) error {
+	var groups []entry.Group
 	for mode, gs := range groupData {
 		for _, gbs := range gs {
 			var g entry.Group
@@ -253,13 +261,30 @@ func (api *API) reloadGroups(groupData map[constant.GameMode][][]byte) error {
 			}
 			if goatGroup, ok := g.(*goat_game.Group); ok {
 				goatGroup.SetPlayerMgr(api.PM)
+				goatGroup.SetStateMachines(api.SM)
+				api.restoreQueueArgs(mode, goatGroup)
 			}
 			api.GM.Add(g.ID(), g)
+			groups = append(groups, g)
 		}
 	}
+	if api.MS != nil {
+		api.MS.RestoreInvites(groups)
+	}
 	return nil
 }
 
+// restoreQueueArgs sets the queue args of the game mode to the reloaded group,
+// which classify the group before it starts to match again.
+func (api *API) restoreQueueArgs(mode constant.GameMode, g *goat_game.Group) {
+	if api.M == nil {
+		return
+	}
+	if funcs := api.M.Glicko2Matcher.GetFuncs(mode); funcs != nil {
+		g.SetQueueArgsFunc(funcs.ArgsFunc)
+	}
+}
+
 //nolint:dupl
 func (api *API) reloadTeams(teamData map[constant.GameMode][][]byte) error {
 	for mode, ts := range teamData {
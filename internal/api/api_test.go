@@ -1,16 +1,23 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/hedon954/go-matcher/internal/config"
+	"github.com/hedon954/go-matcher/internal/config/mock"
 	"github.com/hedon954/go-matcher/internal/constant"
 	"github.com/hedon954/go-matcher/internal/entry"
+	glicko2Entry "github.com/hedon954/go-matcher/internal/entry/glicko2"
+	"github.com/hedon954/go-matcher/internal/entry/goat_game"
 	"github.com/hedon954/go-matcher/internal/pto"
+	"github.com/hedon954/go-matcher/pkg/algorithm/glicko2"
 )
 
 func TestSaveEntries_ReloadEntries(t *testing.T) {
@@ -51,6 +58,50 @@ func TestSaveEntries_ReloadEntries(t *testing.T) {
 	compareRooms(t, rooms, mgrs.RoomMgr.All())
 }
 
+func TestReloadEntries_StartMatch(t *testing.T) {
+	api, shutdown := Start(mock.NewServerConfigerMock(), mock.NewMatchConfigerMock(&config.MatchConfig{
+		GroupPlayerLimit: 5,
+		Glicko2: map[constant.GameMode]*glicko2.QueueArgs{
+			constant.GameModeGoatGame: {
+				MatchTimeoutSec: mock.MatchTimeoutSec,
+				TeamPlayerLimit: 5,
+				RoomTeamLimit:   2,
+				Newcomer:        &glicko2.NewcomerArgs{MinRD: 300},
+			},
+		},
+	}))
+	defer shutdown()
+
+	ctx := context.Background()
+	g, err := api.MS.CreateGroup(ctx, &pto.CreateGroup{PlayerInfo: pto.PlayerInfo{
+		UID:         "a",
+		GameMode:    constant.GameModeGoatGame,
+		ModeVersion: 1,
+		Glicko2Info: &pto.Glicko2Info{RD: 350},
+	}})
+	assert.Nil(t, err)
+	assert.Nil(t, api.SaveEntries())
+
+	api.PM.Clear()
+	api.GM.Clear()
+	api.TM.Clear()
+	api.RM.Clear()
+	assert.Nil(t, api.ReloadEntries())
+	assert.NotNil(t, api.GM.Get(g.ID()))
+
+	// the reloaded group should classify itself by the queue args
+	assert.True(t, api.GM.Get(g.ID()).(*goat_game.Group).IsNewer(time.Now().Unix()))
+
+	// the reloaded group should be able to transit its state in the matcher
+	assert.Nil(t, api.MS.StartMatch(ctx, "a"))
+	key := glicko2Entry.QueueKey(constant.GameModeGoatGame, 1)
+	assert.Eventually(t, func() bool {
+		m := api.M.Glicko2Matcher.GetMatcher(key)
+		return m != nil && len(m.Groups()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, entry.GroupStateMatch, api.GM.Get(g.ID()).Base().GetStateWithLock())
+}
+
 func prepareEntries(t *testing.T, mgrs *entry.Mgrs) (
 	[]entry.Player, []entry.Group, []entry.Team, []entry.Room,
 ) {
@@ -0,0 +1,348 @@
+package apihttp
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/merr"
+	"github.com/hedon954/go-matcher/pkg/algorithm/glicko2"
+	"github.com/hedon954/go-matcher/pkg/response"
+)
+
+// ListQueues godoc
+// @Summary list the match queues
+// @Description list the glicko2 match queues with their sizes and wait times
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Success 200 {object} ListQueuesRsp
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Router /admin/queues [get]
+func (api *API) ListQueues(c *gin.Context) {
+	gm := api.M.Glicko2Matcher
+	drains := api.MS.Drains(c.Request.Context())
+	now := time.Now().Unix()
+	queues := make([]*QueueInfo, 0)
+	for _, key := range gm.Keys() {
+		m := gm.GetMatcher(key)
+		if m == nil {
+			continue
+		}
+		info := &QueueInfo{Key: key, Paused: m.Paused(), DrainUntil: drains[key]}
+		info.NormalWait, info.TeamWait = m.WaitStats()
+		for _, g := range m.Groups() {
+			if g.Type() == glicko2.GroupTypeNotTeam {
+				info.NormalGroups++
+			} else {
+				info.TeamGroups++
+			}
+			info.Players += g.PlayerCount()
+			info.MaxWaitSec = max(info.MaxWaitSec, now-g.GetStartMatchTimeSec())
+		}
+		queues = append(queues, info)
+	}
+	response.GinSuccess(c, ListQueuesRsp{Paused: gm.Paused(), DrainUntil: drains[""], Queues: queues})
+}
+
+// ListQueueGroups godoc
+// @Summary list the groups in a match queue
+// @Description list the groups waiting in the glicko2 match queue, the longest waiting first
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Param key path string true "Queue Key"
+// @Success 200 {object} ListQueueGroupsRsp
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /admin/queues/{key}/groups [get]
+func (api *API) ListQueueGroups(c *gin.Context) {
+	m := api.M.Glicko2Matcher.GetMatcher(c.Param("key"))
+	if m == nil {
+		response.GinError(c, merr.ErrQueueNotExists)
+		return
+	}
+	now := time.Now().Unix()
+	groups := make([]*QueueGroupInfo, 0)
+	for _, g := range m.Groups() {
+		queue := glicko2.NormalQueue
+		if g.Type() != glicko2.GroupTypeNotTeam {
+			queue = glicko2.TeamQueue
+		}
+		groups = append(groups, &QueueGroupInfo{
+			GroupID: g.(entry.Group).ID(),
+			Queue:   queue,
+			Type:    g.Type().String(),
+			MMR:     g.GetMMR(),
+			Star:    g.GetStar(),
+			Players: g.(entry.Group).Base().UIDs(),
+			WaitSec: now - g.GetStartMatchTimeSec(),
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].WaitSec > groups[j].WaitSec
+	})
+	response.GinSuccess(c, ListQueueGroupsRsp{Groups: groups})
+}
+
+// PauseQueue godoc
+// @Summary pause a match queue
+// @Description stop matching the groups in the queue until resumed, the groups keep waiting in the queue
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Param key path string true "Queue Key"
+// @Success 200 {object} string "ok"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /admin/queues/{key}/pause [post]
+func (api *API) PauseQueue(c *gin.Context) {
+	m := api.M.Glicko2Matcher.GetMatcher(c.Param("key"))
+	if m == nil {
+		response.GinError(c, merr.ErrQueueNotExists)
+		return
+	}
+	m.Pause()
+	response.GinSuccess(c, nil)
+}
+
+// ResumeQueue godoc
+// @Summary resume a match queue
+// @Description resume matching the groups in the paused queue
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Param key path string true "Queue Key"
+// @Success 200 {object} string "ok"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /admin/queues/{key}/resume [post]
+func (api *API) ResumeQueue(c *gin.Context) {
+	m := api.M.Glicko2Matcher.GetMatcher(c.Param("key"))
+	if m == nil {
+		response.GinError(c, merr.ErrQueueNotExists)
+		return
+	}
+	m.Resume()
+	api.MS.ResumeMatchTimeouts(c.Request.Context(), c.Param("key"))
+	response.GinSuccess(c, nil)
+}
+
+// DrainQueue godoc
+// @Summary drain a match queue for maintenance
+// @Description stop accepting new groups to the queue, the groups already matching keep matching until the timeout,
+// @Description then the rest are canceled. The queue keeps draining until undrained.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Param key path string true "Queue Key"
+// @Param DrainReq body DrainReq true "Drain Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /admin/queues/{key}/drain [post]
+func (api *API) DrainQueue(c *gin.Context) {
+	api.drain(c, c.Param("key"))
+}
+
+// UndrainQueue godoc
+// @Summary stop draining a match queue
+// @Description accept new groups to the draining queue again
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Param key path string true "Queue Key"
+// @Success 200 {object} string "ok"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /admin/queues/{key}/undrain [post]
+func (api *API) UndrainQueue(c *gin.Context) {
+	api.undrain(c, c.Param("key"))
+}
+
+// PauseAll godoc
+// @Summary pause all the match queues
+// @Description stop matching the groups in all the queues until resumed, including the queues created later
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Success 200 {object} string "ok"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Router /admin/pause [post]
+func (api *API) PauseAll(c *gin.Context) {
+	api.M.Glicko2Matcher.Pause()
+	response.GinSuccess(c, nil)
+}
+
+// ResumeAll godoc
+// @Summary resume all the match queues
+// @Description resume matching the groups in all the queues, including the queues paused separately
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Success 200 {object} string "ok"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Router /admin/resume [post]
+func (api *API) ResumeAll(c *gin.Context) {
+	api.M.Glicko2Matcher.Resume()
+	api.MS.ResumeMatchTimeouts(c.Request.Context(), "")
+	response.GinSuccess(c, nil)
+}
+
+// DrainAll godoc
+// @Summary drain all the match queues for maintenance
+// @Description stop accepting new groups to all the queues, the groups already matching keep matching until the timeout,
+// @Description then the rest are canceled. The queues keep draining until undrained.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Param DrainReq body DrainReq true "Drain Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /admin/drain [post]
+func (api *API) DrainAll(c *gin.Context) {
+	api.drain(c, "")
+}
+
+// UndrainAll godoc
+// @Summary stop draining all the match queues
+// @Description accept new groups to the queues again, the queues drained separately keep draining
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Success 200 {object} string "ok"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /admin/undrain [post]
+func (api *API) UndrainAll(c *gin.Context) {
+	api.undrain(c, "")
+}
+
+func (api *API) drain(c *gin.Context, queueKey string) {
+	var req DrainReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.Drain(c.Request.Context(), queueKey, time.Duration(req.TimeoutSec)*time.Second); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+func (api *API) undrain(c *gin.Context, queueKey string) {
+	if err := api.MS.Undrain(c.Request.Context(), queueKey); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// ForceCancelMatch godoc
+// @Summary force a group to cancel matching
+// @Description take the group out of the queue and back to the group, regardless of its captain
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Param id path int true "Group ID"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /admin/groups/{id}/cancel_match [post]
+func (api *API) ForceCancelMatch(c *gin.Context) {
+	var req AdminIDReq
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.ForceCancelMatch(c.Request.Context(), req.ID); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// ForceDissolveGroup godoc
+// @Summary force to dissolve a group
+// @Description dissolve the group in invite or match state, regardless of its captain
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Param id path int true "Group ID"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /admin/groups/{id}/dissolve [post]
+func (api *API) ForceDissolveGroup(c *gin.Context) {
+	var req AdminIDReq
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.ForceDissolveGroup(c.Request.Context(), req.ID); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// ClearRoom godoc
+// @Summary clear a room
+// @Description clear the room, its groups go back to invite state, the custom room not started is dissolved
+// @Tags admin
+// @Produce json
+// @Security AdminToken
+// @Param x-request-id header string false "Request ID"
+// @Param id path int true "Room ID"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 401 {object} string "Missing Admin Token"
+// @Failure 403 {object} string "Invalid Admin Token"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /admin/rooms/{id}/clear [post]
+func (api *API) ClearRoom(c *gin.Context) {
+	var req AdminIDReq
+	if err := c.ShouldBindUri(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.ClearRoom(c.Request.Context(), req.ID); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
@@ -0,0 +1,161 @@
+package apihttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	internalapi "github.com/hedon954/go-matcher/internal/api"
+	"github.com/hedon954/go-matcher/internal/constant"
+	"github.com/hedon954/go-matcher/internal/entry"
+	glicko2Entry "github.com/hedon954/go-matcher/internal/entry/glicko2"
+	"github.com/hedon954/go-matcher/internal/merr"
+	"github.com/hedon954/go-matcher/pkg/response"
+)
+
+const adminToken = "admin-token"
+
+func TestAPI_Admin_Auth(t *testing.T) {
+	inner, shutdown := internalapi.Start(newConf(2))
+	defer shutdown()
+
+	api := API{inner}
+	router := api.setupRouter()
+
+	// disabled without the admin token configured
+	assert.Equal(t, http.StatusForbidden, requestAdmin(router, "GET", "/admin/queues", adminToken).Code)
+
+	api.SC.Get().AdminToken = adminToken
+	assert.Equal(t, http.StatusUnauthorized, requestAdmin(router, "GET", "/admin/queues", "").Code)
+	assert.Equal(t, http.StatusForbidden, requestAdmin(router, "GET", "/admin/queues", "wrong").Code)
+	assertRspOk(requestAdmin(router, "GET", "/admin/queues", adminToken), t)
+}
+
+func TestAPI_Admin_ShouldWork(t *testing.T) {
+	inner, shutdown := internalapi.Start(newConf(2))
+	defer shutdown()
+
+	api := API{inner}
+	api.SC.Get().AdminToken = adminToken
+	router := api.setupRouter()
+	key := glicko2Entry.QueueKey(constant.GameModeGoatGame, 1)
+
+	// 1. the queue is created after the first group starts matching
+	w := requestAdmin(router, "GET", "/admin/queues/"+key+"/groups", adminToken)
+	assert.Equal(t, merr.ErrQueueNotExists.Error(), assertRspNotOk(w, t))
+
+	g := requestCreateGroup(router, "a", t)
+	gid := strconv.FormatInt(g.GroupID, 10)
+	requestStartMatch(router, "a", t)
+	assert.Eventually(t, func() bool {
+		return len(listQueueGroups(router, key, t)) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	queues := response.FromHTTPResponse[ListQueuesRsp](
+		response.NewHTTPResponse(requestAdmin(router, "GET", "/admin/queues", adminToken).Body.Bytes())).Queues
+	assert.Equal(t, 1, len(queues))
+	assert.Equal(t, key, queues[0].Key)
+	assert.Equal(t, 1, queues[0].NormalGroups+queues[0].TeamGroups)
+	assert.Equal(t, 1, queues[0].Players)
+	groups := listQueueGroups(router, key, t)
+	assert.Equal(t, g.GroupID, groups[0].GroupID)
+	assert.Equal(t, []string{"a"}, groups[0].Players)
+
+	// 2. pause and resume the queue
+	assertRspOk(requestAdmin(router, "POST", "/admin/queues/"+key+"/pause", adminToken), t)
+	assert.True(t, api.M.Glicko2Matcher.GetMatcher(key).Paused())
+	assertRspOk(requestAdmin(router, "POST", "/admin/queues/"+key+"/resume", adminToken), t)
+	assert.False(t, api.M.Glicko2Matcher.GetMatcher(key).Paused())
+	w = requestAdmin(router, "POST", "/admin/queues/unknown/pause", adminToken)
+	assert.Equal(t, merr.ErrQueueNotExists.Error(), assertRspNotOk(w, t))
+
+	// 3. force the group to cancel matching, it leaves the queue
+	assertRspOk(requestAdmin(router, "POST", "/admin/groups/"+gid+"/cancel_match", adminToken), t)
+	assert.Equal(t, entry.GroupStateInvite, getGroupStateWithLock(api.GM.Get(g.GroupID)))
+	assert.Equal(t, 0, len(listQueueGroups(router, key, t)))
+
+	// 4. force to dissolve the group
+	assertRspOk(requestAdmin(router, "POST", "/admin/groups/"+gid+"/dissolve", adminToken), t)
+	assert.Nil(t, api.GM.Get(g.GroupID))
+	w = requestAdmin(router, "POST", "/admin/groups/"+gid+"/dissolve", adminToken)
+	assert.Equal(t, merr.ErrGroupNotExists.Error(), assertRspNotOk(w, t))
+
+	// 5. clear a room
+	w = requestAdmin(router, "POST", "/admin/rooms/1/clear", adminToken)
+	assert.Equal(t, merr.ErrRoomNotExists.Error(), assertRspNotOk(w, t))
+	assert.Equal(t, http.StatusBadRequest, requestAdmin(router, "POST", "/admin/rooms/x/clear", adminToken).Code)
+}
+
+func TestAPI_Admin_PauseAndDrain(t *testing.T) {
+	inner, shutdown := internalapi.Start(newConf(2))
+	defer shutdown()
+
+	api := API{inner}
+	api.SC.Get().AdminToken = adminToken
+	router := api.setupRouter()
+	key := glicko2Entry.QueueKey(constant.GameModeGoatGame, 1)
+
+	// 1. pause all the queues, including the ones created later
+	assertRspOk(requestAdmin(router, "POST", "/admin/pause", adminToken), t)
+	_ = requestCreateGroup(router, "a", t)
+	requestStartMatch(router, "a", t)
+	assert.Eventually(t, func() bool {
+		m := api.M.Glicko2Matcher.GetMatcher(key)
+		return m != nil && m.Paused()
+	}, time.Second, 10*time.Millisecond)
+	rsp := response.FromHTTPResponse[ListQueuesRsp](
+		response.NewHTTPResponse(requestAdmin(router, "GET", "/admin/queues", adminToken).Body.Bytes()))
+	assert.True(t, rsp.Paused)
+	assertRspOk(requestAdmin(router, "POST", "/admin/resume", adminToken), t)
+	assert.False(t, api.M.Glicko2Matcher.GetMatcher(key).Paused())
+
+	// 2. drain the queue, the new groups are rejected
+	w := requestAdminJSON(router, "/admin/queues/"+key+"/drain", `{"timeout_sec": 60}`)
+	assertRspOk(w, t)
+	rsp = response.FromHTTPResponse[ListQueuesRsp](
+		response.NewHTTPResponse(requestAdmin(router, "GET", "/admin/queues", adminToken).Body.Bytes()))
+	assert.NotZero(t, rsp.Queues[0].DrainUntil)
+	_ = requestCreateGroup(router, "b", t)
+	w = requestAdmin(router, "POST", "/match/start_match/b", adminToken)
+	assert.Equal(t, merr.ErrMatchDraining.Error(), assertRspNotOk(w, t))
+	assertRspOk(requestAdmin(router, "POST", "/admin/queues/"+key+"/undrain", adminToken), t)
+	requestStartMatch(router, "b", t)
+
+	// 3. drain all the queues
+	assert.Equal(t, http.StatusBadRequest, requestAdminJSON(router, "/admin/drain", `{"timeout_sec": -1}`).Code)
+	assertRspOk(requestAdminJSON(router, "/admin/drain", `{"timeout_sec": 60}`), t)
+	assertRspOk(requestAdmin(router, "POST", "/admin/undrain", adminToken), t)
+	w = requestAdmin(router, "POST", "/admin/undrain", adminToken)
+	assert.Equal(t, merr.ErrQueueNotDraining.Error(), assertRspNotOk(w, t))
+}
+
+func requestAdminJSON(router *gin.Engine, path, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest("POST", path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+adminToken)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func requestAdmin(router *gin.Engine, method, path, token string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, http.NoBody)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func listQueueGroups(router *gin.Engine, key string, t *testing.T) []*QueueGroupInfo {
+	w := requestAdmin(router, "GET", "/admin/queues/"+key+"/groups", adminToken)
+	assertRspOk(w, t)
+	return response.FromHTTPResponse[ListQueueGroupsRsp](response.NewHTTPResponse(w.Body.Bytes())).Groups
+}
@@ -21,6 +21,11 @@ import (
 // @host      :5050
 // @BasePath  /
 
+// @securityDefinitions.apikey AdminToken
+// @in header
+// @name Authorization
+// @description Bearer token of the admin routes, e.g. "Bearer <admin_token>"
+
 func (api *API) setupRouter() *gin.Engine {
 	r := gin.Default()
 	r.Use(apm.GinOtel(), middleware.WithRequestAndTrace())
@@ -42,15 +47,53 @@ func (api *API) setupRouter() *gin.Engine {
 		mg.POST("/invite", api.Invite)
 		mg.POST("/accept_invite", api.AcceptInvite)
 		mg.POST("/refuse_invite", api.RefuseInvite)
+		mg.POST("/revoke_invite", api.RevokeInvite)
+		mg.POST("/received_invites/:uid", api.ListReceivedInvites)
+		mg.POST("/sent_invites/:uid", api.ListSentInvites)
 		mg.POST("/set_nearby_join_group", api.SetNearbyJoinGroup)
 		mg.POST("/set_recent_join_group", api.SetRecentJoinGroup)
+		mg.POST("/set_friend_join_group", api.SetFriendJoinGroup)
+		mg.POST("/set_world_channel_join_group", api.SetWorldChannelJoinGroup)
+		mg.POST("/set_clan_channel_join_group", api.SetClanChannelJoinGroup)
+		mg.POST("/share_group/:uid", api.ShareGroup)
+		mg.POST("/report_location", api.ReportLocation)
+		mg.POST("/nearby_groups/:uid", api.NearbyGroups)
+		mg.POST("/recent_groups/:uid", api.RecentGroups)
 		mg.POST("/set_voice_state", api.SetVoiceState)
+		mg.POST("/send_group_message", api.SendGroupMessage)
 		mg.POST("/start_match/:uid", api.StartMatch)
 		mg.POST("/cancel_match/:uid", api.CancelMatch)
 		mg.POST("/upload_player_attr", api.UploadPlayerAttr)
 		mg.POST("/ready/:uid", api.Ready)
 		mg.POST("/unready/:uid", api.Unready)
 		mg.POST("/exit_game", api.ExitGame)
+		mg.POST("/open_backfill", api.OpenBackfill)
+		mg.POST("/accept_match", api.AcceptMatch)
+		mg.POST("/decline_match", api.DeclineMatch)
+		mg.POST("/spectate", api.Spectate)
+		mg.POST("/exit_spectate", api.ExitSpectate)
+		mg.POST("/create_custom_room", api.CreateCustomRoom)
+		mg.POST("/join_custom_room", api.JoinCustomRoom)
+		mg.POST("/leave_custom_room", api.LeaveCustomRoom)
+		mg.POST("/move_custom_room_player", api.MoveCustomRoomPlayer)
+		mg.POST("/start_custom_room", api.StartCustomRoom)
+	}
+
+	ag := r.Group("/admin", middleware.AdminAuth(func() string { return api.SC.Get().AdminToken }))
+	{
+		ag.POST("/pause", api.PauseAll)
+		ag.POST("/resume", api.ResumeAll)
+		ag.POST("/drain", api.DrainAll)
+		ag.POST("/undrain", api.UndrainAll)
+		ag.GET("/queues", api.ListQueues)
+		ag.GET("/queues/:key/groups", api.ListQueueGroups)
+		ag.POST("/queues/:key/pause", api.PauseQueue)
+		ag.POST("/queues/:key/resume", api.ResumeQueue)
+		ag.POST("/queues/:key/drain", api.DrainQueue)
+		ag.POST("/queues/:key/undrain", api.UndrainQueue)
+		ag.POST("/groups/:id/cancel_match", api.ForceCancelMatch)
+		ag.POST("/groups/:id/dissolve", api.ForceDissolveGroup)
+		ag.POST("/rooms/:id/clear", api.ClearRoom)
 	}
 
 	docs.SwaggerInfo.BasePath = "/"
@@ -274,6 +317,73 @@ func (api *API) RefuseInvite(c *gin.Context) {
 	response.GinSuccess(c, nil)
 }
 
+// RevokeInvite godoc
+// @Summary revoke an invitation
+// @Description revoke the pending invitation sent by the inviter
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param RevokeInviteReq body RevokeInviteReq true "Revoke Invite Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/revoke_invite [post]
+func (api *API) RevokeInvite(c *gin.Context) {
+	var req RevokeInviteReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.RevokeInvite(c.Request.Context(), req.InviterUID, req.InviteeUID); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// ListReceivedInvites godoc
+// @Summary list received invitations
+// @Description list the pending invitations received by the player, the latest first
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param uid path string true "player uid"
+// @Success 200 {object} PendingInvitesRsp
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/received_invites/{uid} [post]
+func (api *API) ListReceivedInvites(c *gin.Context) {
+	invites, err := api.MS.ListReceivedInvites(c.Request.Context(), c.Param("uid"))
+	if err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, PendingInvitesRsp{Invites: invites})
+}
+
+// ListSentInvites godoc
+// @Summary list sent invitations
+// @Description list the pending invitations sent by the player, the latest first
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param uid path string true "player uid"
+// @Success 200 {object} PendingInvitesRsp
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/sent_invites/{uid} [post]
+func (api *API) ListSentInvites(c *gin.Context) {
+	invites, err := api.MS.ListSentInvites(c.Request.Context(), c.Param("uid"))
+	if err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, PendingInvitesRsp{Invites: invites})
+}
+
 // SetNearbyJoinGroup godoc
 // @Summary set nearby join group
 // @Description set whether group can be entered from nearby players list
@@ -324,6 +434,170 @@ func (api *API) SetRecentJoinGroup(c *gin.Context) {
 	response.GinSuccess(c, nil)
 }
 
+// SetFriendJoinGroup godoc
+// @Summary set friend join group
+// @Description set whether group can be entered from the friends of the group players
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param SetFriendJoinGroupReq body SetFriendJoinGroupReq true "Set Friend Join Group Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/set_friend_join_group [post]
+func (api *API) SetFriendJoinGroup(c *gin.Context) {
+	var req SetFriendJoinGroupReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.SetFriendJoinGroup(c.Request.Context(), req.CaptainUID, req.Allow); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// SetWorldChannelJoinGroup godoc
+// @Summary set world channel join group
+// @Description set whether group can be entered from world channel
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param SetWorldChannelJoinGroupReq body SetWorldChannelJoinGroupReq true "Set World Channel Join Group Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/set_world_channel_join_group [post]
+func (api *API) SetWorldChannelJoinGroup(c *gin.Context) {
+	var req SetWorldChannelJoinGroupReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.SetWorldChannelJoinGroup(c.Request.Context(), req.CaptainUID, req.Allow); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// SetClanChannelJoinGroup godoc
+// @Summary set clan channel join group
+// @Description set whether group can be entered from clan channel by the clan members of the group players
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param SetClanChannelJoinGroupReq body SetClanChannelJoinGroupReq true "Set Clan Channel Join Group Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/set_clan_channel_join_group [post]
+func (api *API) SetClanChannelJoinGroup(c *gin.Context) {
+	var req SetClanChannelJoinGroupReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.SetClanChannelJoinGroup(c.Request.Context(), req.CaptainUID, req.Allow); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// ShareGroup godoc
+// @Summary share group
+// @Description generate a share token of the group, other players can enter the group with it before it expires
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param uid path string true "player uid"
+// @Success 200 {object} ShareGroupRsp
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/share_group/{uid} [post]
+func (api *API) ShareGroup(c *gin.Context) {
+	uid := c.Param("uid")
+	token, expireAt, err := api.MS.ShareGroup(c.Request.Context(), uid)
+	if err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, ShareGroupRsp{Token: token, ExpireAt: expireAt})
+}
+
+// ReportLocation godoc
+// @Summary report location
+// @Description report the coordinates of the player to be found by nearby players
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param ReportLocationReq body ReportLocationReq true "Report Location Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/report_location [post]
+func (api *API) ReportLocation(c *gin.Context) {
+	var req ReportLocationReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.ReportLocation(c.Request.Context(), req.UID, req.Latitude, req.Longitude); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// NearbyGroups godoc
+// @Summary nearby groups
+// @Description list the joinable groups of the nearby players, the nearest first
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param uid path string true "player uid"
+// @Success 200 {object} JoinableGroupsRsp
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/nearby_groups/{uid} [post]
+func (api *API) NearbyGroups(c *gin.Context) {
+	groups, err := api.MS.NearbyGroups(c.Request.Context(), c.Param("uid"))
+	if err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, JoinableGroupsRsp{Groups: groups})
+}
+
+// RecentGroups godoc
+// @Summary recent groups
+// @Description list the joinable groups of the recent teammates and opponents, the latest first
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param uid path string true "player uid"
+// @Success 200 {object} JoinableGroupsRsp
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/recent_groups/{uid} [post]
+func (api *API) RecentGroups(c *gin.Context) {
+	groups, err := api.MS.RecentGroups(c.Request.Context(), c.Param("uid"))
+	if err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, JoinableGroupsRsp{Groups: groups})
+}
+
 // SetVoiceState godoc
 // @Summary set voice state
 // @Description set player voice state
@@ -349,6 +623,31 @@ func (api *API) SetVoiceState(c *gin.Context) {
 	response.GinSuccess(c, nil)
 }
 
+// SendGroupMessage godoc
+// @Summary send group message
+// @Description send a text, emote or quick chat message to the group, available until the game starts
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param SendGroupMessageReq body SendGroupMessageReq true "Send Group Message Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/send_group_message [post]
+func (api *API) SendGroupMessage(c *gin.Context) {
+	var req SendGroupMessageReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.SendGroupMessage(c.Request.Context(), req.UID, req.Type, req.Content); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
 // StartMatch godoc
 // @Summary start match
 // @Description start to match
@@ -482,3 +781,258 @@ func (api *API) ExitGame(c *gin.Context) {
 	}
 	response.GinSuccess(c, nil)
 }
+
+// OpenBackfill godoc
+// @Summary open backfill
+// @Description open slots of a team in an in-progress room to be filled by the matching players
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param OpenBackfillReq body OpenBackfillReq true "Open Backfill Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/open_backfill [post]
+func (api *API) OpenBackfill(c *gin.Context) {
+	var req OpenBackfillReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.OpenBackfill(c.Request.Context(), req.RoomID, req.TeamID, req.Slots); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// AcceptMatch godoc
+// @Summary accept match
+// @Description accept the matched room in ready check
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param AcceptMatchReq body AcceptMatchReq true "Accept Match Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/accept_match [post]
+func (api *API) AcceptMatch(c *gin.Context) {
+	var req AcceptMatchReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.AcceptMatch(c.Request.Context(), req.UID, req.RoomID); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// DeclineMatch godoc
+// @Summary decline match
+// @Description decline the matched room in ready check
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param DeclineMatchReq body DeclineMatchReq true "Decline Match Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/decline_match [post]
+func (api *API) DeclineMatch(c *gin.Context) {
+	var req DeclineMatchReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.DeclineMatch(c.Request.Context(), req.UID, req.RoomID); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// Spectate godoc
+// @Summary spectate
+// @Description watch the game of a matched room as a spectator
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param SpectateReq body SpectateReq true "Spectate Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/spectate [post]
+func (api *API) Spectate(c *gin.Context) {
+	var req SpectateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.Spectate(c.Request.Context(), req.UID, req.RoomID); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// ExitSpectate godoc
+// @Summary exit spectate
+// @Description leave the room as a spectator
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param ExitSpectateReq body ExitSpectateReq true "Exit Spectate Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/exit_spectate [post]
+func (api *API) ExitSpectate(c *gin.Context) {
+	var req ExitSpectateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.ExitSpectate(c.Request.Context(), req.UID, req.RoomID); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// CreateCustomRoom godoc
+// @Summary create custom room
+// @Description create a custom room with manual team setup, the group of the captain is placed in the first team slot
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param CreateCustomRoomReq body CreateCustomRoomReq true "Create Custom Room Request Body"
+// @Success 200 {object} CreateCustomRoomRsp
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/create_custom_room [post]
+func (api *API) CreateCustomRoom(c *gin.Context) {
+	var req CreateCustomRoomReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	r, err := api.MS.CreateCustomRoom(c.Request.Context(), req.UID, &pto.CreateCustomRoom{
+		TeamLimit:       req.TeamLimit,
+		TeamPlayerLimit: req.TeamPlayerLimit,
+		Password:        req.Password,
+	})
+	if err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, CreateCustomRoomRsp{RoomID: r.ID(), Code: r.Base().Custom.Code})
+}
+
+// JoinCustomRoom godoc
+// @Summary join custom room
+// @Description join a custom room by the invite code with the whole group
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param JoinCustomRoomReq body JoinCustomRoomReq true "Join Custom Room Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/join_custom_room [post]
+func (api *API) JoinCustomRoom(c *gin.Context) {
+	var req JoinCustomRoomReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.JoinCustomRoom(c.Request.Context(), req.UID, req.Code, req.Password); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// LeaveCustomRoom godoc
+// @Summary leave custom room
+// @Description leave the custom room with the whole group, the room is dissolved if the owner leaves
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param LeaveCustomRoomReq body LeaveCustomRoomReq true "Leave Custom Room Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/leave_custom_room [post]
+func (api *API) LeaveCustomRoom(c *gin.Context) {
+	var req LeaveCustomRoomReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.LeaveCustomRoom(c.Request.Context(), req.UID, req.RoomID); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// MoveCustomRoomPlayer godoc
+// @Summary move custom room player
+// @Description move the group of the target player to another team slot of the custom room
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param MoveCustomRoomPlayerReq body MoveCustomRoomPlayerReq true "Move Custom Room Player Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/move_custom_room_player [post]
+func (api *API) MoveCustomRoomPlayer(c *gin.Context) {
+	var req MoveCustomRoomPlayerReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.MoveCustomRoomPlayer(c.Request.Context(), req.UID, req.TargetUID, req.RoomID, req.Slot); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
+
+// StartCustomRoom godoc
+// @Summary start custom room
+// @Description start the game of the custom room once every team slot has players
+// @Tags match service
+// @Accept json
+// @Produce json
+// @Param x-request-id header string false "Request ID"
+// @Param StartCustomRoomReq body StartCustomRoomReq true "Start Custom Room Request Body"
+// @Success 200 {object} string "ok"
+// @Failure 400 {object} string "Bad Request"
+// @Failure 200 {object} string "Concrete Error Msg"
+// @Router /match/start_custom_room [post]
+func (api *API) StartCustomRoom(c *gin.Context) {
+	var req StartCustomRoomReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.GinParamError(c, err)
+		return
+	}
+	if err := api.MS.StartCustomRoom(c.Request.Context(), req.UID, req.RoomID); err != nil {
+		response.GinError(c, err)
+		return
+	}
+	response.GinSuccess(c, nil)
+}
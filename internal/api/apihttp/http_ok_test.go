@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -235,6 +236,27 @@ func Test_HTTP_ShouldWork(t *testing.T) {
 	assert.Equal(t, entry.GroupStateDissolved, getGroupStateWithLock(g5))
 }
 
+func Test_HTTP_QueueMetrics(t *testing.T) {
+	inner, shutdown := internalapi.Start(newConf(2))
+	defer shutdown()
+
+	api := API{inner}
+	router := api.setupRouter()
+
+	_ = requestCreateGroup(router, "a", t)
+	requestStartMatch(router, "a", t)
+
+	assert.Eventually(t, func() bool {
+		req, _ := http.NewRequest("GET", "/metrics", http.NoBody)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		body := w.Body.String()
+		return strings.Contains(body, "match_queue_depth{") &&
+			strings.Contains(body, `queue_key="905-1"`) &&
+			strings.Contains(body, "match_tick_seconds_bucket")
+	}, time.Second, 10*time.Millisecond)
+}
+
 func getGroupStateWithLock(g entry.Group) entry.GroupState {
 	g.Base().Lock()
 	defer g.Base().Unlock()
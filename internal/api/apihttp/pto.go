@@ -3,6 +3,7 @@ package apihttp
 import (
 	"github.com/hedon954/go-matcher/internal/entry"
 	"github.com/hedon954/go-matcher/internal/pto"
+	"github.com/hedon954/go-matcher/pkg/algorithm/glicko2"
 )
 
 type CreateGroupRsp struct {
@@ -24,6 +25,67 @@ type ExitGameReq struct {
 	RoomID int64  `json:"room_id" binding:"required"`
 }
 
+type OpenBackfillReq struct {
+	RoomID int64 `json:"room_id" binding:"required"`
+	TeamID int64 `json:"team_id" binding:"required"`
+	Slots  int   `json:"slots" binding:"required"`
+}
+
+type AcceptMatchReq struct {
+	UID    string `json:"uid" binding:"required"`
+	RoomID int64  `json:"room_id" binding:"required"`
+}
+
+type DeclineMatchReq struct {
+	UID    string `json:"uid" binding:"required"`
+	RoomID int64  `json:"room_id" binding:"required"`
+}
+
+type SpectateReq struct {
+	UID    string `json:"uid" binding:"required"`
+	RoomID int64  `json:"room_id" binding:"required"`
+}
+
+type ExitSpectateReq struct {
+	UID    string `json:"uid" binding:"required"`
+	RoomID int64  `json:"room_id" binding:"required"`
+}
+
+type CreateCustomRoomReq struct {
+	UID             string `json:"uid" binding:"required"`
+	TeamLimit       int    `json:"team_limit" binding:"required,gt=0"`
+	TeamPlayerLimit int    `json:"team_player_limit" binding:"required,gt=0"`
+	Password        string `json:"password"`
+}
+
+type CreateCustomRoomRsp struct {
+	RoomID int64  `json:"room_id,omitempty"`
+	Code   string `json:"code,omitempty"`
+}
+
+type JoinCustomRoomReq struct {
+	UID      string `json:"uid" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+	Password string `json:"password"`
+}
+
+type LeaveCustomRoomReq struct {
+	UID    string `json:"uid" binding:"required"`
+	RoomID int64  `json:"room_id" binding:"required"`
+}
+
+type MoveCustomRoomPlayerReq struct {
+	UID       string `json:"uid" binding:"required"`
+	TargetUID string `json:"target_uid" binding:"required"`
+	RoomID    int64  `json:"room_id" binding:"required"`
+	Slot      int    `json:"slot" binding:"required,gt=0"`
+}
+
+type StartCustomRoomReq struct {
+	UID    string `json:"uid" binding:"required"`
+	RoomID int64  `json:"room_id" binding:"required"`
+}
+
 type KickPlayerReq struct {
 	CaptainUID string `json:"captain_uid" binding:"required"`
 	KickedUID  string `json:"kicked_uid" binding:"required"`
@@ -53,6 +115,15 @@ type RefuseInviteReq struct {
 	RefuseMsg  string `json:"refuse_msg"`
 }
 
+type RevokeInviteReq struct {
+	InviterUID string `json:"inviter_uid" binding:"required"`
+	InviteeUID string `json:"invitee_uid" binding:"required"`
+}
+
+type PendingInvitesRsp struct {
+	Invites []*pto.PendingInvite `json:"invites"`
+}
+
 type SetNearbyJoinGroupReq struct {
 	CaptainUID string `json:"captain_uid" binding:"required"`
 	Allow      bool   `json:"allow"`
@@ -63,12 +134,89 @@ type SetRecentJoinGroupReq struct {
 	Allow      bool   `json:"allow"`
 }
 
+type SetFriendJoinGroupReq struct {
+	CaptainUID string `json:"captain_uid" binding:"required"`
+	Allow      bool   `json:"allow"`
+}
+
+type SetWorldChannelJoinGroupReq struct {
+	CaptainUID string `json:"captain_uid" binding:"required"`
+	Allow      bool   `json:"allow"`
+}
+
+type SetClanChannelJoinGroupReq struct {
+	CaptainUID string `json:"captain_uid" binding:"required"`
+	Allow      bool   `json:"allow"`
+}
+
+type ReportLocationReq struct {
+	UID       string  `json:"uid" binding:"required"`
+	Latitude  float64 `json:"latitude" binding:"gte=-90,lte=90"`
+	Longitude float64 `json:"longitude" binding:"gte=-180,lte=180"`
+}
+
+type JoinableGroupsRsp struct {
+	Groups []*pto.JoinableGroup `json:"groups"`
+}
+
+type ShareGroupRsp struct {
+	Token    string `json:"token,omitempty"`
+	ExpireAt int64  `json:"expire_at,omitempty"`
+}
+
 type SetVoiceStateReq struct {
 	UID   string                 `json:"uid" binding:"required"`
 	State entry.PlayerVoiceState `json:"state" binding:"gte=0,lte=1"`
 }
 
+type SendGroupMessageReq struct {
+	UID     string               `json:"uid" binding:"required"`
+	Type    pto.GroupMessageType `json:"type" binding:"required"`
+	Content string               `json:"content" binding:"required"`
+}
+
 type UploadPlayerAttrReq struct {
 	UID string `json:"uid" binding:"required"`
 	pto.UploadPlayerAttr
 }
+
+type AdminIDReq struct {
+	ID int64 `uri:"id" binding:"required"`
+}
+
+type DrainReq struct {
+	TimeoutSec int64 `json:"timeout_sec" binding:"gte=0"`
+}
+
+type QueueInfo struct {
+	Key          string            `json:"key"`
+	Paused       bool              `json:"paused"`
+	DrainUntil   int64             `json:"drain_until,omitempty"`
+	NormalGroups int               `json:"normal_groups"`
+	TeamGroups   int               `json:"team_groups"`
+	Players      int               `json:"players"`
+	MaxWaitSec   int64             `json:"max_wait_sec"`
+	NormalWait   glicko2.WaitStats `json:"normal_wait"`
+	TeamWait     glicko2.WaitStats `json:"team_wait"`
+}
+
+type ListQueuesRsp struct {
+	Paused bool `json:"paused"`
+	// DrainUntil is the deadline of draining all the queues in unix seconds, 0 means not draining
+	DrainUntil int64        `json:"drain_until,omitempty"`
+	Queues     []*QueueInfo `json:"queues"`
+}
+
+type QueueGroupInfo struct {
+	GroupID int64    `json:"group_id"`
+	Queue   string   `json:"queue"`
+	Type    string   `json:"type"`
+	MMR     float64  `json:"mmr"`
+	Star    int      `json:"star"`
+	Players []string `json:"players"`
+	WaitSec int64    `json:"wait_sec"`
+}
+
+type ListQueueGroupsRsp struct {
+	Groups []*QueueGroupInfo `json:"groups"`
+}
@@ -1,7 +1,6 @@
 package apitcp
 
 import (
-	"context"
 	"errors"
 	"fmt"
 
@@ -14,6 +13,8 @@ import (
 	"github.com/hedon954/go-matcher/pkg/typeconv"
 	"github.com/hedon954/go-matcher/pkg/zinx/ziface"
 
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -35,7 +36,7 @@ func (api *API) CreateGroup(request ziface.IRequest) {
 		PlayerInfo: playerInfoFromPBToPTO(data.PlayerInfo),
 	}
 
-	group, err := api.MS.CreateGroup(context.Background(), param)
+	group, err := api.MS.CreateGroup(request.Context(), param)
 	fmt.Println("------------------>", group, err)
 	if err != nil {
 		api.responseError(request, err)
@@ -61,9 +62,10 @@ func (api *API) EnterGroup(request ziface.IRequest) {
 	param := &pto.EnterGroup{
 		PlayerInfo: playerInfoFromPBToPTO(data.PlayerInfo),
 		Source:     pto.EnterGroupSourceType(data.Source),
+		ShareToken: data.ShareToken,
 	}
 
-	if err := api.MS.EnterGroup(context.Background(), param, data.GroupId); err != nil {
+	if err := api.MS.EnterGroup(request.Context(), param, data.GroupId); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -74,7 +76,7 @@ func (api *API) EnterGroup(request ziface.IRequest) {
 func (api *API) ExitGroup(request ziface.IRequest) {
 	param := typeconv.MustFromProto[pb.ExitGroupReq](request.GetData())
 
-	if err := api.MS.ExitGroup(context.Background(), param.Uid); err != nil {
+	if err := api.MS.ExitGroup(request.Context(), param.Uid); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -85,7 +87,7 @@ func (api *API) ExitGroup(request ziface.IRequest) {
 func (api *API) DissolveGroup(request ziface.IRequest) {
 	param := typeconv.MustFromProto[pb.DissolveGroupReq](request.GetData())
 
-	if err := api.MS.DissolveGroup(context.Background(), param.Uid); err != nil {
+	if err := api.MS.DissolveGroup(request.Context(), param.Uid); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -99,7 +101,7 @@ func (api *API) KickPlayer(request ziface.IRequest) {
 		api.responseParamError(request, errors.New("lack of kicked uid"))
 		return
 	}
-	if err := api.MS.KickPlayer(context.Background(), param.CaptainUid, param.KickedUid); err != nil {
+	if err := api.MS.KickPlayer(request.Context(), param.CaptainUid, param.KickedUid); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -110,7 +112,7 @@ func (api *API) KickPlayer(request ziface.IRequest) {
 func (api *API) ChangeRole(request ziface.IRequest) {
 	param := typeconv.MustFromProto[pb.ChangeRoleReq](request.GetData())
 
-	if err := api.MS.ChangeRole(context.Background(),
+	if err := api.MS.ChangeRole(request.Context(),
 		param.CaptainUid, param.TargetUid, entry.GroupRole(param.Role)); err != nil {
 		api.responseError(request, err)
 		return
@@ -125,7 +127,7 @@ func (api *API) Invite(request ziface.IRequest) {
 		api.responseParamError(request, errors.New("lack of invitee uid"))
 		return
 	}
-	if err := api.MS.Invite(context.Background(), param.InviterUid, param.InviteeUid); err != nil {
+	if err := api.MS.Invite(request.Context(), param.InviterUid, param.InviteeUid); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -147,7 +149,7 @@ func (api *API) AcceptInvite(request ziface.IRequest) {
 
 	inviteInfo := playerInfoFromPBToPTO(param.InviteeInfo)
 
-	if err := api.MS.AcceptInvite(context.Background(), param.InviterUid, &inviteInfo, param.GroupId); err != nil {
+	if err := api.MS.AcceptInvite(request.Context(), param.InviterUid, &inviteInfo, param.GroupId); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -163,15 +165,53 @@ func (api *API) RefuseInvite(request ziface.IRequest) {
 		return
 	}
 
-	api.MS.RefuseInvite(context.Background(), param.InviterUid, param.InviteeUid, param.GroupId, param.RefuseMsg)
+	api.MS.RefuseInvite(request.Context(), param.InviterUid, param.InviteeUid, param.GroupId, param.RefuseMsg)
 
 	api.responseSuccess(request, &pb.RefuseInviteRsp{})
 }
 
+func (api *API) RevokeInvite(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.RevokeInviteReq](request.GetData())
+	if param.InviteeUid == "" {
+		api.responseParamError(request, errors.New("lack of invitee uid"))
+		return
+	}
+	if err := api.MS.RevokeInvite(request.Context(), param.InviterUid, param.InviteeUid); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.RevokeInviteRsp{})
+}
+
+func (api *API) ListReceivedInvites(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.ListReceivedInvitesReq](request.GetData())
+
+	invites, err := api.MS.ListReceivedInvites(request.Context(), param.Uid)
+	if err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.ListReceivedInvitesRsp{Invites: pendingInvitesFromPTOToPB(invites)})
+}
+
+func (api *API) ListSentInvites(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.ListSentInvitesReq](request.GetData())
+
+	invites, err := api.MS.ListSentInvites(request.Context(), param.Uid)
+	if err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.ListSentInvitesRsp{Invites: pendingInvitesFromPTOToPB(invites)})
+}
+
 func (api *API) SetNearbyJoinGroup(request ziface.IRequest) {
 	param := typeconv.MustFromProto[pb.SetNearbyJoinGroupReq](request.GetData())
 
-	if err := api.MS.SetNearbyJoinGroup(context.Background(), param.Uid, param.Allow); err != nil {
+	if err := api.MS.SetNearbyJoinGroup(request.Context(), param.Uid, param.Allow); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -182,7 +222,7 @@ func (api *API) SetNearbyJoinGroup(request ziface.IRequest) {
 func (api *API) SetRecentJoinGroup(request ziface.IRequest) {
 	param := typeconv.MustFromProto[pb.SetRecentJoinGroupReq](request.GetData())
 
-	if err := api.MS.SetRecentJoinGroup(context.Background(), param.Uid, param.Allow); err != nil {
+	if err := api.MS.SetRecentJoinGroup(request.Context(), param.Uid, param.Allow); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -190,6 +230,86 @@ func (api *API) SetRecentJoinGroup(request ziface.IRequest) {
 	api.responseSuccess(request, &pb.SetRecentJoinGroupRsp{})
 }
 
+func (api *API) SetFriendJoinGroup(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.SetFriendJoinGroupReq](request.GetData())
+
+	if err := api.MS.SetFriendJoinGroup(request.Context(), param.Uid, param.Allow); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.SetFriendJoinGroupRsp{})
+}
+
+func (api *API) SetWorldChannelJoinGroup(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.SetWorldChannelJoinGroupReq](request.GetData())
+
+	if err := api.MS.SetWorldChannelJoinGroup(request.Context(), param.Uid, param.Allow); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.SetWorldChannelJoinGroupRsp{})
+}
+
+func (api *API) SetClanChannelJoinGroup(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.SetClanChannelJoinGroupReq](request.GetData())
+
+	if err := api.MS.SetClanChannelJoinGroup(request.Context(), param.Uid, param.Allow); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.SetClanChannelJoinGroupRsp{})
+}
+
+func (api *API) ShareGroup(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.ShareGroupReq](request.GetData())
+
+	token, expireAt, err := api.MS.ShareGroup(request.Context(), param.Uid)
+	if err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.ShareGroupRsp{Token: token, ExpireAt: expireAt})
+}
+
+func (api *API) ReportLocation(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.ReportLocationReq](request.GetData())
+
+	if err := api.MS.ReportLocation(request.Context(), param.Uid, param.Latitude, param.Longitude); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.ReportLocationRsp{})
+}
+
+func (api *API) NearbyGroups(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.NearbyGroupsReq](request.GetData())
+
+	groups, err := api.MS.NearbyGroups(request.Context(), param.Uid)
+	if err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.NearbyGroupsRsp{Groups: joinableGroupsFromPTOToPB(groups)})
+}
+
+func (api *API) RecentGroups(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.RecentGroupsReq](request.GetData())
+
+	groups, err := api.MS.RecentGroups(request.Context(), param.Uid)
+	if err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.RecentGroupsRsp{Groups: joinableGroupsFromPTOToPB(groups)})
+}
+
 func (api *API) SetVoiceState(request ziface.IRequest) {
 	param := typeconv.MustFromProto[pb.SetVoiceStateReq](request.GetData())
 
@@ -199,7 +319,7 @@ func (api *API) SetVoiceState(request ziface.IRequest) {
 		return
 	}
 
-	if err := api.MS.SetVoiceState(context.Background(), param.Uid, state); err != nil {
+	if err := api.MS.SetVoiceState(request.Context(), param.Uid, state); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -207,10 +327,22 @@ func (api *API) SetVoiceState(request ziface.IRequest) {
 	api.responseSuccess(request, &pb.SetVoiceStateRsp{})
 }
 
+func (api *API) SendGroupMessage(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.SendGroupMessageReq](request.GetData())
+
+	err := api.MS.SendGroupMessage(request.Context(), param.Uid, pto.GroupMessageType(param.Type), param.Content)
+	if err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.SendGroupMessageRsp{})
+}
+
 func (api *API) StartMatch(request ziface.IRequest) {
 	param := typeconv.MustFromProto[pb.StartMatchReq](request.GetData())
 
-	if err := api.MS.StartMatch(context.Background(), param.Uid); err != nil {
+	if err := api.MS.StartMatch(request.Context(), param.Uid); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -221,7 +353,7 @@ func (api *API) StartMatch(request ziface.IRequest) {
 func (api *API) CancelMatch(request ziface.IRequest) {
 	param := typeconv.MustFromProto[pb.CancelMatchReq](request.GetData())
 
-	if err := api.MS.CancelMatch(context.Background(), param.Uid); err != nil {
+	if err := api.MS.CancelMatch(request.Context(), param.Uid); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -232,7 +364,7 @@ func (api *API) CancelMatch(request ziface.IRequest) {
 func (api *API) Ready(request ziface.IRequest) {
 	param := typeconv.MustFromProto[pb.ReadyReq](request.GetData())
 
-	if err := api.MS.Ready(context.Background(), param.Uid); err != nil {
+	if err := api.MS.Ready(request.Context(), param.Uid); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -243,7 +375,7 @@ func (api *API) Ready(request ziface.IRequest) {
 func (api *API) Unready(request ziface.IRequest) {
 	param := typeconv.MustFromProto[pb.UnreadyReq](request.GetData())
 
-	if err := api.MS.Unready(context.Background(), param.Uid); err != nil {
+	if err := api.MS.Unready(request.Context(), param.Uid); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -263,7 +395,7 @@ func (api *API) UploadPlayerAttr(request ziface.IRequest) {
 		extra, _ = proto.Marshal(param.GetGoatGameAttr())
 	}
 
-	if err := api.MS.UploadPlayerAttr(context.Background(), param.Uid, &pto.UploadPlayerAttr{
+	if err := api.MS.UploadPlayerAttr(request.Context(), param.Uid, &pto.UploadPlayerAttr{
 		Attribute: pto.Attribute{
 			Nickname: param.Attr.Nickname,
 			Avatar:   param.Attr.Avatar,
@@ -285,7 +417,7 @@ func (api *API) ExitGame(request ziface.IRequest) {
 		return
 	}
 
-	if err := api.MS.ExitGame(context.Background(), param.Uid, param.RoomId); err != nil {
+	if err := api.MS.ExitGame(request.Context(), param.Uid, param.RoomId); err != nil {
 		api.responseError(request, err)
 		return
 	}
@@ -293,13 +425,177 @@ func (api *API) ExitGame(request ziface.IRequest) {
 	api.responseSuccess(request, &pb.ExitGameRsp{})
 }
 
+func (api *API) OpenBackfill(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.OpenBackfillReq](request.GetData())
+	if param.RoomId == 0 {
+		api.responseParamError(request, errors.New("lack of room id"))
+		return
+	}
+	if param.TeamId == 0 {
+		api.responseParamError(request, errors.New("lack of team id"))
+		return
+	}
+
+	if err := api.MS.OpenBackfill(request.Context(), param.RoomId, param.TeamId, int(param.Slots)); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.OpenBackfillRsp{})
+}
+
+func (api *API) AcceptMatch(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.AcceptMatchReq](request.GetData())
+	if param.RoomId == 0 {
+		api.responseParamError(request, errors.New("lack of room id"))
+		return
+	}
+
+	if err := api.MS.AcceptMatch(request.Context(), param.Uid, param.RoomId); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.AcceptMatchRsp{})
+}
+
+func (api *API) DeclineMatch(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.DeclineMatchReq](request.GetData())
+	if param.RoomId == 0 {
+		api.responseParamError(request, errors.New("lack of room id"))
+		return
+	}
+
+	if err := api.MS.DeclineMatch(request.Context(), param.Uid, param.RoomId); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.DeclineMatchRsp{})
+}
+
+func (api *API) Spectate(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.SpectateReq](request.GetData())
+	if param.RoomId == 0 {
+		api.responseParamError(request, errors.New("lack of room id"))
+		return
+	}
+
+	if err := api.MS.Spectate(request.Context(), param.Uid, param.RoomId); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.SpectateRsp{})
+}
+
+func (api *API) ExitSpectate(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.ExitSpectateReq](request.GetData())
+	if param.RoomId == 0 {
+		api.responseParamError(request, errors.New("lack of room id"))
+		return
+	}
+
+	if err := api.MS.ExitSpectate(request.Context(), param.Uid, param.RoomId); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.ExitSpectateRsp{})
+}
+
+func (api *API) CreateCustomRoom(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.CreateCustomRoomReq](request.GetData())
+	if param.TeamLimit <= 0 || param.TeamPlayerLimit <= 0 {
+		api.responseParamError(request, errors.New("invalid team limit"))
+		return
+	}
+
+	r, err := api.MS.CreateCustomRoom(request.Context(), param.Uid, &pto.CreateCustomRoom{
+		TeamLimit:       int(param.TeamLimit),
+		TeamPlayerLimit: int(param.TeamPlayerLimit),
+		Password:        param.Password,
+	})
+	if err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.CreateCustomRoomRsp{RoomId: r.ID(), Code: r.Base().Custom.Code})
+}
+
+func (api *API) JoinCustomRoom(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.JoinCustomRoomReq](request.GetData())
+	if param.Code == "" {
+		api.responseParamError(request, errors.New("lack of code"))
+		return
+	}
+
+	if err := api.MS.JoinCustomRoom(request.Context(), param.Uid, param.Code, param.Password); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.JoinCustomRoomRsp{})
+}
+
+func (api *API) LeaveCustomRoom(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.LeaveCustomRoomReq](request.GetData())
+	if param.RoomId == 0 {
+		api.responseParamError(request, errors.New("lack of room id"))
+		return
+	}
+
+	if err := api.MS.LeaveCustomRoom(request.Context(), param.Uid, param.RoomId); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.LeaveCustomRoomRsp{})
+}
+
+func (api *API) MoveCustomRoomPlayer(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.MoveCustomRoomPlayerReq](request.GetData())
+	if param.RoomId == 0 {
+		api.responseParamError(request, errors.New("lack of room id"))
+		return
+	}
+
+	if err := api.MS.MoveCustomRoomPlayer(request.Context(), param.Uid, param.TargetUid,
+		param.RoomId, int(param.Slot)); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.MoveCustomRoomPlayerRsp{})
+}
+
+func (api *API) StartCustomRoom(request ziface.IRequest) {
+	param := typeconv.MustFromProto[pb.StartCustomRoomReq](request.GetData())
+	if param.RoomId == 0 {
+		api.responseParamError(request, errors.New("lack of room id"))
+		return
+	}
+
+	if err := api.MS.StartCustomRoom(request.Context(), param.Uid, param.RoomId); err != nil {
+		api.responseError(request, err)
+		return
+	}
+
+	api.responseSuccess(request, &pb.StartCustomRoomRsp{})
+}
+
 func (api *API) createAndSendResponse(req ziface.IRequest, code pb.RspCode, err error) {
+	span := trace.SpanFromContext(req.Context())
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
 	rsp := &pb.CommonRsp{
 		Code:      code,
 		Message:   err.Error(),
 		ReqType:   pb.ReqType(req.GetMsgID()),
 		RequestId: "",
-		TraceId:   "",
+		TraceId:   traceID(req),
 		Data:      nil,
 	}
 	bs, err := proto.Marshal(rsp)
@@ -334,7 +630,7 @@ func (api *API) responseSuccess(request ziface.IRequest, p proto.Message) {
 		Message:   "",
 		ReqType:   pb.ReqType(request.GetMsgID()),
 		RequestId: "",
-		TraceId:   "",
+		TraceId:   traceID(request),
 		Data:      bs,
 	}
 
@@ -359,14 +655,53 @@ func playerInfoFromPBToPTO(pInfo *pb.PlayerInfo) pto.PlayerInfo {
 		ModeVersion: pInfo.ModeVersion,
 		Star:        pInfo.Star,
 		Rank:        pInfo.Rank,
+		RegionPings: pInfo.RegionPings,
 		Glicko2Info: &pto.Glicko2Info{
-			MMR:  pInfo.Glicko2Info.Mmr,
-			Star: pInfo.Glicko2Info.Star,
-			Rank: pInfo.Glicko2Info.Rank,
+			MMR:              pInfo.Glicko2Info.Mmr,
+			Star:             pInfo.Glicko2Info.Star,
+			Rank:             pInfo.Glicko2Info.Rank,
+			RD:               pInfo.Glicko2Info.Rd,
+			AccountCreateSec: pInfo.Glicko2Info.AccountCreateSec,
+			MatchCount:       pInfo.Glicko2Info.MatchCount,
 		},
 	}
 }
 
+func pendingInvitesFromPTOToPB(invites []*pto.PendingInvite) []*pb.PendingInvite {
+	res := make([]*pb.PendingInvite, 0, len(invites))
+	for _, i := range invites {
+		res = append(res, &pb.PendingInvite{
+			GroupId:     i.GroupID,
+			InviterUid:  i.InviterUID,
+			InviteeUid:  i.InviteeUID,
+			GameMode:    pb.GameMode(i.GameMode),
+			ModeVersion: i.ModeVersion,
+			CreateSec:   i.CreateSec,
+			ExpireSec:   i.ExpireSec,
+		})
+	}
+	return res
+}
+
+func joinableGroupsFromPTOToPB(groups []*pto.JoinableGroup) []*pb.JoinableGroup {
+	res := make([]*pb.JoinableGroup, 0, len(groups))
+	for _, g := range groups {
+		res = append(res, &pb.JoinableGroup{
+			GroupId:     g.GroupID,
+			Captain:     g.Captain,
+			GameMode:    pb.GameMode(g.GameMode),
+			ModeVersion: g.ModeVersion,
+			PlayerCount: int32(g.PlayerCount),
+			PlayerLimit: int32(g.PlayerLimit),
+			Uid:         g.UID,
+			DistanceKm:  g.DistanceKm,
+			Relation:    int32(g.Relation),
+			PlayedAtSec: g.PlayedAtSec,
+		})
+	}
+	return res
+}
+
 func checkPlayerInfo(info *pb.PlayerInfo) error {
 	if info == nil {
 		return errors.New("lack of player info")
@@ -28,22 +28,43 @@ func SetupTCPServer(
 }
 
 func (api *API) setupRouter(s ziface.IServer) {
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_CREATE_GROUP), api.CreateGroup)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_ENTER_GROUP), api.EnterGroup)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_EXIT_GROUP), api.ExitGroup)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_DISSOLVE_GROUP), api.DissolveGroup)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_KICK_PLAYER), api.KickPlayer)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_CHANGE_ROLE), api.ChangeRole)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_INVITE), api.Invite)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_ACCEPT_INVITE), api.AcceptInvite)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_REFUSE_INVITE), api.RefuseInvite)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_SET_NEARBY_JOIN_GROUP), api.SetNearbyJoinGroup)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_SET_RECENT_JOIN_GROUP), api.SetRecentJoinGroup)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_SET_VOICE_STATE), api.SetVoiceState)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_START_MATCH), api.StartMatch)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_CANCEL_MATCH), api.CancelMatch)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_READY), api.Ready)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_UNREADY), api.Unready)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_UPLOAD_PLAYER_ATTR), api.UploadPlayerAttr)
-	s.AddRouter(uint32(pb.ReqType_REQ_TYPE_EXIT_GAME), api.ExitGame)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_CREATE_GROUP, api.CreateGroup)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_ENTER_GROUP, api.EnterGroup)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_EXIT_GROUP, api.ExitGroup)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_DISSOLVE_GROUP, api.DissolveGroup)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_KICK_PLAYER, api.KickPlayer)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_CHANGE_ROLE, api.ChangeRole)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_INVITE, api.Invite)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_ACCEPT_INVITE, api.AcceptInvite)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_REFUSE_INVITE, api.RefuseInvite)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_REVOKE_INVITE, api.RevokeInvite)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_LIST_RECEIVED_INVITES, api.ListReceivedInvites)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_LIST_SENT_INVITES, api.ListSentInvites)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_SET_NEARBY_JOIN_GROUP, api.SetNearbyJoinGroup)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_SET_RECENT_JOIN_GROUP, api.SetRecentJoinGroup)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_SET_FRIEND_JOIN_GROUP, api.SetFriendJoinGroup)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_SET_WORLD_CHANNEL_JOIN_GROUP, api.SetWorldChannelJoinGroup)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_SET_CLAN_CHANNEL_JOIN_GROUP, api.SetClanChannelJoinGroup)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_SHARE_GROUP, api.ShareGroup)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_REPORT_LOCATION, api.ReportLocation)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_NEARBY_GROUPS, api.NearbyGroups)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_RECENT_GROUPS, api.RecentGroups)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_SET_VOICE_STATE, api.SetVoiceState)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_SEND_GROUP_MESSAGE, api.SendGroupMessage)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_START_MATCH, api.StartMatch)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_CANCEL_MATCH, api.CancelMatch)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_READY, api.Ready)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_UNREADY, api.Unready)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_UPLOAD_PLAYER_ATTR, api.UploadPlayerAttr)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_EXIT_GAME, api.ExitGame)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_OPEN_BACKFILL, api.OpenBackfill)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_ACCEPT_MATCH, api.AcceptMatch)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_DECLINE_MATCH, api.DeclineMatch)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_SPECTATE, api.Spectate)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_EXIT_SPECTATE, api.ExitSpectate)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_CREATE_CUSTOM_ROOM, api.CreateCustomRoom)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_JOIN_CUSTOM_ROOM, api.JoinCustomRoom)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_LEAVE_CUSTOM_ROOM, api.LeaveCustomRoom)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_MOVE_CUSTOM_ROOM_PLAYER, api.MoveCustomRoomPlayer)
+	api.addRouter(s, pb.ReqType_REQ_TYPE_START_CUSTOM_ROOM, api.StartCustomRoom)
 }
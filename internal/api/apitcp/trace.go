@@ -0,0 +1,42 @@
+package apitcp
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hedon954/go-matcher/internal/pb"
+	"github.com/hedon954/go-matcher/pkg/zinx/ziface"
+)
+
+const tracerName = "go-matcher/tcp"
+
+// addRouter adds the handle of the request type to the server, and traces the requests of it.
+func (api *API) addRouter(s ziface.IServer, reqType pb.ReqType, handle ziface.HandleFunc) {
+	s.AddRouter(uint32(reqType), traced(reqType, handle))
+}
+
+// traced extracts the trace context from the metadata of the request,
+// and starts a server span for the handle as HTTP does in apm.GinOtel.
+func traced(reqType pb.ReqType, handle ziface.HandleFunc) ziface.HandleFunc {
+	return func(request ziface.IRequest) {
+		ctx := otel.GetTextMapPropagator().Extract(request.Context(), propagation.MapCarrier(request.GetMeta()))
+		ctx, span := otel.Tracer(tracerName).Start(ctx, "TCP "+reqType.String(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("tcp.remote_addr", request.GetConnection().RemoteAddr().String())),
+		)
+		defer span.End()
+		request.SetContext(ctx)
+		handle(request)
+	}
+}
+
+// traceID returns the trace id of the request, it is empty if the request is not traced.
+func traceID(request ziface.IRequest) string {
+	sc := trace.SpanContextFromContext(request.Context())
+	if !sc.HasTraceID() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
@@ -0,0 +1,140 @@
+package apitcp
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/pb"
+	"github.com/hedon954/go-matcher/pkg/zinx/znet"
+
+	internalapi "github.com/hedon954/go-matcher/internal/api"
+)
+
+func Test_TCP_Trace(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp, prop := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer func() {
+		otel.SetTracerProvider(tp)
+		otel.SetTextMapPropagator(prop)
+	}()
+
+	inner, shutdown := internalapi.Start(newConf(2))
+	defer shutdown()
+	api := &API{inner}
+	server, p := startServer()
+	api.setupRouter(server)
+	defer server.Stop()
+	conn := startClient(p)
+	defer func() { _ = conn.Close() }()
+
+	// 1. the request without trace context should be traced as a new trace
+	rsp := requestTraced(conn, pb.ReqType_REQ_TYPE_CREATE_GROUP,
+		&pb.CreateGroupReq{PlayerInfo: newPlayerInfo(UIDA)}, "", t)
+	assert.Equal(t, pb.RspCode_RSP_CODE_SUCCESS, rsp.Code)
+	assert.Len(t, rsp.TraceId, 32)
+
+	// 2. the request with trace context should be traced as a child of it, even if it fails
+	traceparent := "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	rsp = requestTraced(conn, pb.ReqType_REQ_TYPE_CANCEL_MATCH, &pb.CancelMatchReq{Uid: UIDA}, traceparent, t)
+	assert.Equal(t, pb.RspCode_RSP_CODE_USER_ERROR, rsp.Code)
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", rsp.TraceId)
+
+	// 3. the groups starting to match from different requests are matched into a room
+	// 'a' -> g1 -> 1
+	// 'b' -> g2 -> 2
+	// 'c' -> g3 -> 2
+	// 'd' -> g4 -> 1
+	requestCreateFullGroup(conn, UIDB, UIDBB, t)
+	requestCreateFullGroup(conn, UIDC, UIDCC, t)
+	requestCreateGroup(conn, UIDD, t)
+	traceIDs := make(map[trace.TraceID]struct{}, 4)
+	for i, uid := range []string{UIDA, UIDB, UIDC, UIDD} {
+		traceparent := fmt.Sprintf("00-%032x-%016x-01", i+1, i+1)
+		rsp = requestTraced(conn, pb.ReqType_REQ_TYPE_START_MATCH, &pb.StartMatchReq{Uid: uid}, traceparent, t)
+		assert.Equal(t, pb.RspCode_RSP_CODE_SUCCESS, rsp.Code)
+
+		g := api.GM.Get(api.PM.Get(uid).Base().GroupID)
+		assert.Equal(t, rsp.TraceId, g.Base().SpanCtx.TraceID().String())
+		traceIDs[g.Base().SpanCtx.TraceID()] = struct{}{}
+	}
+
+	spans := func(name string) []sdktrace.ReadOnlySpan {
+		res := make([]sdktrace.ReadOnlySpan, 0)
+		for _, s := range sr.Ended() {
+			if s.Name() == name {
+				res = append(res, s)
+			}
+		}
+		return res
+	}
+	assert.Eventually(t, func() bool {
+		return len(spans("GameServerDispatch")) == 1 && len(spans("glicko2.Room")) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, entry.GroupStateGame, api.GM.Get(api.PM.Get(UIDA).Base().GroupID).Base().GetStateWithLock())
+
+	// 4. each group is enqueued as a child of its request
+	for _, s := range spans("matcher.Enqueue") {
+		assert.True(t, s.Parent().IsValid())
+		assert.Contains(t, traceIDs, s.SpanContext().TraceID())
+	}
+	assert.GreaterOrEqual(t, len(spans("matcher.Enqueue")), 4)
+
+	// 5. the room starts a new trace linking to the requests of the groups
+	room := spans("glicko2.Room")[0]
+	assert.False(t, room.Parent().IsValid())
+	assert.Len(t, room.Links(), 4)
+	for _, l := range room.Links() {
+		assert.Contains(t, traceIDs, l.SpanContext.TraceID())
+	}
+
+	// 6. the result handling and the game server dispatch are traced as the children of the room
+	handle := spans("HandleMatchResult")
+	assert.Len(t, handle, 1)
+	assert.Equal(t, room.SpanContext().SpanID(), handle[0].Parent().SpanID())
+	dispatch := spans("GameServerDispatch")[0]
+	assert.Equal(t, handle[0].SpanContext().SpanID(), dispatch.Parent().SpanID())
+	assert.Equal(t, room.SpanContext().TraceID(), dispatch.SpanContext().TraceID())
+
+	// 7. the match ticks are traced
+	assert.NotEmpty(t, spans("glicko2.Tick"))
+}
+
+// requestTraced sends the request with the traceparent in the metadata, and returns the raw response.
+func requestTraced(conn net.Conn, reqType pb.ReqType, req proto.Message, traceparent string, t *testing.T) *pb.CommonRsp {
+	bs, _ := proto.Marshal(req)
+	var meta map[string]string
+	if traceparent != "" {
+		meta = map[string]string{"traceparent": traceparent}
+	}
+	msg, err := dp.Pack(znet.NewMsgPackageWithMeta(uint32(reqType), bs, meta))
+	assert.Nil(t, err)
+	_, err = conn.Write(msg)
+	assert.Nil(t, err)
+
+	headData := make([]byte, dp.GetHeadLen())
+	_, err = io.ReadFull(conn, headData)
+	assert.Nil(t, err)
+	head, err := dp.Unpack(headData)
+	assert.Nil(t, err)
+	body := make([]byte, head.GetDataLen())
+	_, err = io.ReadFull(conn, body)
+	assert.Nil(t, err)
+
+	rsp := new(pb.CommonRsp)
+	assert.Nil(t, proto.Unmarshal(body, rsp))
+	return rsp
+}
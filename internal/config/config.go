@@ -4,3 +4,8 @@ package config
 type Configer[T any] interface {
 	Get() *T
 }
+
+// Notifier is implemented by the loaders which can notify the changes of the config.
+type Notifier[T any] interface {
+	AddListener(l Listener[T])
+}
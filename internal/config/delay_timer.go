@@ -4,13 +4,8 @@ import (
 	"time"
 )
 
-// TODO: support different delay timer type for different game mode
-// type GameModeConfig interface {
-//    GetDelayTimerConfig() DelayTimerConfig
-//    GetMatchStrategy() MatchStrategy
-// }
-
-// DelayTimerConfig defines the delay timer config.
+// DelayTimerConfig defines the delay timer config,
+// the game modes can override it by GameModeConfig.
 type DelayTimerConfig struct {
 	InviteTimeoutMs    int64 `yaml:"invite_timeout_ms"`
 	MatchTimeoutMs     int64 `yaml:"match_timeout_ms"`
@@ -0,0 +1,78 @@
+package config
+
+const (
+	defaultRecentPlayerLimit  = 50
+	defaultRecentExpireSec    = 86400 * 7
+	defaultNearbyRadiusKm     = 10
+	defaultNearbyLimit        = 50
+	defaultLocationExpireSec  = 3600
+	defaultDiscoveryListLimit = 20
+)
+
+// DiscoveryConfig defines the nearby and recent player lists,
+// the zero values fall back to the defaults.
+type DiscoveryConfig struct {
+	// RecentPlayerLimit is the max recent teammates and opponents remembered for each player.
+	RecentPlayerLimit int `yaml:"recent_player_limit"`
+	// RecentExpireSec is how long a player is kept in the recent list after the game.
+	RecentExpireSec int64 `yaml:"recent_expire_sec"`
+	// NearbyRadiusKm is the radius to look for nearby players.
+	NearbyRadiusKm float64 `yaml:"nearby_radius_km"`
+	// NearbyCellDegree is the cell size of the geo index in degrees.
+	NearbyCellDegree float64 `yaml:"nearby_cell_degree"`
+	// NearbyLimit is the max nearby players in the joinable groups scanned for each query.
+	NearbyLimit int `yaml:"nearby_limit"`
+	// LocationExpireSec is how long a reported location is valid.
+	LocationExpireSec int64 `yaml:"location_expire_sec"`
+	// ListLimit is the max groups returned by each query.
+	ListLimit int `yaml:"list_limit"`
+}
+
+func (dc *DiscoveryConfig) GetRecentPlayerLimit() int {
+	if dc == nil || dc.RecentPlayerLimit <= 0 {
+		return defaultRecentPlayerLimit
+	}
+	return dc.RecentPlayerLimit
+}
+
+func (dc *DiscoveryConfig) GetRecentExpireSec() int64 {
+	if dc == nil || dc.RecentExpireSec <= 0 {
+		return defaultRecentExpireSec
+	}
+	return dc.RecentExpireSec
+}
+
+func (dc *DiscoveryConfig) GetNearbyRadiusKm() float64 {
+	if dc == nil || dc.NearbyRadiusKm <= 0 {
+		return defaultNearbyRadiusKm
+	}
+	return dc.NearbyRadiusKm
+}
+
+func (dc *DiscoveryConfig) GetNearbyCellDegree() float64 {
+	if dc == nil {
+		return 0
+	}
+	return dc.NearbyCellDegree
+}
+
+func (dc *DiscoveryConfig) GetNearbyLimit() int {
+	if dc == nil || dc.NearbyLimit <= 0 {
+		return defaultNearbyLimit
+	}
+	return dc.NearbyLimit
+}
+
+func (dc *DiscoveryConfig) GetLocationExpireSec() int64 {
+	if dc == nil || dc.LocationExpireSec <= 0 {
+		return defaultLocationExpireSec
+	}
+	return dc.LocationExpireSec
+}
+
+func (dc *DiscoveryConfig) GetListLimit() int {
+	if dc == nil || dc.ListLimit <= 0 {
+		return defaultDiscoveryListLimit
+	}
+	return dc.ListLimit
+}
@@ -0,0 +1,169 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hedon954/go-matcher/internal/log"
+	"github.com/hedon954/go-matcher/thirdparty"
+)
+
+const (
+	defaultEtcdDialTimeout = 5 * time.Second
+	etcdRequestTimeout     = 5 * time.Second
+	etcdRewatchInterval    = time.Second
+)
+
+// EtcdLoader loads the match config from a key of etcd and watches its changes.
+type EtcdLoader struct {
+	key        string
+	etcdClient *clientv3.Client
+
+	// revision is the mod revision of the current config
+	revision int64
+	ctx      context.Context
+	cancel   context.CancelFunc
+	done     chan struct{}
+
+	*store[MatchConfig]
+}
+
+func NewEtcdLoader(key string, sc *EtcdServerConfig, opts ...LoaderOption) *EtcdLoader {
+	ctx, cancel := context.WithCancel(context.Background())
+	el := &EtcdLoader{
+		key:        key,
+		etcdClient: newEtcdClient(sc),
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		store:      newStore[MatchConfig]("match", newLoaderOptions(opts)),
+	}
+	el.load()
+	return el
+}
+
+// Stop stops watching the changes and closes the etcd client.
+func (el *EtcdLoader) Stop() {
+	el.cancel()
+	<-el.done
+	_ = el.etcdClient.Close()
+}
+
+func (el *EtcdLoader) load() {
+	rev, err := el.loadMatchConfig()
+	if err != nil {
+		panic(fmt.Errorf("get etcd match config error: %w", err))
+	}
+	if el.Get() == nil {
+		panic("load match config from etcd failed")
+	}
+	go el.watch(rev + 1)
+}
+
+// loadMatchConfig gets the match config and returns the revision of etcd when getting.
+func (el *EtcdLoader) loadMatchConfig() (int64, error) {
+	ctx, cancel := context.WithTimeout(el.ctx, etcdRequestTimeout)
+	defer cancel()
+	rsp, err := el.etcdClient.Get(ctx, el.key)
+	if err != nil {
+		return 0, err
+	}
+	if len(rsp.Kvs) == 0 {
+		log.Warn().Str("key", el.key).Msg("etcd match config not found, keep the current config")
+	} else if kv := rsp.Kvs[0]; kv.ModRevision != el.revision {
+		el.updateMatchConfig(kv.ModRevision, kv.Value)
+	}
+	return rsp.Header.Revision, nil
+}
+
+// watch watches the changes of the key from rev until the loader stops.
+// The etcd client reconnects by itself, and the watch is restarted if it is broken,
+// e.g. losing the leader or the revision being compacted, after resyncing the config.
+func (el *EtcdLoader) watch(rev int64) {
+	defer close(el.done)
+	for {
+		rev = el.watchMatchConfig(rev)
+		select {
+		case <-el.ctx.Done():
+			return
+		case <-time.After(etcdRewatchInterval):
+		}
+
+		// the changes may be missed when the watch is broken
+		r, err := el.loadMatchConfig()
+		if err != nil {
+			log.Error().Str("key", el.key).Err(err).Msg("resync etcd match config error")
+			continue
+		}
+		rev = r + 1
+	}
+}
+
+// watchMatchConfig handles the changes from rev until the watch is broken,
+// and returns the revision to continue watching from.
+func (el *EtcdLoader) watchMatchConfig(rev int64) int64 {
+	ctx, cancel := context.WithCancel(clientv3.WithRequireLeader(el.ctx))
+	defer cancel()
+	for rsp := range el.etcdClient.Watch(ctx, el.key, clientv3.WithRev(rev)) {
+		if err := rsp.Err(); err != nil {
+			log.Error().Str("key", el.key).Int64("revision", rev).Err(err).Msg("watch etcd match config error")
+			return rev
+		}
+		for _, ev := range rsp.Events {
+			switch ev.Type {
+			case mvccpb.PUT:
+				el.updateMatchConfig(ev.Kv.ModRevision, ev.Kv.Value)
+			case mvccpb.DELETE:
+				log.Warn().Str("key", el.key).Msg("etcd match config deleted, keep the current config")
+			}
+			rev = ev.Kv.ModRevision + 1
+		}
+	}
+	return rev
+}
+
+func (el *EtcdLoader) updateMatchConfig(revision int64, data []byte) {
+	log.Info().
+		Str("key", el.key).
+		Int64("revision", revision).
+		Msg("etcd config update")
+
+	mc := &MatchConfig{}
+	if err := yaml.Unmarshal(data, mc); err != nil {
+		log.Error().
+			Str("key", el.key).
+			Int64("revision", revision).
+			Str("data", string(data)).
+			Err(err).
+			Msg("unmarshal etcd match config error when config update")
+		return
+	}
+
+	// a bad put should not go live, keep the previous config
+	if err := el.update(mc); err != nil {
+		log.Error().
+			Str("key", el.key).
+			Int64("revision", revision).
+			Err(err).
+			Msg("reject etcd match config update")
+		return
+	}
+	el.revision = revision
+}
+
+func newEtcdClient(sc *EtcdServerConfig) *clientv3.Client {
+	dialTimeout := defaultEtcdDialTimeout
+	if sc.DialTimeoutMs > 0 {
+		dialTimeout = time.Duration(sc.DialTimeoutMs) * time.Millisecond
+	}
+	client, err := thirdparty.NewEtcdClient(sc.Endpoints, dialTimeout, sc.Username, sc.Password)
+	if err != nil {
+		panic(fmt.Errorf("new etcd client error: %w", err))
+	}
+	return client
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hedon954/go-matcher/thirdparty"
+)
+
+const etcdKey = "/go-matcher/match_config"
+
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer func() { _ = l.Close() }()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func putMatchConfig(t *testing.T, sc *EtcdServerConfig, data []byte) {
+	client := newEtcdClient(sc)
+	defer func() { _ = client.Close() }()
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+	_, err := client.Put(ctx, etcdKey, string(data))
+	assert.Nil(t, err)
+}
+
+func deleteMatchConfig(t *testing.T, sc *EtcdServerConfig) {
+	client := newEtcdClient(sc)
+	defer func() { _ = client.Close() }()
+	ctx, cancel := context.WithTimeout(context.Background(), etcdRequestTimeout)
+	defer cancel()
+	_, err := client.Delete(ctx, etcdKey)
+	assert.Nil(t, err)
+}
+
+func marshalMatchConfig(t *testing.T, groupPlayerLimit int) []byte {
+	mc := *defaultMC
+	mc.GroupPlayerLimit = groupPlayerLimit
+	bs, err := yaml.Marshal(&mc)
+	assert.Nil(t, err)
+	return bs
+}
+
+func Test_EtcdLoader_MatchConfig(t *testing.T) {
+	dir := t.TempDir()
+	clientPort, peerPort := freePort(t), freePort(t)
+	e := thirdparty.NewEmbedEtcd(dir, clientPort, peerPort)
+	defer func() { e.Close() }()
+	sc := &EtcdServerConfig{Endpoints: []string{e.Clients[0].Addr().String()}}
+
+	// initial load
+	putMatchConfig(t, sc, marshalMatchConfig(t, defaultMC.GroupPlayerLimit))
+	loader := NewEtcdLoader(etcdKey, sc)
+	defer loader.Stop()
+	expected := &MatchConfig{}
+	assert.Nil(t, yaml.Unmarshal(marshalMatchConfig(t, defaultMC.GroupPlayerLimit), expected))
+	assert.Equal(t, expected, loader.Get())
+
+	var (
+		mu     sync.Mutex
+		limits []int
+	)
+	loader.AddListener(func(_, new *MatchConfig) {
+		mu.Lock()
+		defer mu.Unlock()
+		limits = append(limits, new.GroupPlayerLimit)
+	})
+	assertLimits := func(expected ...int) {
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, expected, limits)
+	}
+	waitLimit := func(limit int) {
+		assert.Eventually(t, func() bool {
+			return loader.Get().GroupPlayerLimit == limit
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	// swap on change
+	putMatchConfig(t, sc, marshalMatchConfig(t, 3))
+	waitLimit(3)
+
+	// undecodable and invalid configs are skipped, deleting the key keeps the current config
+	putMatchConfig(t, sc, []byte("group_player_limit: [}"))
+	putMatchConfig(t, sc, marshalMatchConfig(t, 0))
+	deleteMatchConfig(t, sc)
+	putMatchConfig(t, sc, marshalMatchConfig(t, 4))
+	waitLimit(4)
+	assertLimits(3, 4)
+
+	// keep watching after the etcd server restarts
+	e.Close()
+	e = thirdparty.NewEmbedEtcd(dir, clientPort, peerPort)
+	putMatchConfig(t, sc, marshalMatchConfig(t, 5))
+	waitLimit(5)
+	assertLimits(3, 4, 5)
+}
@@ -1,52 +1,144 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"os"
-	"sync"
+	"path/filepath"
+	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"gopkg.in/yaml.v3"
+
+	"github.com/hedon954/go-matcher/internal/log"
 )
 
+// fileReloadDelay merges the bursts of file events, e.g. an editor truncates and then writes the file.
+const fileReloadDelay = 100 * time.Millisecond
+
 // FileLoader loads the config from file.
 type FileLoader[T any] struct {
 	path string
 
-	sync.RWMutex
-	c *T
+	// data is the content of the current config
+	data    []byte
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+
+	*store[T]
 }
 
-func NewFileLoader[T any](path string) *FileLoader[T] {
-	fl := &FileLoader[T]{path: path}
+// WithFileWatch makes the file loader watch the file and reload the config when it changes.
+func WithFileWatch() LoaderOption {
+	return func(o *loaderOptions) {
+		o.watch = true
+	}
+}
+
+func NewFileLoader[T any](path string, opts ...LoaderOption) *FileLoader[T] {
+	o := newLoaderOptions(opts)
+	fl := &FileLoader[T]{path: path, store: newStore[T](path, o)}
 	fl.load()
+	if o.watch {
+		fl.startWatch()
+	}
 	return fl
 }
 
-func (fl *FileLoader[T]) Get() *T {
-	fl.RLock()
-	defer fl.RUnlock()
-	return fl.c
+// Stop stops watching the file, it does nothing if the loader is not watching.
+func (fl *FileLoader[T]) Stop() {
+	if fl.watcher == nil {
+		return
+	}
+	_ = fl.watcher.Close()
+	<-fl.done
 }
 
 func (fl *FileLoader[T]) load() {
-	config, err := load[T](fl.path)
+	bs, err := os.ReadFile(fl.path)
+	if err != nil {
+		panic(fmt.Errorf("read config file error: %w", err))
+	}
+	config, err := unmarshal[T](bs)
 	if err != nil {
 		panic(err)
 	}
+	if err := fl.update(config); err != nil {
+		panic(err)
+	}
+	fl.data = bs
+}
+
+// startWatch watches the directory of the file rather than the file itself,
+// so that the file replaced by renaming, e.g. saved by editors, is still watched.
+func (fl *FileLoader[T]) startWatch() {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		panic(fmt.Errorf("new file watcher error: %w", err))
+	}
+	if err := watcher.Add(filepath.Dir(fl.path)); err != nil {
+		_ = watcher.Close()
+		panic(fmt.Errorf("watch config file error: %w", err))
+	}
+	fl.watcher = watcher
+	fl.done = make(chan struct{})
+	go fl.watchFile()
+}
 
-	fl.Lock()
-	defer fl.Unlock()
-	fl.c = config
+func (fl *FileLoader[T]) watchFile() {
+	defer close(fl.done)
+	var reload <-chan time.Time
+	for {
+		select {
+		case ev, ok := <-fl.watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(ev.Name) != filepath.Clean(fl.path) {
+				continue
+			}
+			if ev.Has(fsnotify.Write) || ev.Has(fsnotify.Create) {
+				reload = time.After(fileReloadDelay)
+			}
+		case err, ok := <-fl.watcher.Errors:
+			if !ok {
+				return
+			}
+			log.Error().Str("path", fl.path).Err(err).Msg("watch config file error")
+		case <-reload:
+			reload = nil
+			fl.reload()
+		}
+	}
 }
 
-// load loads the config from file.
-func load[T any](path string) (*T, error) {
-	bs, err := os.ReadFile(path)
+// reload swaps in the config from the file, the current config is kept if the file is bad.
+func (fl *FileLoader[T]) reload() {
+	bs, err := os.ReadFile(fl.path)
 	if err != nil {
-		return nil, fmt.Errorf("read config file error: %w", err)
+		log.Error().Str("path", fl.path).Err(err).Msg("read config file error when config update")
+		return
+	}
+	if bytes.Equal(bs, fl.data) {
+		return
 	}
+	log.Info().Str("path", fl.path).Msg("config file update")
+
+	config, err := unmarshal[T](bs)
+	if err != nil {
+		log.Error().Str("path", fl.path).Err(err).Msg("unmarshal config file error when config update")
+		return
+	}
+	if err := fl.update(config); err != nil {
+		log.Error().Str("path", fl.path).Err(err).Msg("reject config file update")
+		return
+	}
+	fl.data = bs
+}
+
+func unmarshal[T any](bs []byte) (*T, error) {
 	var c T
-	err = yaml.Unmarshal(bs, &c)
+	err := yaml.Unmarshal(bs, &c)
 	if err != nil {
 		return nil, fmt.Errorf("unmarshal config error: %w", err)
 	}
@@ -1,10 +1,16 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/r3labs/diff/v3"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
 
 	"github.com/hedon954/go-matcher/internal/constant"
 	"github.com/hedon954/go-matcher/pkg/algorithm/glicko2"
@@ -29,6 +35,15 @@ func Test_FileLoader_MatchConfig(t *testing.T) {
 			WaitAttrTimeoutMs:  1,
 			ClearRoomTimeoutMs: 1800000,
 		},
+		Modes: map[constant.GameMode]*GameModeConfig{
+			constant.GameModeGoatGame: {
+				GroupPlayerLimit: 5,
+				DelayTimerConfig: &DelayTimerConfig{MatchTimeoutMs: 120000},
+				MatchStrategy:    constant.MatchStrategyGlicko2,
+				InviteExpireSec:  60,
+				RequireAllReady:  true,
+			},
+		},
 	}
 
 	got := loader.Get()
@@ -66,3 +81,79 @@ func Test_FileLoader_ServerConfig(t *testing.T) {
 		t.Errorf("diff: \n%+v", d)
 	}
 }
+
+func TestMatchConfig_GameModeOverrides(t *testing.T) {
+	conf := NewFileLoader[MatchConfig]("../../fixtures/match_conf_test.yml").Get()
+	const otherMode = constant.GameMode(0)
+
+	// the overridden fields of the game mode
+	assert.Equal(t, 5, conf.GetGroupPlayerLimit(constant.GameModeGoatGame))
+	assert.Equal(t, int64(60), conf.GetInviteExpireSec(constant.GameModeGoatGame))
+	assert.True(t, conf.RequireAllReady(constant.GameModeGoatGame))
+	assert.Equal(t, DelayTimerConfig{
+		InviteTimeoutMs:    300000,
+		MatchTimeoutMs:     120000,
+		WaitAttrTimeoutMs:  1,
+		ClearRoomTimeoutMs: 1800000,
+	}, conf.GetDelayTimerConfig(constant.GameModeGoatGame))
+
+	// the other game modes fall back to the globals
+	assert.Equal(t, 2, conf.GetGroupPlayerLimit(otherMode))
+	assert.Equal(t, int64(0), conf.GetInviteExpireSec(otherMode))
+	assert.False(t, conf.RequireAllReady(otherMode))
+	assert.Equal(t, constant.MatchStrategyGlicko2, conf.GetMatchStrategy(otherMode))
+	assert.Equal(t, *conf.DelayTimerConfig, conf.GetDelayTimerConfig(otherMode))
+}
+
+func Test_FileLoader_Watch(t *testing.T) {
+	src, err := os.ReadFile("../../fixtures/match_conf_test.yml")
+	assert.Nil(t, err)
+	path := filepath.Join(t.TempDir(), "match_conf.yml")
+	assert.Nil(t, os.WriteFile(path, src, 0o600))
+
+	loader := NewFileLoader[MatchConfig](path, WithFileWatch())
+	defer loader.Stop()
+	var (
+		mu     sync.Mutex
+		limits []int
+	)
+	loader.AddListener(func(_, new *MatchConfig) {
+		mu.Lock()
+		defer mu.Unlock()
+		limits = append(limits, new.GroupPlayerLimit)
+	})
+
+	writeLimit := func(limit int) []byte {
+		mc := *loader.Get()
+		mc.GroupPlayerLimit = limit
+		bs, err := yaml.Marshal(&mc)
+		assert.Nil(t, err)
+		return bs
+	}
+	waitLimit := func(limit int) {
+		assert.Eventually(t, func() bool {
+			return loader.Get().GroupPlayerLimit == limit
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	// write in place
+	assert.Nil(t, os.WriteFile(path, writeLimit(3), 0o600))
+	waitLimit(3)
+
+	// bad files are skipped
+	assert.Nil(t, os.WriteFile(path, []byte("group_player_limit: [}"), 0o600))
+	time.Sleep(3 * fileReloadDelay)
+	assert.Nil(t, os.WriteFile(path, writeLimit(0), 0o600))
+	time.Sleep(3 * fileReloadDelay)
+	assert.Equal(t, 3, loader.Get().GroupPlayerLimit)
+
+	// replaced by renaming
+	tmp := path + ".tmp"
+	assert.Nil(t, os.WriteFile(tmp, writeLimit(4), 0o600))
+	assert.Nil(t, os.Rename(tmp, path))
+	waitLimit(4)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []int{3, 4}, limits)
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"github.com/hedon954/go-matcher/internal/constant"
+)
+
+// defaultMatchStrategy is the match strategy of the game modes not configuring one.
+const defaultMatchStrategy = constant.MatchStrategyGlicko2
+
+// GameModeConfig overrides the global match config for a game mode,
+// the zero values fall back to the globals.
+type GameModeConfig struct {
+	// GroupPlayerLimit overrides MatchConfig.GroupPlayerLimit.
+	GroupPlayerLimit int `yaml:"group_player_limit"`
+	// DelayTimerConfig overrides the non-zero timeouts of MatchConfig.DelayTimerConfig.
+	DelayTimerConfig *DelayTimerConfig `yaml:"delay_timer_config"`
+	// MatchStrategy is the strategy to match the groups of the game mode, 0 means glicko2.
+	MatchStrategy constant.MatchStrategy `yaml:"match_strategy"`
+	// InviteExpireSec is how long an invitation of the groups is valid, 0 means the default of the group.
+	InviteExpireSec int64 `yaml:"invite_expire_sec"`
+	// RequireAllReady requires all the players of a group to be ready before the captain starts to match.
+	RequireAllReady bool `yaml:"require_all_ready"`
+}
+
+func (c *MatchConfig) GetGameModeConfig(mode constant.GameMode) *GameModeConfig {
+	return c.Modes[mode]
+}
+
+func (c *MatchConfig) GetGroupPlayerLimit(mode constant.GameMode) int {
+	if mc := c.GetGameModeConfig(mode); mc != nil && mc.GroupPlayerLimit > 0 {
+		return mc.GroupPlayerLimit
+	}
+	return c.GroupPlayerLimit
+}
+
+// GetDelayTimerConfig returns the delay timer config of the game mode,
+// each timeout falls back to the global one if the game mode does not override it.
+func (c *MatchConfig) GetDelayTimerConfig(mode constant.GameMode) DelayTimerConfig {
+	var res DelayTimerConfig
+	if c.DelayTimerConfig != nil {
+		res = *c.DelayTimerConfig
+	}
+	mc := c.GetGameModeConfig(mode)
+	if mc == nil || mc.DelayTimerConfig == nil {
+		return res
+	}
+	if mc.DelayTimerConfig.InviteTimeoutMs > 0 {
+		res.InviteTimeoutMs = mc.DelayTimerConfig.InviteTimeoutMs
+	}
+	if mc.DelayTimerConfig.MatchTimeoutMs > 0 {
+		res.MatchTimeoutMs = mc.DelayTimerConfig.MatchTimeoutMs
+	}
+	if mc.DelayTimerConfig.WaitAttrTimeoutMs > 0 {
+		res.WaitAttrTimeoutMs = mc.DelayTimerConfig.WaitAttrTimeoutMs
+	}
+	if mc.DelayTimerConfig.ClearRoomTimeoutMs > 0 {
+		res.ClearRoomTimeoutMs = mc.DelayTimerConfig.ClearRoomTimeoutMs
+	}
+	return res
+}
+
+func (c *MatchConfig) GetMatchStrategy(mode constant.GameMode) constant.MatchStrategy {
+	if mc := c.GetGameModeConfig(mode); mc != nil && mc.MatchStrategy != 0 {
+		return mc.MatchStrategy
+	}
+	return defaultMatchStrategy
+}
+
+// GetInviteExpireSec returns the invite expiry of the game mode, 0 means using the default of the group.
+func (c *MatchConfig) GetInviteExpireSec(mode constant.GameMode) int64 {
+	if mc := c.GetGameModeConfig(mode); mc != nil {
+		return mc.InviteExpireSec
+	}
+	return 0
+}
+
+func (c *MatchConfig) RequireAllReady(mode constant.GameMode) bool {
+	if mc := c.GetGameModeConfig(mode); mc != nil {
+		return mc.RequireAllReady
+	}
+	return false
+}
@@ -0,0 +1,43 @@
+package config
+
+const (
+	defaultGroupChatMaxLength          = 100
+	defaultGroupChatRateLimit          = 5
+	defaultGroupChatRateLimitWindowSec = 10
+)
+
+// GroupChatConfig defines the limits of the group messages,
+// the zero values fall back to the defaults.
+type GroupChatConfig struct {
+	// MaxLength is the max characters of a message.
+	MaxLength int `yaml:"max_length"`
+	// RateLimit is the max messages a player can send within RateLimitWindowSec.
+	RateLimit int `yaml:"rate_limit"`
+	// RateLimitWindowSec is the sliding window of RateLimit.
+	RateLimitWindowSec int64 `yaml:"rate_limit_window_sec"`
+	// Emotes and QuickChats are the preset ids of the emotes and the quick chats,
+	// empty means any content is allowed and filtered like the free text.
+	Emotes     []string `yaml:"emotes"`
+	QuickChats []string `yaml:"quick_chats"`
+}
+
+func (gcc *GroupChatConfig) GetMaxLength() int {
+	if gcc == nil || gcc.MaxLength <= 0 {
+		return defaultGroupChatMaxLength
+	}
+	return gcc.MaxLength
+}
+
+func (gcc *GroupChatConfig) GetRateLimit() int {
+	if gcc == nil || gcc.RateLimit <= 0 {
+		return defaultGroupChatRateLimit
+	}
+	return gcc.RateLimit
+}
+
+func (gcc *GroupChatConfig) GetRateLimitWindowSec() int64 {
+	if gcc == nil || gcc.RateLimitWindowSec <= 0 {
+		return defaultGroupChatRateLimitWindowSec
+	}
+	return gcc.RateLimitWindowSec
+}
@@ -0,0 +1,9 @@
+package config
+
+// InviteConfig defines the limits of the invitations.
+type InviteConfig struct {
+	// RateLimit is the max invitations a player can send within RateLimitWindowSec, 0 means no limit.
+	RateLimit int `yaml:"rate_limit"`
+	// RateLimitWindowSec is the sliding window of RateLimit, it is required if RateLimit is set.
+	RateLimitWindowSec int64 `yaml:"rate_limit_window_sec"`
+}
@@ -0,0 +1,237 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hedon954/go-matcher/internal/log"
+)
+
+// Override sets a value on the path of the yaml keys, e.g. glicko2.905.match_timeout_sec=60.
+// The value is parsed as yaml, so numbers, bools and lists like [a, b] are supported.
+type Override struct {
+	Path  []string
+	Value string
+}
+
+func (o Override) String() string {
+	return strings.Join(o.Path, ".") + "=" + o.Value
+}
+
+// ParseOverride parses the override in the form of key.path=value.
+func ParseOverride(s string) (Override, error) {
+	k, v, ok := strings.Cut(s, "=")
+	if !ok || k == "" {
+		return Override{}, fmt.Errorf("invalid override %q, should be key.path=value", s)
+	}
+	return Override{Path: strings.Split(k, "."), Value: v}, nil
+}
+
+// EnvOverrides returns the overrides from the environment variables with the prefix,
+// the keys are lowercased and separated by double underscores,
+// e.g. GOMATCHER_MATCH_GLICKO2__905__MATCH_TIMEOUT_SEC=60 with prefix GOMATCHER_MATCH_.
+func EnvOverrides(prefix string) []Override {
+	var overrides []Override
+	for _, kv := range os.Environ() {
+		k, v, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(k, prefix) || len(k) == len(prefix) {
+			continue
+		}
+		overrides = append(overrides, Override{
+			Path:  strings.Split(strings.ToLower(k[len(prefix):]), "__"),
+			Value: v,
+		})
+	}
+	return overrides
+}
+
+// LayeredLoader merges the config from the layers, the later ones take precedence:
+// the defaults, the sources in order, and the overrides in order.
+// The zero values of the sources fall back to the lower layers, while the overrides are always set.
+// The config is merged again when a source implementing Notifier changes.
+// The sources should be created with AsLayer, since only the merged config is complete to be validated.
+type LayeredLoader[T any] struct {
+	defaults  *T
+	sources   []Configer[T]
+	overrides []Override
+
+	mu sync.Mutex
+	*store[T]
+}
+
+func NewLayeredLoader[T any](name string, defaults *T, sources []Configer[T], overrides ...Override) *LayeredLoader[T] {
+	ll := &LayeredLoader[T]{
+		defaults:  defaults,
+		sources:   sources,
+		overrides: overrides,
+		store:     newStore[T](name, loaderOptions{}),
+	}
+	if err := ll.load(); err != nil {
+		panic(err)
+	}
+	for _, s := range sources {
+		if n, ok := s.(Notifier[T]); ok {
+			n.AddListener(func(_, _ *T) { ll.reload() })
+		}
+	}
+	return ll
+}
+
+func (ll *LayeredLoader[T]) load() error {
+	ll.mu.Lock()
+	defer ll.mu.Unlock()
+	c, err := ll.merge()
+	if err != nil {
+		return fmt.Errorf("merge %s config error: %w", ll.name, err)
+	}
+	return ll.update(c)
+}
+
+// reload merges the config again after a source changes, the current config is kept if it fails.
+func (ll *LayeredLoader[T]) reload() {
+	if err := ll.load(); err != nil {
+		log.Error().Str("config", ll.name).Err(err).Msg("reject layered config update")
+	}
+}
+
+func (ll *LayeredLoader[T]) merge() (*T, error) {
+	merged, err := toYAMLMap(ll.defaults)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range ll.sources {
+		m, err := toYAMLMap(s.Get())
+		if err != nil {
+			return nil, err
+		}
+		mergeYAML(merged, m)
+	}
+	for _, o := range ll.overrides {
+		if err := applyOverride(merged, o); err != nil {
+			return nil, err
+		}
+	}
+
+	bs, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	// the unknown keys are mostly typos of the overrides
+	var c T
+	dec := yaml.NewDecoder(bytes.NewReader(bs))
+	dec.KnownFields(true)
+	if err := dec.Decode(&c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func toYAMLMap(c any) (map[string]any, error) {
+	m := make(map[string]any)
+	if c == nil || reflect.ValueOf(c).IsNil() {
+		return m, nil
+	}
+	bs, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(bs, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// mergeYAML merges the non-zero values of src into dst recursively and returns the merged value.
+func mergeYAML(dst, src any) any {
+	switch sm := src.(type) {
+	case map[string]any:
+		if dm, ok := dst.(map[string]any); ok {
+			for k, v := range sm {
+				if !isZeroYAML(v) {
+					dm[k] = mergeYAML(dm[k], v)
+				}
+			}
+			return dm
+		}
+	case map[any]any:
+		if dm, ok := dst.(map[any]any); ok {
+			for k, v := range sm {
+				if !isZeroYAML(v) {
+					dm[k] = mergeYAML(dm[k], v)
+				}
+			}
+			return dm
+		}
+	}
+	return src
+}
+
+func isZeroYAML(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Map || rv.Kind() == reflect.Slice {
+		return rv.Len() == 0
+	}
+	return rv.IsZero()
+}
+
+// applyOverride sets the value on the path, the missing maps on the path are created.
+// The keys of the maps are compared as strings, e.g. the game modes.
+func applyOverride(m map[string]any, o Override) error {
+	var v any
+	if err := yaml.Unmarshal([]byte(o.Value), &v); err != nil {
+		return fmt.Errorf("invalid override %q: %w", o, err)
+	}
+
+	var cur any = m
+	for i, k := range o.Path {
+		last := i == len(o.Path)-1
+		switch node := cur.(type) {
+		case map[string]any:
+			if last {
+				node[k] = v
+				return nil
+			}
+			if _, ok := node[k]; !ok {
+				node[k] = make(map[any]any)
+			}
+			cur = node[k]
+		case map[any]any:
+			key := yamlKey(node, k)
+			if last {
+				node[key] = v
+				return nil
+			}
+			if _, ok := node[key]; !ok {
+				node[key] = make(map[any]any)
+			}
+			cur = node[key]
+		default:
+			return fmt.Errorf("invalid override %q: %s is not a map", o, strings.Join(o.Path[:i], "."))
+		}
+	}
+	return nil
+}
+
+// yamlKey returns the existing key of the map equal to k as string,
+// or k parsed as yaml so that the numeric keys keep their types.
+func yamlKey(m map[any]any, k string) any {
+	for mk := range m {
+		if fmt.Sprint(mk) == k {
+			return mk
+		}
+	}
+	var key any
+	if err := yaml.Unmarshal([]byte(k), &key); err != nil || key == nil {
+		return k
+	}
+	return key
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hedon954/go-matcher/internal/constant"
+	"github.com/hedon954/go-matcher/pkg/algorithm/glicko2"
+)
+
+func TestParseOverride(t *testing.T) {
+	o, err := ParseOverride("glicko2.905.match_timeout_sec=60")
+	assert.Nil(t, err)
+	assert.Equal(t, Override{Path: []string{"glicko2", "905", "match_timeout_sec"}, Value: "60"}, o)
+
+	_, err = ParseOverride("glicko2.905.match_timeout_sec")
+	assert.NotNil(t, err)
+}
+
+func TestEnvOverrides(t *testing.T) {
+	t.Setenv("GOMATCHER_TEST_GLICKO2__905__MATCH_TIMEOUT_SEC", "60")
+	assert.Equal(t, []Override{{Path: []string{"glicko2", "905", "match_timeout_sec"}, Value: "60"}},
+		EnvOverrides("GOMATCHER_TEST_"))
+}
+
+func TestLayeredLoader(t *testing.T) {
+	file := NewFileLoader[MatchConfig]("../../fixtures/match_conf_test.yml")
+	t.Setenv("GOMATCHER_TEST_MATCH_INTERVAL_MS", "500")
+	overrides := append(EnvOverrides("GOMATCHER_TEST_"),
+		Override{Path: []string{"glicko2", "905", "match_timeout_sec"}, Value: "60"},
+		Override{Path: []string{"glicko2", "906", "team_player_limit"}, Value: "1"},
+		Override{Path: []string{"glicko2", "906", "room_team_limit"}, Value: "2"},
+		Override{Path: []string{"backfill_on_escape"}, Value: "false"},
+	)
+	defaults := DefaultMatchConfig()
+	defaults.BackfillOnEscape = true
+	ll := NewLayeredLoader("match", defaults, []Configer[MatchConfig]{file}, overrides...)
+	c := ll.Get()
+
+	// the source takes precedence over the defaults
+	assert.Equal(t, 2, c.GroupPlayerLimit)
+	assert.Equal(t, int64(1), c.DelayTimerConfig.WaitAttrTimeoutMs)
+	// the overrides take precedence over the source, even if they are zero
+	assert.Equal(t, int64(500), c.MatchIntervalMs)
+	assert.Equal(t, int64(60), c.Glicko2[constant.GameModeGoatGame].MatchTimeoutSec)
+	assert.Equal(t, 2, c.Glicko2[constant.GameModeGoatGame].TeamPlayerLimit)
+	assert.Equal(t, 1, c.Glicko2[906].TeamPlayerLimit)
+	assert.False(t, c.BackfillOnEscape)
+
+	// merge again when the source changes
+	var notified *MatchConfig
+	ll.AddListener(func(_, new *MatchConfig) { notified = new })
+	changed := *file.Get()
+	changed.GroupPlayerLimit = 4
+	changed.Glicko2 = map[constant.GameMode]*glicko2.QueueArgs{
+		constant.GameModeGoatGame: {MatchTimeoutSec: 100, TeamPlayerLimit: 3, RoomTeamLimit: 2},
+	}
+	assert.Nil(t, file.update(&changed))
+	assert.Equal(t, 4, ll.Get().GroupPlayerLimit)
+	assert.Equal(t, 3, ll.Get().Glicko2[constant.GameModeGoatGame].TeamPlayerLimit)
+	assert.Equal(t, int64(60), ll.Get().Glicko2[constant.GameModeGoatGame].MatchTimeoutSec)
+	assert.Equal(t, ll.Get(), notified)
+}
+
+func TestLayeredLoader_invalidOverride(t *testing.T) {
+	assert.PanicsWithError(t, `merge match config error: invalid override "group_player_limit.x=1": group_player_limit is not a map`,
+		func() {
+			NewLayeredLoader("match", DefaultMatchConfig(), nil,
+				Override{Path: []string{"group_player_limit", "x"}, Value: "1"})
+		})
+	assert.Panics(t, func() {
+		NewLayeredLoader("match", DefaultMatchConfig(), nil,
+			Override{Path: []string{"group_player_limt"}, Value: "1"})
+	})
+}
+
+func TestLayeredLoader_partialSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "match_conf_overlay.yml")
+	assert.Nil(t, os.WriteFile(path, []byte("glicko2:\n  905:\n    match_timeout_sec: 60\n"), 0o600))
+
+	// the partial source is invalid on its own
+	assert.Panics(t, func() { NewFileLoader[MatchConfig](path) })
+
+	// but valid after merged as a layer
+	base := NewFileLoader[MatchConfig]("../../fixtures/match_conf_test.yml", AsLayer())
+	overlay := NewFileLoader[MatchConfig](path, AsLayer())
+	ll := NewLayeredLoader("match", DefaultMatchConfig(), []Configer[MatchConfig]{base, overlay})
+	assert.Equal(t, int64(60), ll.Get().Glicko2[constant.GameModeGoatGame].MatchTimeoutSec)
+	assert.Equal(t, 2, ll.Get().Glicko2[constant.GameModeGoatGame].TeamPlayerLimit)
+
+	// the merged config is still validated
+	assert.Panics(t, func() {
+		NewLayeredLoader("match", DefaultMatchConfig(), []Configer[MatchConfig]{overlay})
+	})
+}
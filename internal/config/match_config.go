@@ -21,12 +21,58 @@ type MatchConfig struct {
 	Glicko2          map[constant.GameMode]*glicko2.QueueArgs `yaml:"glicko2"`
 	DelayTimerType   DelayTimerType                           `yaml:"delay_timer_type"`
 	DelayTimerConfig *DelayTimerConfig                        `yaml:"delay_timer_config"`
+	BackfillOnEscape bool                                     `yaml:"backfill_on_escape"`
+
+	// ReadyCheck enables the ready check of the game modes, nil means the game starts once matched.
+	ReadyCheck map[constant.GameMode]*ReadyCheckConfig `yaml:"ready_check"`
+
+	// SpectatorLimit is the max spectators of a matched room of the game modes, 0 means spectating is disabled.
+	SpectatorLimit map[constant.GameMode]int `yaml:"spectator_limit"`
+
+	// ShareToken enables the share links of the groups, nil means sharing is disabled.
+	ShareToken *ShareTokenConfig `yaml:"share_token"`
+
+	// Discovery defines the nearby and recent player lists, nil means using the defaults.
+	Discovery *DiscoveryConfig `yaml:"discovery"`
+
+	// Invite defines the limits of the invitations, nil means no limit.
+	Invite *InviteConfig `yaml:"invite"`
+
+	// GroupChat defines the limits of the group messages, nil means using the defaults.
+	GroupChat *GroupChatConfig `yaml:"group_chat"`
+
+	// Modes overrides the global config for the game modes.
+	Modes map[constant.GameMode]*GameModeConfig `yaml:"modes"`
+}
+
+// DefaultMatchConfig returns the defaults of the match config, the lowest layer of the config.
+// No game mode is enabled by default, the glicko2 queue args should be configured for each game mode.
+func DefaultMatchConfig() *MatchConfig {
+	return &MatchConfig{
+		GroupPlayerLimit: 5,
+		MatchIntervalMs:  1000,
+		DelayTimerType:   DelayTimerTypeNative,
+		DelayTimerConfig: &DelayTimerConfig{
+			InviteTimeoutMs:    300000,
+			MatchTimeoutMs:     60000,
+			WaitAttrTimeoutMs:  1000,
+			ClearRoomTimeoutMs: 1800000,
+		},
+	}
 }
 
 func (c *MatchConfig) GetGlicko2QueueArgs(mode constant.GameMode) *glicko2.QueueArgs {
 	return c.Glicko2[mode]
 }
 
+func (c *MatchConfig) GetReadyCheckConfig(mode constant.GameMode) *ReadyCheckConfig {
+	return c.ReadyCheck[mode]
+}
+
+func (c *MatchConfig) GetSpectatorLimit(mode constant.GameMode) int {
+	return c.SpectatorLimit[mode]
+}
+
 func (c *MatchConfig) MatchInterval() time.Duration {
 	return time.Duration(c.MatchIntervalMs) * time.Millisecond
 }
@@ -2,7 +2,6 @@ package config
 
 import (
 	"fmt"
-	"sync"
 
 	"github.com/nacos-group/nacos-sdk-go/v2/clients/config_client"
 	"github.com/nacos-group/nacos-sdk-go/v2/vo"
@@ -18,26 +17,22 @@ type NacosLoader struct {
 	namespaceID string
 	nacosClient config_client.IConfigClient
 
-	sync.RWMutex
-	mc *MatchConfig
+	*store[MatchConfig]
 }
 
-func NewNacosLoader(namespaceID, group, dataID string, scs []*NacosServerConfig) *NacosLoader {
+func NewNacosLoader(namespaceID, group, dataID string, scs []*NacosServerConfig,
+	opts ...LoaderOption) *NacosLoader {
 	nl := &NacosLoader{
 		group:       group,
 		dataID:      dataID,
 		namespaceID: namespaceID,
-		nacosClient: newNacosClient(namespaceID, scs)}
+		nacosClient: newNacosClient(namespaceID, scs),
+		store:       newStore[MatchConfig]("match", newLoaderOptions(opts)),
+	}
 	nl.load()
 	return nl
 }
 
-func (nl *NacosLoader) Get() *MatchConfig {
-	nl.RLock()
-	defer nl.RUnlock()
-	return nl.mc
-}
-
 func (nl *NacosLoader) load() {
 	nl.loadMatchConfig()
 }
@@ -53,7 +48,7 @@ func (nl *NacosLoader) loadMatchConfig() {
 	}
 
 	nl.updateMatchConfig(nl.namespaceID, nl.dataID, nl.group, mc)
-	if nl.Get().DelayTimerType == "" {
+	if nl.Get() == nil {
 		panic("load match config from nacos failed")
 	}
 }
@@ -77,9 +72,15 @@ func (nl *NacosLoader) updateMatchConfig(namespace, group, dataID, data string)
 		return
 	}
 
-	nl.Lock()
-	defer nl.Unlock()
-	nl.mc = mc
+	// a bad push should not go live, keep the previous config
+	if err := nl.update(mc); err != nil {
+		log.Error().
+			Str("namespace", namespace).
+			Str("group", group).
+			Str("data_id", dataID).
+			Err(err).
+			Msg("reject nacos match config update")
+	}
 }
 
 func newNacosClient(namespaceID string, scs []*NacosServerConfig) config_client.IConfigClient {
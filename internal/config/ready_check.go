@@ -0,0 +1,19 @@
+package config
+
+import (
+	"time"
+)
+
+// ReadyCheckConfig defines the ready check of a game mode,
+// the matched players should accept the match before the game starts.
+type ReadyCheckConfig struct {
+	// TimeoutMs is the time for the players to accept the match.
+	TimeoutMs int64 `yaml:"timeout_ms"`
+	// PenaltySec is how long the players who declined or did not accept in time
+	// would be deprioritized in the queue.
+	PenaltySec int64 `yaml:"penalty_sec"`
+}
+
+func (rcc ReadyCheckConfig) Timeout() time.Duration {
+	return time.Millisecond * time.Duration(rcc.TimeoutMs)
+}
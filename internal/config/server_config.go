@@ -11,6 +11,19 @@ type ServerConfig struct {
 	AsynqRedis           *RedisOpt            `yaml:"asynq_redis"`
 	NacosNamespaceID     string               `yaml:"nacos_namespace_id"`
 	NacosServers         []*NacosServerConfig `yaml:"nacos_servers"`
+	EtcdServer           *EtcdServerConfig    `yaml:"etcd_server"`
+	MatchConfigPath      string               `yaml:"match_config_path"`
+
+	// AdminToken is the bearer token of the admin routes, empty means the admin routes are disabled.
+	AdminToken string `yaml:"admin_token"`
+}
+
+// DefaultServerConfig returns the defaults of the server config, the lowest layer of the config.
+func DefaultServerConfig() *ServerConfig {
+	return &ServerConfig{
+		HTTPPort:   5050,
+		AsynqRedis: &RedisOpt{Addr: "127.0.0.1:6379"},
+	}
 }
 
 type RedisOpt struct {
@@ -27,6 +40,15 @@ type NacosServerConfig struct {
 	Schema      string `yaml:"schema"`
 }
 
+// EtcdServerConfig defines the etcd cluster to load the match config from,
+// the match config is loaded from nacos if it is not set.
+type EtcdServerConfig struct {
+	Endpoints     []string `yaml:"endpoints"`
+	DialTimeoutMs int64    `yaml:"dial_timeout_ms"`
+	Username      string   `yaml:"username"`
+	Password      string   `yaml:"password"`
+}
+
 func ToNacosServerConfigs(scs []*NacosServerConfig) []constant.ServerConfig {
 	serverConfigs := make([]constant.ServerConfig, len(scs))
 	for i := 0; i < len(scs); i++ {
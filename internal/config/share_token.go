@@ -0,0 +1,10 @@
+package config
+
+// ShareTokenConfig defines the share tokens of the groups,
+// the players can enter a group with a valid token from its share link.
+type ShareTokenConfig struct {
+	// Secret is the key to sign the tokens, sharing is disabled if empty.
+	Secret string `yaml:"secret"`
+	// ExpireSec is how long a token is valid after generated.
+	ExpireSec int64 `yaml:"expire_sec"`
+}
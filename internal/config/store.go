@@ -0,0 +1,113 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/r3labs/diff/v3"
+
+	"github.com/hedon954/go-matcher/internal/log"
+)
+
+// Validator is implemented by the configs which check themselves before taking effect.
+type Validator interface {
+	Validate() error
+}
+
+// Listener is called after the config changes, old is nil for the first load.
+// The configs should be treated as read-only.
+type Listener[T any] func(old, new *T)
+
+// store holds the current config of a loader,
+// it rejects the invalid updates, logs what changed and notifies the listeners.
+type store[T any] struct {
+	name string
+	// partial skips the validation, see AsLayer
+	partial bool
+
+	mu        sync.RWMutex
+	c         *T
+	listeners []Listener[T]
+}
+
+func newStore[T any](name string, o loaderOptions) *store[T] {
+	return &store[T]{name: name, partial: o.partial}
+}
+
+// LoaderOption is the option of the loaders.
+type LoaderOption func(*loaderOptions)
+
+type loaderOptions struct {
+	watch   bool
+	partial bool
+}
+
+func newLoaderOptions(opts []LoaderOption) loaderOptions {
+	var o loaderOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// AsLayer makes the loader a source of LayeredLoader, which may hold only a part of the config,
+// so the config is not validated by the loader but after merged by the LayeredLoader.
+func AsLayer() LoaderOption {
+	return func(o *loaderOptions) {
+		o.partial = true
+	}
+}
+
+func (s *store[T]) Get() *T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.c
+}
+
+// AddListener registers a listener which is notified after each change of the config.
+func (s *store[T]) AddListener(l Listener[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.listeners = append(s.listeners, l)
+}
+
+// update swaps in the config if it is valid, otherwise the current config is kept.
+func (s *store[T]) update(c *T) error {
+	if v, ok := any(c).(Validator); ok && !s.partial {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("invalid %s config: %w", s.name, err)
+		}
+	}
+
+	s.mu.Lock()
+	old := s.c
+	s.c = c
+	listeners := slices.Clone(s.listeners)
+	s.mu.Unlock()
+
+	if old != nil {
+		s.logChanges(old, c)
+	}
+	for _, l := range listeners {
+		l(old, c)
+	}
+	return nil
+}
+
+func (s *store[T]) logChanges(old, c *T) {
+	changes, err := diff.Diff(old, c)
+	if err != nil {
+		log.Error().Str("config", s.name).Err(err).Msg("diff config error")
+		return
+	}
+	for _, ch := range changes {
+		log.Info().
+			Str("config", s.name).
+			Str("type", ch.Type).
+			Strs("path", ch.Path).
+			Any("from", ch.From).
+			Any("to", ch.To).
+			Msg("config changed")
+	}
+}
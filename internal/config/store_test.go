@@ -0,0 +1,67 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hedon954/go-matcher/internal/constant"
+	"github.com/hedon954/go-matcher/pkg/algorithm/glicko2"
+)
+
+func TestStore_update(t *testing.T) {
+	var _ Notifier[MatchConfig] = &FileLoader[MatchConfig]{}
+
+	loader := NewFileLoader[MatchConfig]("../../fixtures/match_conf_test.yml")
+	old := loader.Get()
+	var notified [][2]*MatchConfig
+	loader.AddListener(func(old, new *MatchConfig) {
+		notified = append(notified, [2]*MatchConfig{old, new})
+	})
+
+	// invalid config is rejected and the current one is kept
+	bad := *old
+	bad.GroupPlayerLimit = 0
+	bad.DelayTimerConfig = &DelayTimerConfig{InviteTimeoutMs: -1}
+	err := loader.update(&bad)
+	assert.ErrorContains(t, err, "group_player_limit should be positive, got 0")
+	assert.ErrorContains(t, err, "delay_timer_config: invite_timeout_ms should be positive, got -1")
+	assert.Equal(t, old, loader.Get())
+	assert.Equal(t, 0, len(notified))
+
+	// valid config takes effect and the listeners are notified
+	good := *old
+	good.GroupPlayerLimit = 4
+	assert.Nil(t, loader.update(&good))
+	assert.Equal(t, &good, loader.Get())
+	assert.Equal(t, [][2]*MatchConfig{{old, &good}}, notified)
+}
+
+func TestMatchConfig_Validate(t *testing.T) {
+	conf := NewFileLoader[MatchConfig]("../../fixtures/match_conf_test.yml").Get()
+	assert.Nil(t, conf.Validate())
+
+	args := *conf.Glicko2[constant.GameModeGoatGame]
+	args.TeamPlayerLimit = 0
+	args.MatchRanges = []glicko2.MatchRange{{MaxMatchSec: 10}, {MaxMatchSec: 5, MMRGapPercent: 120}}
+	bad := *conf
+	bad.Glicko2 = map[constant.GameMode]*glicko2.QueueArgs{constant.GameModeGoatGame: &args}
+	bad.DelayTimerType = "unknown"
+	bad.Modes = map[constant.GameMode]*GameModeConfig{constant.GameModeGoatGame: {MatchStrategy: 9}}
+	bad.Invite = &InviteConfig{RateLimit: 5}
+	bad.ShareToken = &ShareTokenConfig{Secret: "secret"}
+
+	err := bad.Validate()
+	assert.ErrorContains(t, err, `unknown delay_timer_type "unknown"`)
+	assert.ErrorContains(t, err, "glicko2[905]: team_player_limit should be positive, got 0")
+	assert.ErrorContains(t, err, "match_ranges[1]: mmr_gap_percent should be in [0, 100], got 120")
+	assert.ErrorContains(t, err, "match_ranges[1]: max_match_sec should be greater than the previous one 10, got 5")
+	assert.ErrorContains(t, err, "modes[905]: unknown match_strategy 9")
+	assert.ErrorContains(t, err, "invite: rate_limit_window_sec should be positive if rate_limit is set")
+	assert.ErrorContains(t, err, "share_token: expire_sec should be positive if secret is set, got 0")
+
+	// sharing is disabled without secret, so the expiry is not required
+	bad = *conf
+	bad.ShareToken = &ShareTokenConfig{}
+	assert.Nil(t, bad.Validate())
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hedon954/go-matcher/internal/constant"
+)
+
+// Validate checks the match config before it takes effect,
+// all the problems found are joined into the returned error.
+func (c *MatchConfig) Validate() error {
+	var errs []error
+	if c.GroupPlayerLimit <= 0 {
+		errs = append(errs, fmt.Errorf("group_player_limit should be positive, got %d", c.GroupPlayerLimit))
+	}
+	if c.MatchIntervalMs <= 0 {
+		errs = append(errs, fmt.Errorf("match_interval_ms should be positive, got %d", c.MatchIntervalMs))
+	}
+	switch c.DelayTimerType {
+	case DelayTimerTypeAsynq, DelayTimerTypeNative:
+	default:
+		errs = append(errs, fmt.Errorf("unknown delay_timer_type %q", c.DelayTimerType))
+	}
+	if c.DelayTimerConfig == nil {
+		errs = append(errs, errors.New("delay_timer_config should not be empty"))
+	} else if err := c.DelayTimerConfig.validate(true); err != nil {
+		errs = append(errs, fmt.Errorf("delay_timer_config: %w", err))
+	}
+
+	for mode, args := range c.Glicko2 {
+		if args == nil {
+			errs = append(errs, fmt.Errorf("glicko2[%d] should not be empty", mode))
+		} else if err := args.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("glicko2[%d]: %w", mode, err))
+		}
+	}
+	for mode, rc := range c.ReadyCheck {
+		if rc != nil && rc.TimeoutMs <= 0 {
+			errs = append(errs, fmt.Errorf("ready_check[%d]: timeout_ms should be positive, got %d", mode, rc.TimeoutMs))
+		}
+		if rc != nil && rc.PenaltySec < 0 {
+			errs = append(errs, fmt.Errorf("ready_check[%d]: penalty_sec should not be negative, got %d", mode, rc.PenaltySec))
+		}
+	}
+	for mode, limit := range c.SpectatorLimit {
+		if limit < 0 {
+			errs = append(errs, fmt.Errorf("spectator_limit[%d] should not be negative, got %d", mode, limit))
+		}
+	}
+	for mode, mc := range c.Modes {
+		if mc == nil {
+			continue
+		}
+		if err := mc.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("modes[%d]: %w", mode, err))
+		}
+	}
+
+	if c.ShareToken != nil && c.ShareToken.Secret != "" && c.ShareToken.ExpireSec <= 0 {
+		errs = append(errs, fmt.Errorf("share_token: expire_sec should be positive if secret is set, got %d", c.ShareToken.ExpireSec))
+	} else if c.ShareToken != nil && c.ShareToken.ExpireSec < 0 {
+		errs = append(errs, fmt.Errorf("share_token: expire_sec should not be negative, got %d", c.ShareToken.ExpireSec))
+	}
+	if c.Invite != nil && (c.Invite.RateLimit < 0 || c.Invite.RateLimitWindowSec < 0) {
+		errs = append(errs, errors.New("invite: rate_limit and rate_limit_window_sec should not be negative"))
+	}
+	if c.Invite != nil && c.Invite.RateLimit > 0 && c.Invite.RateLimitWindowSec == 0 {
+		errs = append(errs, errors.New("invite: rate_limit_window_sec should be positive if rate_limit is set"))
+	}
+	if c.GroupChat != nil && (c.GroupChat.MaxLength < 0 || c.GroupChat.RateLimit < 0 || c.GroupChat.RateLimitWindowSec < 0) {
+		errs = append(errs, errors.New("group_chat: max_length, rate_limit and rate_limit_window_sec should not be negative"))
+	}
+	return errors.Join(errs...)
+}
+
+// validate checks the timeouts of the delay timers,
+// they should all be set if required, otherwise the zero ones fall back to the globals.
+func (dtc *DelayTimerConfig) validate(required bool) error {
+	var errs []error
+	for _, t := range []struct {
+		name string
+		ms   int64
+	}{
+		{"invite_timeout_ms", dtc.InviteTimeoutMs},
+		{"match_timeout_ms", dtc.MatchTimeoutMs},
+		{"wait_attr_timeout_ms", dtc.WaitAttrTimeoutMs},
+		{"clear_room_timeout_ms", dtc.ClearRoomTimeoutMs},
+	} {
+		if t.ms < 0 || (required && t.ms == 0) {
+			errs = append(errs, fmt.Errorf("%s should be positive, got %d", t.name, t.ms))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (mc *GameModeConfig) validate() error {
+	var errs []error
+	if mc.GroupPlayerLimit < 0 {
+		errs = append(errs, fmt.Errorf("group_player_limit should not be negative, got %d", mc.GroupPlayerLimit))
+	}
+	if mc.DelayTimerConfig != nil {
+		if err := mc.DelayTimerConfig.validate(false); err != nil {
+			errs = append(errs, fmt.Errorf("delay_timer_config: %w", err))
+		}
+	}
+	switch mc.MatchStrategy {
+	case 0, constant.MatchStrategyGlicko2:
+	default:
+		errs = append(errs, fmt.Errorf("unknown match_strategy %d", mc.MatchStrategy))
+	}
+	if mc.InviteExpireSec < 0 {
+		errs = append(errs, fmt.Errorf("invite_expire_sec should not be negative, got %d", mc.InviteExpireSec))
+	}
+	return errors.Join(errs...)
+}
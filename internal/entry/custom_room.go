@@ -0,0 +1,25 @@
+package entry
+
+// CustomRoom holds the settings of a room created by a player instead of the matcher.
+// The players join it by the invite code and the owner places them into the team slots manually.
+type CustomRoom struct {
+	// Owner is the player who created the room, only the owner can move players and start the game.
+	Owner string
+
+	// Code is the invite code to join the room.
+	Code string
+
+	// Password is required to join the room if not empty.
+	Password string
+
+	// TeamPlayerLimit is the max players of each team slot.
+	TeamPlayerLimit int
+
+	// Started indicates the game of the room has started, no one can join it anymore.
+	Started bool
+}
+
+// IsCustom checks if the room is a custom room.
+func (r *RoomBase) IsCustom() bool {
+	return r.Custom != nil
+}
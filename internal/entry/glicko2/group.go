@@ -1,7 +1,9 @@
 package glicko2
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cast"
@@ -13,15 +15,22 @@ import (
 
 type GroupBaseGlicko2 struct {
 	*entry.GroupBase
-	playerMgr *entry.PlayerMgr `msgpack:"-"`
+	playerMgr *entry.PlayerMgr     `msgpack:"-"`
+	sm        *entry.StateMachines `msgpack:"-"`
+
+	// queueArgs returns the args of the queue the group is matching in,
+	// it is set when the group starts to match and read by the match ticks, so it is guarded by argsLock.
+	queueArgs func() *glicko2.QueueArgs `msgpack:"-"`
+	argsLock  sync.RWMutex              `msgpack:"-"`
 }
 
-func NewGroup(base *entry.GroupBase, playerMgr *entry.PlayerMgr) *GroupBaseGlicko2 {
+func NewGroup(base *entry.GroupBase, playerMgr *entry.PlayerMgr, sm *entry.StateMachines) *GroupBaseGlicko2 {
 	base.SupportMatchStrategies = append(base.SupportMatchStrategies, constant.MatchStrategyGlicko2)
 
 	g := &GroupBaseGlicko2{
 		GroupBase: base,
 		playerMgr: playerMgr,
+		sm:        sm,
 	}
 	return g
 }
@@ -31,7 +40,12 @@ func (g *GroupBaseGlicko2) GetID() string {
 }
 
 func (g *GroupBaseGlicko2) QueueKey() string {
-	return fmt.Sprintf("%d-%d", g.GameMode, g.ModeVersion)
+	return QueueKey(g.GameMode, g.ModeVersion)
+}
+
+// QueueKey returns the key of the glicko2 queue the groups of the game mode and mode version match in.
+func QueueKey(mode constant.GameMode, modeVersion int64) string {
+	return fmt.Sprintf("%d-%d", mode, modeVersion)
 }
 
 func (g *GroupBaseGlicko2) GetPlayers() []glicko2.Player {
@@ -75,11 +89,11 @@ func (g *GroupBaseGlicko2) GetState() glicko2.GroupState {
 	g.Lock()
 	defer g.Unlock()
 	switch g.Base().GetState() {
-	case entry.GroupStateDissolved, entry.GroupStateInvite:
+	case entry.GroupStateDissolved, entry.GroupStateInvite, entry.GroupStateCustomRoom:
 		return glicko2.GroupStateUnready
 	case entry.GroupStateMatch:
 		return glicko2.GroupStateQueuing
-	case entry.GroupStateGame:
+	case entry.GroupStateGame, entry.GroupStateReadyCheck:
 		return glicko2.GroupStateMatched
 	}
 	panic(fmt.Sprintf("unreachable, state: %d", g.GetState()))
@@ -88,13 +102,19 @@ func (g *GroupBaseGlicko2) GetState() glicko2.GroupState {
 func (g *GroupBaseGlicko2) SetState(state glicko2.GroupState) {
 	g.Lock()
 	defer g.Unlock()
+	var to entry.GroupState
 	switch state {
 	case glicko2.GroupStateUnready:
-		g.Base().SetState(entry.GroupStateInvite)
+		to = entry.GroupStateInvite
 	case glicko2.GroupStateQueuing:
-		g.Base().SetState(entry.GroupStateMatch)
+		to = entry.GroupStateMatch
 	case glicko2.GroupStateMatched:
-		g.Base().SetState(entry.GroupStateGame)
+		to = entry.GroupStateGame
+	default:
+		return
+	}
+	if err := g.sm.Group.Transit(context.Background(), g.Base(), to); err != nil {
+		log.Error().Int64("group_id", g.ID()).Err(err).Msg("failed to set group state")
 	}
 }
 
@@ -109,11 +129,17 @@ func (g *GroupBaseGlicko2) SetFinishMatchTimeSec(t int64) {
 }
 
 func (g *GroupBaseGlicko2) Type() glicko2.GroupType {
-	return glicko2.GroupTypeNormalTeam
+	return glicko2.ClassifyGroup(g.GetPlayers(), g.getQueueArgs())
 }
 
-func (g *GroupBaseGlicko2) CanFillAi() bool {
-	return false
+// CanFillAi checks if the group is a newcomer group which has waited long enough at now to be matched with AI.
+func (g *GroupBaseGlicko2) CanFillAi(now int64) bool {
+	args := g.getQueueArgs()
+	if args == nil {
+		return false
+	}
+	waitSec := now - g.GetStartMatchTimeSec()
+	return args.Newcomer.CanFillAi(g.IsNewer(now), waitSec)
 }
 
 func (g *GroupBaseGlicko2) ForceCancelMatch(reason string, waitSec int64) {
@@ -124,20 +150,74 @@ func (g *GroupBaseGlicko2) ForceCancelMatch(reason string, waitSec int64) {
 		Msg("force cancel match")
 	g.Lock()
 	defer g.Unlock()
-	g.Base().SetState(entry.GroupStateInvite)
+	ctx := context.Background()
+	if err := g.sm.Group.Transit(ctx, g.Base(), entry.GroupStateInvite); err != nil {
+		log.Error().Int64("group_id", g.ID()).Err(err).Msg("failed to set group state")
+		return
+	}
 	for _, puid := range g.Base().GetPlayers() {
 		p := g.playerMgr.Get(puid)
 		p.Base().Lock()
-		p.Base().SetOnlineState(entry.PlayerOnlineStateInGroup)
+		if err := g.sm.Player.Transit(ctx, p.Base(), entry.PlayerOnlineStateInGroup); err != nil {
+			log.Error().Str("uid", puid).Err(err).Msg("failed to set player state")
+		}
 		p.Base().Unlock()
 	}
 	// TODO: push cancel match to users
 }
 
-func (g *GroupBaseGlicko2) IsNewer() bool {
+// IsNewer checks if the group is a newcomer group at now by the newcomer args of the queue.
+func (g *GroupBaseGlicko2) IsNewer(now int64) bool {
+	args := g.getQueueArgs()
+	if args == nil {
+		return false
+	}
+	return args.Newcomer.IsNewcomerGroup(g.GetPlayers(), now)
+}
+
+// IsPenalized checks if any player of the group is under the ready check penalty at now.
+func (g *GroupBaseGlicko2) IsPenalized(now int64) bool {
+	for _, puid := range g.Base().GetPlayers() {
+		if g.playerMgr.Get(puid).Base().GetPenaltyUntilSecWithLock() > now {
+			return true
+		}
+	}
 	return false
 }
 
+func (g *GroupBaseGlicko2) GetRegionPings() map[string]int64 {
+	players := g.Base().GetPlayers()
+	pings := make([]map[string]int64, len(players))
+	for i, puid := range players {
+		pings[i] = g.playerMgr.Get(puid).Base().RegionPings
+	}
+	return glicko2.MergeRegionPings(pings...)
+}
+
 func (g *GroupBaseGlicko2) SetPlayerMgr(playerMgr *entry.PlayerMgr) {
 	g.playerMgr = playerMgr
 }
+
+// SetStateMachines sets the state machines to transit the group and its players,
+// it is not encoded so it should be set again when the group is decoded.
+func (g *GroupBaseGlicko2) SetStateMachines(sm *entry.StateMachines) {
+	g.sm = sm
+}
+
+// SetQueueArgsFunc sets the func returning the args of the queue the group is matching in,
+// it is not encoded so it should be set again when the group is decoded.
+func (g *GroupBaseGlicko2) SetQueueArgsFunc(f func() *glicko2.QueueArgs) {
+	g.argsLock.Lock()
+	defer g.argsLock.Unlock()
+	g.queueArgs = f
+}
+
+func (g *GroupBaseGlicko2) getQueueArgs() *glicko2.QueueArgs {
+	g.argsLock.RLock()
+	f := g.queueArgs
+	g.argsLock.RUnlock()
+	if f == nil {
+		return nil
+	}
+	return f()
+}
@@ -7,19 +7,25 @@ import (
 
 type PlayerBaseGlicko2 struct {
 	*entry.PlayerBase
-	MMR            float64
-	Star           int64
-	StartMatchSec  int64
-	FinishMatchSec int64
-	Rank           int64
+	MMR              float64
+	Star             int64
+	StartMatchSec    int64
+	FinishMatchSec   int64
+	Rank             int64
+	RD               float64
+	AccountCreateSec int64
+	MatchCount       int64
 }
 
 func CreatePlayerBase(p *entry.PlayerBase, info *pto.Glicko2Info) *PlayerBaseGlicko2 {
 	return &PlayerBaseGlicko2{
-		PlayerBase: p,
-		MMR:        info.MMR,
-		Star:       info.Star,
-		Rank:       info.Rank,
+		PlayerBase:       p,
+		MMR:              info.MMR,
+		Star:             info.Star,
+		Rank:             info.Rank,
+		RD:               info.RD,
+		AccountCreateSec: info.AccountCreateSec,
+		MatchCount:       info.MatchCount,
 	}
 }
 
@@ -28,7 +34,7 @@ func (p *PlayerBaseGlicko2) GetID() string {
 }
 
 func (p *PlayerBaseGlicko2) IsAi() bool {
-	return false
+	return p.IsAI
 }
 
 func (p *PlayerBaseGlicko2) GetMMR() float64 {
@@ -58,3 +64,15 @@ func (p *PlayerBaseGlicko2) SetFinishMatchTimeSec(t int64) {
 func (p *PlayerBaseGlicko2) GetRank() int {
 	return int(p.Rank)
 }
+
+func (p *PlayerBaseGlicko2) GetRD() float64 {
+	return p.RD
+}
+
+func (p *PlayerBaseGlicko2) GetMatchCount() int64 {
+	return p.MatchCount
+}
+
+func (p *PlayerBaseGlicko2) GetAccountCreateTimeSec() int64 {
+	return p.AccountCreateSec
+}
@@ -81,6 +81,12 @@ func (r *RoomBaseGlicko2) HasAi() bool {
 	return false
 }
 
+func (r *RoomBaseGlicko2) SetMMRSpread(spread float64) {
+	r.Lock()
+	defer r.Unlock()
+	r.Base().MMRSpread = spread
+}
+
 func (r *RoomBaseGlicko2) SetTeamMgr(mgr TeamMgr) {
 	r.teamMgr = mgr
 }
@@ -40,6 +40,12 @@ func (t *TeamBaseGlicko2) AddGroup(g glicko2.Group) {
 	t.Base().AddGroup(g.(entry.Group))
 }
 
+func (t *TeamBaseGlicko2) RemoveGroup(g glicko2.Group) {
+	t.Lock()
+	defer t.Unlock()
+	t.Base().RemoveGroup(g.(entry.Group).ID())
+}
+
 func (t *TeamBaseGlicko2) PlayerCount() int {
 	count := 0
 	for _, g := range t.GetGroups() {
@@ -97,17 +103,27 @@ func (t *TeamBaseGlicko2) IsAi() bool {
 	return false
 }
 
-func (t *TeamBaseGlicko2) CanFillAi() bool {
-	return false
+// CanFillAi returns true if all the groups in the team can be filled with AI at now.
+func (t *TeamBaseGlicko2) CanFillAi(now int64) bool {
+	groups := t.GetGroups()
+	if len(groups) == 0 {
+		return false
+	}
+	for _, g := range groups {
+		if !g.CanFillAi(now) {
+			return false
+		}
+	}
+	return true
 }
 
 func (t *TeamBaseGlicko2) IsFull(teamPlayerLimit int) bool {
 	return t.PlayerCount() >= teamPlayerLimit
 }
 
-func (t *TeamBaseGlicko2) IsNewer() bool {
+func (t *TeamBaseGlicko2) IsNewer(now int64) bool {
 	for _, g := range t.GetGroups() {
-		if g.IsNewer() {
+		if g.IsNewer(now) {
 			return true
 		}
 	}
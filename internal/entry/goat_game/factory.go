@@ -29,7 +29,7 @@ func (f *factory) CreateGroup(mgr *entry.Mgrs, base *entry.GroupBase) (entry.Gro
 	g := &Group{}
 	// ... other common fields
 
-	g.withMatchStrategy(base, mgr.PlayerMgr)
+	g.withMatchStrategy(base, mgr.PlayerMgr, mgr.SM)
 	return g, nil
 }
 
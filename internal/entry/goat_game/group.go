@@ -12,8 +12,8 @@ type Group struct {
 // withMatchStrategy initializes the parameters related to the match strategy.
 // We do not initialize the parameters according to the match strategy here,
 // because we want to switch the match strategy dynamically without re-initializing the Group object.
-func (g *Group) withMatchStrategy(base *entry.GroupBase, playerMgr *entry.PlayerMgr) {
-	g.GroupBaseGlicko2 = glicko2.NewGroup(base, playerMgr)
+func (g *Group) withMatchStrategy(base *entry.GroupBase, playerMgr *entry.PlayerMgr, sm *entry.StateMachines) {
+	g.GroupBaseGlicko2 = glicko2.NewGroup(base, playerMgr, sm)
 	// ... other match strategy initialization
 }
 
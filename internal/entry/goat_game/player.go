@@ -49,6 +49,9 @@ func (p *Player) setGlicko2Attr(extra []byte) error {
 	p.Glicko2Info.MMR = attribute.Mmr
 	p.Glicko2Info.Rank = p.Rank
 	p.Glicko2Info.Star = p.Star
+	p.TotalPvpCount = attribute.TotalPvpCount
+	// goat game counts the pvp matches
+	p.MatchCount = attribute.TotalPvpCount
 	return nil
 }
 
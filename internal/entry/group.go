@@ -3,6 +3,8 @@ package entry
 import (
 	"slices"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/hedon954/go-matcher/internal/constant"
 	"github.com/hedon954/go-matcher/internal/merr"
 	"github.com/hedon954/go-matcher/internal/pto"
@@ -63,6 +65,8 @@ const (
 	InviteExpireSec = 60 * 5
 )
 
+// GroupState is the state of a group,
+// the transitions are declared in NewGroupStateMachine.
 type GroupState int8
 
 const (
@@ -70,6 +74,12 @@ const (
 	GroupStateMatch     GroupState = 1
 	GroupStateGame      GroupState = 2
 	GroupStateDissolved GroupState = 3
+
+	// GroupStateReadyCheck means the group is matched and waiting for its players to accept the match.
+	GroupStateReadyCheck GroupState = 4
+
+	// GroupStateCustomRoom means the group is in a custom room waiting for the owner to start the game.
+	GroupStateCustomRoom GroupState = 5
 )
 
 type GroupRole int8
@@ -113,6 +123,10 @@ type GroupBase struct {
 	// MatchID is a unique id to identify each match action.
 	MatchID string
 
+	// SpanCtx is the span context of the request starting the match,
+	// the matcher traces the group as a child of it or links to it when the group is matched into a room.
+	SpanCtx trace.SpanContext `msgpack:"-" json:"-"`
+
 	// StartMatchTimeSec is the start match time of the group.
 	StartMatchTimeSec int64
 
@@ -129,9 +143,9 @@ type GroupBase struct {
 	UnReadyPlayer map[string]struct{}
 
 	// InviteRecords holds the invite records of the group.
-	// key: uid
-	// value: expire time (s)
-	InviteRecords map[string]int64
+	// key: invitee uid
+	// value: the pending invitation, a new invitation to the same invitee replaces the old one
+	InviteRecords InviteRecords
 
 	// Settings holds the settings of the group.
 	Settings GroupSettings
@@ -146,6 +160,16 @@ type GroupSettings struct {
 
 	// RecentJoinAllowed indicates whether recent players can join the group.
 	RecentJoinAllowed bool
+
+	// FriendJoinAllowed indicates whether the friends of the group players can join the group.
+	FriendJoinAllowed bool
+
+	// WorldChannelJoinAllowed indicates whether players from world channel can join the group.
+	WorldChannelJoinAllowed bool
+
+	// ClanChannelJoinAllowed indicates whether the clan members of the group players
+	// can join the group from clan channel.
+	ClanChannelJoinAllowed bool
 }
 
 type GroupConfig struct {
@@ -165,7 +189,7 @@ func NewGroupBase(
 		ModeVersion:            playerBase.ModeVersion,
 		Players:                make([]string, 0, playerLimit),
 		Roles:                  make(map[string]GroupRole, playerLimit),
-		InviteRecords:          make(map[string]int64, playerLimit),
+		InviteRecords:          make(InviteRecords, playerLimit),
 		SupportMatchStrategies: make([]constant.MatchStrategy, 0),
 		UnReadyPlayer:          make(map[string]struct{}, playerLimit),
 		Configs:                GroupConfig{PlayerLimit: playerLimit, InviteExpireSec: InviteExpireSec},
@@ -229,7 +253,6 @@ func (g *GroupBase) AddPlayer(p Player) error {
 		g.Roles[p.UID()] = GroupRoleCaptain
 	}
 	p.Base().GroupID = g.ID()
-	p.Base().SetOnlineState(PlayerOnlineStateInGroup)
 	for i, puid := range g.Players {
 		if puid == p.UID() {
 			g.Players[i] = p.UID()
@@ -285,6 +308,8 @@ func (g *GroupBase) PlayerExists(uid string) bool {
 	return false
 }
 
+// SetState sets the state without validation,
+// the state changes should go through the GroupStateMachine.
 func (g *GroupBase) SetState(s GroupState) {
 	g.State = s
 }
@@ -330,6 +355,10 @@ func (g *GroupBase) CheckState(valids ...GroupState) error {
 		return merr.ErrGroupInGame
 	case GroupStateDissolved:
 		return merr.ErrGroupDissolved
+	case GroupStateReadyCheck:
+		return merr.ErrGroupInReadyCheck
+	case GroupStateCustomRoom:
+		return merr.ErrGroupInCustomRoom
 	}
 
 	panic("unreachable")
@@ -377,22 +406,65 @@ func (g *GroupBase) AllowRecentJoin() bool {
 	return g.Settings.RecentJoinAllowed
 }
 
-func (g *GroupBase) AddInviteRecord(inviteeUID string, nowUnix int64) {
-	g.InviteRecords[inviteeUID] = nowUnix + g.Configs.InviteExpireSec
+func (g *GroupBase) SetAllowFriendJoin(allow bool) {
+	g.Settings.FriendJoinAllowed = allow
+}
+
+func (g *GroupBase) SetAllowWorldChannelJoin(allow bool) {
+	g.Settings.WorldChannelJoinAllowed = allow
+}
+
+func (g *GroupBase) SetAllowClanChannelJoin(allow bool) {
+	g.Settings.ClanChannelJoinAllowed = allow
+}
+
+func (g *GroupBase) AllowFriendJoin() bool {
+	return g.Settings.FriendJoinAllowed
+}
+
+func (g *GroupBase) AllowWorldChannelJoin() bool {
+	return g.Settings.WorldChannelJoinAllowed
+}
+
+func (g *GroupBase) AllowClanChannelJoin() bool {
+	return g.Settings.ClanChannelJoinAllowed
+}
+
+// AddInviteRecord adds an invitation from the inviter to the invitee with the id,
+// it expires after InviteExpireSec of the group, the replaced invitation is returned if any.
+func (g *GroupBase) AddInviteRecord(id int64, inviterUID, inviteeUID string, nowUnix int64) (old *InviteRecord) {
+	old = g.InviteRecords[inviteeUID]
+	g.InviteRecords[inviteeUID] = &InviteRecord{
+		ID:         id,
+		InviterUID: inviterUID,
+		InviteeUID: inviteeUID,
+		CreateSec:  nowUnix,
+		ExpireSec:  nowUnix + g.Configs.InviteExpireSec,
+	}
+	return old
 }
 
 func (g *GroupBase) DelInviteRecord(inviteeUID string) {
 	delete(g.InviteRecords, inviteeUID)
 }
 
-func (g *GroupBase) GetInviteRecords() map[string]int64 {
+func (g *GroupBase) GetInviteRecords() InviteRecords {
 	return g.InviteRecords
 }
+
+func (g *GroupBase) GetInviteRecord(inviteeUID string) *InviteRecord {
+	return g.InviteRecords[inviteeUID]
+}
+
 func (g *GroupBase) GetInviteExpireTimeStamp(uid string) int64 {
-	return g.InviteRecords[uid]
+	if r := g.InviteRecords[uid]; r != nil {
+		return r.ExpireSec
+	}
+	return 0
 }
+
 func (g *GroupBase) IsInviteExpired(uid string, nowUnix int64) bool {
-	return g.InviteRecords[uid] <= nowUnix
+	return g.GetInviteExpireTimeStamp(uid) <= nowUnix
 }
 
 func (g *GroupBase) Lock() {
@@ -0,0 +1,42 @@
+package entry
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// InviteRecord is a pending invitation to join a group.
+type InviteRecord struct {
+	// ID is unique among the pending invitations, used to track the expiry.
+	ID         int64
+	InviterUID string
+	InviteeUID string
+	CreateSec  int64
+	ExpireSec  int64
+}
+
+// InviteRecords holds the pending invitations of a group by the invitee uid.
+type InviteRecords map[string]*InviteRecord
+
+// DecodeMsgpack decodes the invite records, the old backups kept only the expire timestamp of each invitee,
+// which are decoded as the invitations without id and inviter.
+func (rs *InviteRecords) DecodeMsgpack(dec *msgpack.Decoder) error {
+	var raws map[string]msgpack.RawMessage
+	if err := dec.Decode(&raws); err != nil {
+		return err
+	}
+	res := make(InviteRecords, len(raws))
+	for uid, raw := range raws {
+		var expireSec int64
+		if err := msgpack.Unmarshal(raw, &expireSec); err == nil {
+			res[uid] = &InviteRecord{InviteeUID: uid, ExpireSec: expireSec}
+			continue
+		}
+		r := new(InviteRecord)
+		if err := msgpack.Unmarshal(raw, r); err != nil {
+			return err
+		}
+		res[uid] = r
+	}
+	*rs = res
+	return nil
+}
@@ -0,0 +1,37 @@
+package entry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestInviteRecords_DecodeMsgpack(t *testing.T) {
+	type oldGroup struct {
+		InviteRecords map[string]int64
+	}
+	type group struct {
+		InviteRecords InviteRecords
+	}
+
+	// the records are kept as they are
+	records := InviteRecords{"b": {ID: 1, InviterUID: "a", InviteeUID: "b", CreateSec: 10, ExpireSec: 20}}
+	bs, err := msgpack.Marshal(&group{InviteRecords: records})
+	assert.Nil(t, err)
+	var g group
+	assert.Nil(t, msgpack.Unmarshal(bs, &g))
+	assert.Equal(t, records, g.InviteRecords)
+
+	// the old backups only kept the expire timestamps
+	bs, err = msgpack.Marshal(&oldGroup{InviteRecords: map[string]int64{"b": 20}})
+	assert.Nil(t, err)
+	g = group{}
+	assert.Nil(t, msgpack.Unmarshal(bs, &g))
+	assert.Equal(t, InviteRecords{"b": {InviteeUID: "b", ExpireSec: 20}}, g.InviteRecords)
+
+	// the invalid records are rejected
+	bs, err = msgpack.Marshal(map[string]any{"InviteRecords": map[string]string{"b": "x"}})
+	assert.Nil(t, err)
+	assert.NotNil(t, msgpack.Unmarshal(bs, &g))
+}
@@ -11,6 +11,7 @@ type Mgrs struct {
 	GroupMgr  *GroupMgr
 	TeamMgr   *TeamMgr
 	RoomMgr   *RoomMgr
+	SM        *StateMachines
 }
 
 func (m *Mgrs) CreatePlayer(pInfo *pto.PlayerInfo) (p Player, err error) {
@@ -67,9 +68,13 @@ func (m *Mgrs) CreateTeam(g Group) (t Team, err error) {
 	return t, nil
 }
 
+// CreateAITeam creates a team of the AI group, the team is marked as AI.
 func (m *Mgrs) CreateAITeam(g Group) (t Team, err error) {
-	base := NewTeamBase(m.TeamMgr.teamIDIter.Add(1), g)
-	base.Base().RemoveGroup(g.ID())
+	t, err = m.CreateTeam(g)
+	if err != nil {
+		return nil, err
+	}
+	t.Base().IsAI = true
 	return t, nil
 }
 
@@ -16,8 +16,8 @@ type Player interface {
 	SetAttr(attr *pto.UploadPlayerAttr) error
 }
 
-// PlayerOnlineState is the state of a player.
-// TODO: try to use state machine to manage player state.
+// PlayerOnlineState is the state of a player,
+// the transitions are declared in NewPlayerStateMachine.
 type PlayerOnlineState int8
 
 const (
@@ -49,6 +49,10 @@ type PlayerBase struct {
 	OnlineState PlayerOnlineState
 	VoiceState  PlayerVoiceState
 
+	// PenaltyUntilSec is the time until which the player is deprioritized in the queue
+	// for declining a ready check.
+	PenaltyUntilSec int64
+
 	// TODO: other common attributes
 	pto.PlayerInfo
 	pto.Attribute
@@ -101,6 +105,8 @@ func (p *PlayerBase) CheckOnlineState(valids ...PlayerOnlineState) error {
 	panic("unreachable")
 }
 
+// SetOnlineState sets the state without validation,
+// the state changes should go through the PlayerStateMachine.
 func (p *PlayerBase) SetOnlineState(s PlayerOnlineState) {
 	p.OnlineState = s
 }
@@ -154,6 +160,18 @@ func (p *PlayerBase) SetMatchStrategyWithLock(s constant.MatchStrategy) {
 	p.MatchStrategy = s
 }
 
+func (p *PlayerBase) GetPenaltyUntilSecWithLock() int64 {
+	p.Lock()
+	defer p.Unlock()
+	return p.PenaltyUntilSec
+}
+
+func (p *PlayerBase) SetPenaltyUntilSecWithLock(sec int64) {
+	p.Lock()
+	defer p.Unlock()
+	p.PenaltyUntilSec = sec
+}
+
 func (p *PlayerBase) Lock() {
 	p.L.Lock()
 }
@@ -1,6 +1,7 @@
 package entry
 
 import (
+	"slices"
 	"sync"
 
 	"github.com/hedon954/go-matcher/internal/constant"
@@ -29,6 +30,26 @@ type RoomBase struct {
 
 	EscapePlayer []string
 
+	// Spectators holds the players watching the game of the room, they are not part of any team.
+	Spectators []string
+
+	// MMRSpread is the difference between the biggest and the smallest team MMR when the room is matched.
+	MMRSpread float64
+
+	// WithAI indicates the room is matched to be filled with AI.
+	WithAI bool
+
+	// ReadyCheckDeadlineSec is the deadline for the players to accept the match,
+	// 0 means the room is not in ready check.
+	ReadyCheckDeadlineSec int64
+	// AcceptedPlayers holds the players who have accepted the match in ready check.
+	AcceptedPlayers map[string]struct{}
+
+	// Custom holds the settings of the custom room, nil means the room is matched by the matcher.
+	Custom *CustomRoom
+
+	// Region is the region chosen by the matcher to play in, empty means any region.
+	Region         string
 	GameServerInfo pto.GameServerInfo
 }
 
@@ -38,6 +59,7 @@ func NewRoomBase(id int64, teamLimit int, t Team) *RoomBase {
 		TeamLimit:     teamLimit,
 		Teams:         make(map[int64]struct{}),
 		EscapePlayer:  make([]string, 0),
+		Spectators:    make([]string, 0),
 		GameMode:      t.Base().GameMode,
 		MatchStrategy: t.Base().MatchStrategy,
 		ModeVersion:   t.Base().ModeVersion,
@@ -65,13 +87,31 @@ func (r *RoomBase) RemoveTeam(id int64) {
 	delete(r.Teams, id)
 }
 
+// GetMatchInfo returns the match info of the room without the teams,
+// which are filled by the service holding the teams and the players.
 func (r *RoomBase) GetMatchInfo() *pto.MatchInfo {
-	// TODO
-	return nil
+	info := &pto.MatchInfo{
+		RoomID:          r.RoomID,
+		GameMode:        r.GameMode,
+		ModeVersion:     r.ModeVersion,
+		MatchStrategy:   r.MatchStrategy,
+		MatchedTimeUnix: r.FinishMatchSec,
+		GameServerInfo:  r.GameServerInfo,
+		Spectators:      make([]pto.MatchSpectatorInfo, 0, len(r.Spectators)),
+	}
+	for _, uid := range r.Spectators {
+		info.Spectators = append(info.Spectators, pto.MatchSpectatorInfo{UID: uid})
+	}
+	return info
 }
 
 func (r *RoomBase) NeedAI() bool {
-	return false
+	return r.WithAI
+}
+
+// InReadyCheck checks if the room is waiting for the players to accept the match.
+func (r *RoomBase) InReadyCheck() bool {
+	return r.ReadyCheckDeadlineSec > 0
 }
 
 func (r *RoomBase) AddEscapePlayer(uid string) {
@@ -82,6 +122,31 @@ func (r *RoomBase) GetEscapePlayers() []string {
 	return r.EscapePlayer
 }
 
+func (r *RoomBase) AddSpectator(uid string) {
+	r.Spectators = append(r.Spectators, uid)
+}
+
+// RemoveSpectator removes the spectator from the room, returns false if the player is not spectating.
+func (r *RoomBase) RemoveSpectator(uid string) bool {
+	i := slices.Index(r.Spectators, uid)
+	if i < 0 {
+		return false
+	}
+	r.Spectators = slices.Delete(r.Spectators, i, i+1)
+	return true
+}
+
+func (r *RoomBase) GetSpectators() []string {
+	return r.Spectators
+}
+
+// ClearSpectators releases all the spectators of the room and returns them.
+func (r *RoomBase) ClearSpectators() []string {
+	res := r.Spectators
+	r.Spectators = make([]string, 0)
+	return res
+}
+
 func (r *RoomBase) Lock() {
 	r.lock.Lock()
 }
@@ -0,0 +1,51 @@
+package entry
+
+import (
+	"github.com/hedon954/go-matcher/pkg/fsm"
+)
+
+// PlayerStateMachine manages the transitions of PlayerOnlineState.
+type PlayerStateMachine = fsm.Machine[PlayerOnlineState, *PlayerBase]
+
+// GroupStateMachine manages the transitions of GroupState.
+type GroupStateMachine = fsm.Machine[GroupState, *GroupBase]
+
+// StateMachines holds the state machines of players and groups,
+// all the state changes of them should go through the machines.
+type StateMachines struct {
+	Player *PlayerStateMachine
+	Group  *GroupStateMachine
+}
+
+func NewStateMachines() *StateMachines {
+	return &StateMachines{
+		Player: NewPlayerStateMachine(),
+		Group:  NewGroupStateMachine(),
+	}
+}
+
+// NewPlayerStateMachine creates a player state machine with the declared transition table.
+// The caller should hold the lock of the player when transiting.
+func NewPlayerStateMachine() *PlayerStateMachine {
+	return fsm.New("player", (*PlayerBase).GetOnlineState, (*PlayerBase).SetOnlineState).
+		Permit(PlayerOnlineStateOffline, PlayerOnlineStateOnline).
+		Permit(PlayerOnlineStateOnline, PlayerOnlineStateOffline, PlayerOnlineStateInGroup).
+		Permit(PlayerOnlineStateInGroup, PlayerOnlineStateOffline, PlayerOnlineStateOnline,
+			PlayerOnlineStateInMatch, PlayerOnlineStateInGame).
+		Permit(PlayerOnlineStateInMatch, PlayerOnlineStateOnline, PlayerOnlineStateInGroup,
+			PlayerOnlineStateInGame).
+		Permit(PlayerOnlineStateInGame, PlayerOnlineStateOnline, PlayerOnlineStateInGroup,
+			PlayerOnlineStateInSettle).
+		Permit(PlayerOnlineStateInSettle, PlayerOnlineStateOnline, PlayerOnlineStateInGroup)
+}
+
+// NewGroupStateMachine creates a group state machine with the declared transition table.
+// The caller should hold the lock of the group when transiting.
+func NewGroupStateMachine() *GroupStateMachine {
+	return fsm.New("group", (*GroupBase).GetState, (*GroupBase).SetState).
+		Permit(GroupStateInvite, GroupStateMatch, GroupStateCustomRoom, GroupStateDissolved).
+		Permit(GroupStateMatch, GroupStateInvite, GroupStateReadyCheck, GroupStateGame, GroupStateDissolved).
+		Permit(GroupStateReadyCheck, GroupStateInvite, GroupStateMatch, GroupStateGame, GroupStateDissolved).
+		Permit(GroupStateGame, GroupStateInvite, GroupStateMatch, GroupStateReadyCheck, GroupStateDissolved).
+		Permit(GroupStateCustomRoom, GroupStateInvite, GroupStateGame, GroupStateDissolved)
+}
@@ -22,6 +22,9 @@ type TeamBase struct {
 	GameMode      constant.GameMode
 	MatchStrategy constant.MatchStrategy
 	ModeVersion   int64
+
+	// OpenSlots is the number of players the team is waiting for to backfill in an in-progress room.
+	OpenSlots int
 }
 
 func NewTeamBase(id int64, g Group) *TeamBase {
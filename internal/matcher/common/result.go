@@ -1,8 +1,26 @@
 package common
 
-import "github.com/hedon954/go-matcher/internal/entry"
+import (
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+)
 
 type Result struct {
 	Room  entry.Room
 	Teams []entry.Team
+
+	// Backfill is set if the result fills the open slots of the in-progress Room,
+	// Teams is empty in this case.
+	Backfill *Backfill
+
+	// SpanCtx is the span context of the matcher forming the result,
+	// the service traces the handling of the result as a child of it.
+	SpanCtx trace.SpanContext
+}
+
+// Backfill is the groups matched to fill the open slots of a team in an in-progress room.
+type Backfill struct {
+	Team   entry.Team
+	Groups []entry.Group
 }
@@ -2,15 +2,18 @@ package glicko2
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/hedon954/go-matcher/internal/config"
 	"github.com/hedon954/go-matcher/internal/constant"
 	"github.com/hedon954/go-matcher/internal/entry"
+	glicko2Entry "github.com/hedon954/go-matcher/internal/entry/glicko2"
 	"github.com/hedon954/go-matcher/internal/matcher/common"
 	"github.com/hedon954/go-matcher/pkg/algorithm/glicko2"
 )
@@ -32,12 +35,18 @@ type Matcher struct {
 	// `value` is the glicko2 matcher.
 	matchers map[string]*glicko2.Matcher
 
+	// paused pauses all the matchers, including the ones created later.
+	paused atomic.Bool
+
 	// errChan is a channel for handle error form glicko2 matcher.
 	errChan chan error
 
 	// roomChan is a channel for handle room form glicko2 matcher.
 	roomChan chan glicko2.Room
 
+	// backfillChan is a channel for handle filled backfill requests form glicko2 matcher.
+	backfillChan chan glicko2.BackfillResult
+
 	// roomChannelToService is a channel for send room to service.
 	roomChannelToService chan common.Result
 
@@ -50,6 +59,11 @@ type Matcher struct {
 	matchInterval time.Duration
 }
 
+// queueArgsSetter is implemented by the groups which need the queue args to classify themselves.
+type queueArgsSetter interface {
+	SetQueueArgsFunc(f func() *glicko2.QueueArgs)
+}
+
 // Funcs is the funcs needed for glicko2 matcher.
 type Funcs struct {
 	ArgsFunc          func() *glicko2.QueueArgs
@@ -68,6 +82,7 @@ func New(
 		matchers:             make(map[string]*glicko2.Matcher, 8),
 		errChan:              make(chan error),
 		roomChan:             make(chan glicko2.Room),
+		backfillChan:         make(chan glicko2.BackfillResult),
 		roomChannelToService: roomChannelToService,
 		gameModes:            make(map[constant.GameMode]*Funcs, 16),
 		mgrs:                 mgrs,
@@ -115,6 +130,52 @@ func (m *Matcher) GetMatcher(key string) *glicko2.Matcher {
 	return m.matchers[key]
 }
 
+// Keys returns the sorted keys of the matchers.
+func (m *Matcher) Keys() []string {
+	m.RLock()
+	defer m.RUnlock()
+	keys := make([]string, 0, len(m.matchers))
+	for key := range m.matchers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Pause pauses all the matchers until Resume, the matchers created later are paused too.
+func (m *Matcher) Pause() {
+	m.Lock()
+	defer m.Unlock()
+	m.paused.Store(true)
+	for _, matcher := range m.matchers {
+		matcher.Pause()
+	}
+}
+
+// Resume resumes all the matchers, including the ones paused separately.
+func (m *Matcher) Resume() {
+	m.Lock()
+	defer m.Unlock()
+	m.paused.Store(false)
+	for _, matcher := range m.matchers {
+		matcher.Resume()
+	}
+}
+
+// Paused returns whether all the matchers are paused.
+func (m *Matcher) Paused() bool {
+	return m.paused.Load()
+}
+
+// QueuePaused returns whether the matcher of the key is paused, either separately or with all the matchers.
+func (m *Matcher) QueuePaused(key string) bool {
+	if m.Paused() {
+		return true
+	}
+	matcher := m.GetMatcher(key)
+	return matcher != nil && matcher.Paused()
+}
+
 // NewMatcher returns the new matcher of the given key,
 // if the key exists, it will return the existing one.
 // `key` is used to separate different matching groups.
@@ -131,23 +192,34 @@ func (m *Matcher) NewMatcher(
 		return matcher, nil
 	}
 
-	matcher, err = m.newMatcher(argsFunc, newTeamFunc, newRoomFunc, newRoomWithAIFunc)
+	matcher, err = m.newMatcher(key, argsFunc, newTeamFunc, newRoomFunc, newRoomWithAIFunc)
 	if err != nil {
 		return nil, err
 	}
 
+	if m.paused.Load() {
+		matcher.Pause()
+	}
 	m.matchers[key] = matcher
 	go matcher.Match(m.matchInterval)
 	return matcher, nil
 }
 
 func (m *Matcher) newMatcher(
+	key string,
 	argsFunc func() *glicko2.QueueArgs,
 	newTeamFunc func(group glicko2.Group) glicko2.Team,
 	newRoomFunc, newRoomWithAIFunc func(team glicko2.Team) glicko2.Room,
 ) (*glicko2.Matcher, error) {
-	return glicko2.NewMatcher(m.errChan, m.roomChan, argsFunc,
+	matcher, err := glicko2.NewMatcher(m.errChan, m.roomChan, argsFunc,
 		newTeamFunc, newRoomFunc, newRoomWithAIFunc)
+	if err != nil {
+		return nil, err
+	}
+	matcher.EnableBackfill(m.backfillChan)
+	matcher.SetMetrics(queueMetrics{key: key})
+	matcher.SetTracer(queueTracer{key: key})
+	return matcher, nil
 }
 
 func (m *Matcher) handleMatchResult() {
@@ -160,6 +232,8 @@ func (m *Matcher) handleMatchResult() {
 			m.handleError(err)
 		case room := <-m.roomChan:
 			m.handleSuccess(room)
+		case res := <-m.backfillChan:
+			m.handleBackfill(res)
 		}
 	}
 }
@@ -174,14 +248,56 @@ func (m *Matcher) handleSuccess(room glicko2.Room) {
 	log.Info().Any("room", room).Msg("glicko2 match success")
 	fmt.Println("glicko2 match success")
 
+	r := room.(entry.Room)
+	roomGroups := glicko2.RoomGroups(room)
+	r.Base().Region = glicko2.ChooseRegion(roomGroups)
+
+	groups := make([]entry.Group, len(roomGroups))
+	for i, g := range roomGroups {
+		groups[i] = g.(entry.Group)
+	}
+	span := startMatchedSpan("glicko2.Room", groups,
+		attribute.Int64("match.room_id", r.ID()),
+		attribute.String("match.region", r.Base().Region),
+		attribute.Int("match.groups", len(groups)),
+	)
+	defer span.End()
+
 	glicko2Teams := room.GetTeams()
 	teams := make([]entry.Team, len(glicko2Teams))
 	for i := 0; i < len(teams); i++ {
 		teams[i] = glicko2Teams[i].(entry.Team)
 	}
 	m.roomChannelToService <- common.Result{
-		Room:  room.(entry.Room),
-		Teams: teams,
+		Room:    r,
+		Teams:   teams,
+		SpanCtx: span.SpanContext(),
+	}
+}
+
+func (m *Matcher) handleBackfill(res glicko2.BackfillResult) {
+	log.Info().Any("groups", res.Groups).Msg("glicko2 backfill success")
+
+	groups := make([]entry.Group, len(res.Groups))
+	for i, g := range res.Groups {
+		groups[i] = g.(entry.Group)
+	}
+	r := res.Backfill.Room.(entry.Room)
+	t := res.Backfill.Team.(entry.Team)
+	span := startMatchedSpan("glicko2.Backfill", groups,
+		attribute.Int64("match.room_id", r.ID()),
+		attribute.Int64("match.team_id", t.ID()),
+		attribute.Int("match.groups", len(groups)),
+	)
+	defer span.End()
+
+	m.roomChannelToService <- common.Result{
+		Room: r,
+		Backfill: &common.Backfill{
+			Team:   t,
+			Groups: groups,
+		},
+		SpanCtx: span.SpanContext(),
 	}
 }
 
@@ -217,6 +333,10 @@ func (m *Matcher) Match(g glicko2.Group) {
 		return
 	}
 
+	if s, ok := g.(queueArgsSetter); ok {
+		s.SetQueueArgsFunc(funcs.ArgsFunc)
+	}
+
 	uids := make([]string, 0)
 	for _, player := range g.GetPlayers() {
 		uids = append(uids, player.GetID())
@@ -231,3 +351,37 @@ func (m *Matcher) Match(g glicko2.Group) {
 		return
 	}
 }
+
+// OpenBackfill requests the matcher of the room to fill `slots` players into the team.
+func (m *Matcher) OpenBackfill(r entry.Room, t entry.Team, slots int) error {
+	mode := r.Base().GameMode
+	funcs := m.GetFuncs(mode)
+	if funcs == nil {
+		return fmt.Errorf("game mode glicko2 funcs not register: %d", mode)
+	}
+
+	matcher, err := m.NewMatcher(glicko2Entry.QueueKey(mode, r.Base().ModeVersion),
+		funcs.ArgsFunc, funcs.NewTeamFunc, funcs.NewRoomFunc, funcs.NewRoomWithAIFunc)
+	if err != nil {
+		return err
+	}
+
+	team := t.(glicko2.Team)
+	return matcher.AddBackfill(&glicko2.Backfill{
+		Room:   r.(glicko2.Room),
+		Team:   team,
+		MMR:    team.GetMMR(),
+		Star:   team.GetStar(),
+		Region: r.Base().Region,
+		Slots:  slots,
+	})
+}
+
+// CloseBackfill cancels the backfill request of the team in the room.
+func (m *Matcher) CloseBackfill(r entry.Room, t entry.Team) {
+	matcher := m.GetMatcher(glicko2Entry.QueueKey(r.Base().GameMode, r.Base().ModeVersion))
+	if matcher == nil {
+		return
+	}
+	matcher.RemoveBackfill(t.(glicko2.Team))
+}
@@ -41,5 +41,7 @@ func (m *Matcher) newGoatGameRoom(t glicko2.Team) glicko2.Room {
 }
 
 func (m *Matcher) newGoatGameRoomWithAI(t glicko2.Team) glicko2.Room {
-	return m.newGoatGameRoom(t)
+	r := m.newGoatGameRoom(t)
+	r.(entry.Room).Base().WithAI = true
+	return r
 }
@@ -0,0 +1,98 @@
+package glicko2
+
+import (
+	"time"
+
+	"github.com/hedon954/goapm/apm"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/hedon954/go-matcher/pkg/algorithm/glicko2"
+)
+
+func init() {
+	apm.MetricsReg.MustRegister(queueDepthGauge, tickHistogram, tickRoomsHistogram, carriedOverGauge,
+		timeoutCounter, teamToNormalCounter, matchWaitHistogram, roomMMRSpreadHistogram, roomStarSpreadHistogram)
+}
+
+var (
+	queueDepthGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "match_queue_depth",
+		Help: "The number of the groups waiting in the queue at the start of the last tick",
+	}, []string{"queue_key", "queue", "group_type"})
+
+	tickHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "match_tick_seconds",
+		Help: "The duration of the match ticks",
+	}, []string{"queue_key"})
+
+	tickRoomsHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "match_tick_rooms",
+		Help:    "The number of the rooms formed per tick",
+		Buckets: []float64{0, 1, 2, 5, 10, 20, 50, 100},
+	}, []string{"queue_key"})
+
+	carriedOverGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "match_tick_carried_over_groups",
+		Help: "The number of the groups carried over to the next tick in the last tick",
+	}, []string{"queue_key"})
+
+	timeoutCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "match_timeout_total",
+		Help: "The total number of the groups removed from the queue for timeout",
+	}, []string{"queue_key", "queue"})
+
+	teamToNormalCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "match_team_to_normal_total",
+		Help: "The total number of the groups moved from the team queue to the normal queue",
+	}, []string{"queue_key", "group_type"})
+
+	matchWaitHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "match_wait_seconds",
+		Help:    "The wait time of the groups when they are matched",
+		Buckets: []float64{1, 2, 5, 10, 15, 30, 60, 90, 120, 180, 300},
+	}, []string{"queue_key", "queue"})
+
+	roomMMRSpreadHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "match_room_mmr_spread",
+		Help:    "The difference between the biggest and the smallest team MMR of the rooms formed",
+		Buckets: []float64{0, 10, 25, 50, 100, 200, 400, 800},
+	}, []string{"queue_key"})
+
+	roomStarSpreadHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "match_room_star_spread",
+		Help:    "The difference between the biggest and the smallest team star of the rooms formed",
+		Buckets: []float64{0, 1, 2, 3, 5, 8, 13},
+	}, []string{"queue_key"})
+)
+
+// queueMetrics records the statistics of the matcher of the queue key to prometheus.
+type queueMetrics struct {
+	key string
+}
+
+func (m queueMetrics) SetQueueDepth(queue string, groupType glicko2.GroupType, count int) {
+	queueDepthGauge.WithLabelValues(m.key, queue, groupType.String()).Set(float64(count))
+}
+
+func (m queueMetrics) ObserveTick(duration time.Duration, rooms, carriedOver int) {
+	tickHistogram.WithLabelValues(m.key).Observe(duration.Seconds())
+	tickRoomsHistogram.WithLabelValues(m.key).Observe(float64(rooms))
+	carriedOverGauge.WithLabelValues(m.key).Set(float64(carriedOver))
+}
+
+func (m queueMetrics) IncTimeout(queue string) {
+	timeoutCounter.WithLabelValues(m.key, queue).Inc()
+}
+
+func (m queueMetrics) IncTeamToNormal(groupType glicko2.GroupType) {
+	teamToNormalCounter.WithLabelValues(m.key, groupType.String()).Inc()
+}
+
+func (m queueMetrics) ObserveMatchWait(queue string, waitSec int64) {
+	matchWaitHistogram.WithLabelValues(m.key, queue).Observe(float64(waitSec))
+}
+
+func (m queueMetrics) ObserveRoomSpread(_ string, mmrSpread float64, starSpread int) {
+	roomMMRSpreadHistogram.WithLabelValues(m.key).Observe(mmrSpread)
+	roomStarSpreadHistogram.WithLabelValues(m.key).Observe(float64(starSpread))
+}
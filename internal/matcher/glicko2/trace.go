@@ -0,0 +1,69 @@
+package glicko2
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+)
+
+const tracerName = "go-matcher/matcher/glicko2"
+
+// queueTracer traces the ticks of the matcher of the queue key.
+type queueTracer struct {
+	key string
+}
+
+func (t queueTracer) StartTick(groups int) func(rooms, carriedOver int) {
+	// the matcher ticks even if its queues are empty, they are not traced to avoid flooding the tracing backend
+	if groups == 0 {
+		return func(int, int) {}
+	}
+	_, span := otel.Tracer(tracerName).Start(context.Background(), "glicko2.Tick",
+		trace.WithAttributes(
+			attribute.String("match.queue_key", t.key),
+			attribute.Int("match.groups", groups),
+		),
+	)
+	return func(rooms, carriedOver int) {
+		span.SetAttributes(
+			attribute.Int("match.rooms", rooms),
+			attribute.Int("match.carried_over", carriedOver),
+		)
+		span.End()
+	}
+}
+
+// startMatchedSpan starts a span for the groups matched together.
+// The span is a child of the request starting the match if all the groups come from it,
+// otherwise it starts a new trace linking to the request of each group.
+func startMatchedSpan(name string, groups []entry.Group, attrs ...attribute.KeyValue) trace.Span {
+	links := make([]trace.Link, 0, len(groups))
+	sameSpan := true
+	for _, g := range groups {
+		sc := g.Base().SpanCtx
+		if !sc.IsValid() {
+			continue
+		}
+		if len(links) > 0 && links[0].SpanContext.SpanID() != sc.SpanID() {
+			sameSpan = false
+		}
+		links = append(links, trace.Link{
+			SpanContext: sc,
+			Attributes:  []attribute.KeyValue{attribute.Int64("match.group_id", g.ID())},
+		})
+	}
+
+	ctx := context.Background()
+	opts := []trace.SpanStartOption{trace.WithAttributes(attrs...)}
+	if len(links) > 0 && sameSpan {
+		ctx = trace.ContextWithSpanContext(ctx, links[0].SpanContext)
+	} else {
+		opts = append(opts, trace.WithLinks(links...))
+	}
+	_, span := otel.Tracer(tracerName).Start(ctx, name, opts...)
+	return span
+}
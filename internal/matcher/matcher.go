@@ -1,10 +1,15 @@
 package matcher
 
 import (
+	"context"
 	"fmt"
 	"runtime/debug"
 
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/hedon954/go-matcher/internal/constant"
 	"github.com/hedon954/go-matcher/internal/entry"
@@ -13,6 +18,8 @@ import (
 	glicko2Algo "github.com/hedon954/go-matcher/pkg/algorithm/glicko2"
 )
 
+const tracerName = "go-matcher/matcher"
+
 type Matcher struct {
 	Glicko2Matcher *glicko2.Matcher
 	groupChannel   chan entry.Group
@@ -42,8 +49,17 @@ func (m *Matcher) Stop() {
 }
 
 func (m *Matcher) handle(g entry.Group) {
+	// the group is matched in the background, trace it as a child of the request starting the match
+	ctx := trace.ContextWithSpanContext(context.Background(), g.Base().SpanCtx)
+	_, span := otel.Tracer(tracerName).Start(ctx, "matcher.Enqueue", trace.WithAttributes(
+		attribute.Int64("match.group_id", g.ID()),
+		attribute.Int("match.strategy", int(g.Base().MatchStrategy)),
+	))
+	defer span.End()
+
 	defer func() {
 		if err := recover(); err != nil {
+			span.SetStatus(codes.Error, fmt.Sprint(err))
 			log.Error().
 				Any("err", err).
 				Str("group", g.Json()).
@@ -64,3 +80,20 @@ func (m *Matcher) handle(g entry.Group) {
 			Msg("unknown match strategy")
 	}
 }
+
+// OpenBackfill requests the matcher of the room's match strategy to fill `slots` players into the team.
+func (m *Matcher) OpenBackfill(r entry.Room, t entry.Team, slots int) error {
+	switch r.Base().MatchStrategy {
+	case constant.MatchStrategyGlicko2:
+		return m.Glicko2Matcher.OpenBackfill(r, t, slots)
+	default:
+		return fmt.Errorf("unsupported match strategy: %v", r.Base().MatchStrategy)
+	}
+}
+
+// CloseBackfill cancels the backfill request of the team in the room.
+func (m *Matcher) CloseBackfill(r entry.Room, t entry.Team) {
+	if r.Base().MatchStrategy == constant.MatchStrategyGlicko2 {
+		m.Glicko2Matcher.CloseBackfill(r, t)
+	}
+}
@@ -12,12 +12,36 @@ var (
 	ErrOnlyCaptainCanKickPlayer    = errors.New("only captain can kick player")
 	ErrPlayerNotExists             = errors.New("player not exists")
 	ErrRoomNotExists               = errors.New("room not exists")
+	ErrQueueNotExists              = errors.New("queue not exists")
+	ErrMatchDraining               = errors.New("match draining for maintenance, please try again later")
+	ErrQueueNotDraining            = errors.New("queue not draining")
+	ErrInvalidDrainTimeout         = errors.New("invalid drain timeout")
 	ErrPlayerNotInRoom             = errors.New("player not in room")
+	ErrTeamNotInRoom               = errors.New("team not in room")
+	ErrInvalidBackfillSlots        = errors.New("invalid backfill slots")
+	ErrRoomNotInReadyCheck         = errors.New("room not in ready check")
+	ErrRoomInReadyCheck            = errors.New("room in ready check")
+	ErrSpectateDisabled            = errors.New("spectate disabled")
+	ErrSpectatorFull               = errors.New("spectators already full")
+	ErrNotSpectator                = errors.New("player not spectating the room")
+	ErrPlayerSpectating            = errors.New("player spectating")
+	ErrCustomRoomNotExists         = errors.New("custom room not exists")
+	ErrCustomRoomStarted           = errors.New("custom room already started")
+	ErrCustomRoomFull              = errors.New("custom room already full")
+	ErrCustomRoomNotReady          = errors.New("custom room not ready, some team slot is empty")
+	ErrNotCustomRoomOwner          = errors.New("you not custom room owner")
+	ErrCustomRoomCodeExhausted     = errors.New("no custom room code available, please try again later")
+	ErrWrongPassword               = errors.New("wrong password")
+	ErrInvalidTeamSlot             = errors.New("invalid team slot")
+	ErrTeamSlotFull                = errors.New("team slot already full")
 	ErrKickSelf                    = errors.New("cannot kick self")
 	ErrChangeSelfRole              = errors.New("cannot change self role")
 	ErrNotCaptain                  = errors.New("you not captain")
+	ErrNotAllReady                 = errors.New("not all players ready")
 	ErrPermissionDeny              = errors.New("permission deny")
 	ErrInvitationExpired           = errors.New("invitation expired")
+	ErrInvitationNotExists         = errors.New("invitation not exists")
+	ErrInviteTooFrequent           = errors.New("invite too frequently")
 	ErrGameModeNotMatch            = errors.New("game mode not match")
 	ErrGroupVersionTooLow          = errors.New("group version too low")
 	ErrPlayerVersionTooLow         = errors.New("player version too low")
@@ -29,10 +53,33 @@ var (
 	ErrPlayerInGame     = errors.New("player gaming")
 	ErrPlayerInSettle   = errors.New("player settling")
 
-	ErrGroupInInvite = errors.New("group not matching")
-	ErrGroupInMatch  = errors.New("group matching")
-	ErrGroupInGame   = errors.New("group gaming")
+	ErrGroupInInvite     = errors.New("group not matching")
+	ErrGroupInMatch      = errors.New("group matching")
+	ErrGroupInGame       = errors.New("group gaming")
+	ErrGroupInReadyCheck = errors.New("group waiting for ready check")
+	ErrGroupInCustomRoom = errors.New("group in custom room")
 
 	ErrGroupDenyNearbyJoin = errors.New("group deny nearby join")
 	ErrGroupDenyRecentJoin = errors.New("group deny recent join")
+
+	ErrGroupDenyFriendJoin       = errors.New("group deny friend join")
+	ErrGroupDenyWorldChannelJoin = errors.New("group deny world channel join")
+	ErrGroupDenyClanChannelJoin  = errors.New("group deny clan channel join")
+	ErrNotFriend                 = errors.New("not friend of the group players")
+	ErrNotRoomFriend             = errors.New("not friend of the room players")
+	ErrNotClanMember             = errors.New("not clan member of the group players")
+
+	ErrShareDisabled     = errors.New("group sharing is disabled")
+	ErrInvalidShareToken = errors.New("invalid share token")
+	ErrShareTokenExpired = errors.New("share token expired")
+
+	ErrInvalidMessageType     = errors.New("invalid message type")
+	ErrUnknownPresetMessage   = errors.New("unknown preset message")
+	ErrEmptyMessage           = errors.New("empty message")
+	ErrMessageTooLong         = errors.New("message too long")
+	ErrSendMessageTooFrequent = errors.New("send message too frequently")
+
+	ErrInvalidLocation     = errors.New("invalid location")
+	ErrLocationNotReported = errors.New("location not reported")
+	ErrLocationExpired     = errors.New("location expired")
 )
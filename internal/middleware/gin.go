@@ -1,6 +1,11 @@
 package middleware
 
 import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 	"go.opentelemetry.io/otel/trace"
 
@@ -8,6 +13,12 @@ import (
 	"github.com/hedon954/go-matcher/pkg/response"
 )
 
+var (
+	errAdminDisabled     = errors.New("admin routes are disabled")
+	errAdminUnauthorized = errors.New("missing admin token")
+	errAdminForbidden    = errors.New("invalid admin token")
+)
+
 // WithRequestAndTrace is a middleware that sets the request id and trace id to the context.
 // If the context has a span, it will use the trace id of the span as the trace id.
 // Otherwise, it will generate a random trace id.
@@ -25,3 +36,26 @@ func WithRequestAndTrace() func(c *gin.Context) {
 		c.Set(response.TraceIDKey, traceID)
 	}
 }
+
+// AdminAuth is a middleware that only allows the requests with the bearer token returned by `token`,
+// the token is read for each request so that it can be rotated by the config.
+// All requests are rejected if the token is empty.
+func AdminAuth(token func() string) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		expected := token()
+		if expected == "" {
+			response.GinAbort(c, http.StatusForbidden, errAdminDisabled)
+			return
+		}
+		got, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || got == "" {
+			response.GinAbort(c, http.StatusUnauthorized, errAdminUnauthorized)
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(got), []byte(expected)) != 1 {
+			response.GinAbort(c, http.StatusForbidden, errAdminForbidden)
+			return
+		}
+		c.Next()
+	}
+}
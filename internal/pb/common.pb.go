@@ -24,26 +24,47 @@ const (
 type ReqType int32
 
 const (
-	ReqType_REQ_TYPE_BIND                  ReqType = 0
-	ReqType_REQ_TYPE_CREATE_GROUP          ReqType = 1
-	ReqType_REQ_TYPE_ENTER_GROUP           ReqType = 2
-	ReqType_REQ_TYPE_EXIT_GROUP            ReqType = 3
-	ReqType_REQ_TYPE_DISSOLVE_GROUP        ReqType = 4
-	ReqType_REQ_TYPE_INVITE                ReqType = 5
-	ReqType_REQ_TYPE_ACCEPT_INVITE         ReqType = 6
-	ReqType_REQ_TYPE_REFUSE_INVITE         ReqType = 7
-	ReqType_REQ_TYPE_KICK_PLAYER           ReqType = 8
-	ReqType_REQ_TYPE_CHANGE_ROLE           ReqType = 9
-	ReqType_REQ_TYPE_SET_NEARBY_JOIN_GROUP ReqType = 10
-	ReqType_REQ_TYPE_SET_RECENT_JOIN_GROUP ReqType = 11
-	ReqType_REQ_TYPE_SET_VOICE_STATE       ReqType = 12
-	ReqType_REQ_TYPE_READY                 ReqType = 13
-	ReqType_REQ_TYPE_UNREADY               ReqType = 14
-	ReqType_REQ_TYPE_START_MATCH           ReqType = 15
-	ReqType_REQ_TYPE_CANCEL_MATCH          ReqType = 16
-	ReqType_REQ_TYPE_UPLOAD_PLAYER_ATTR    ReqType = 17
-	ReqType_REQ_TYPE_EXIT_GAME             ReqType = 18
-	ReqType_REQ_TYPE_MATCH_RESPONSE        ReqType = 999
+	ReqType_REQ_TYPE_BIND                         ReqType = 0
+	ReqType_REQ_TYPE_CREATE_GROUP                 ReqType = 1
+	ReqType_REQ_TYPE_ENTER_GROUP                  ReqType = 2
+	ReqType_REQ_TYPE_EXIT_GROUP                   ReqType = 3
+	ReqType_REQ_TYPE_DISSOLVE_GROUP               ReqType = 4
+	ReqType_REQ_TYPE_INVITE                       ReqType = 5
+	ReqType_REQ_TYPE_ACCEPT_INVITE                ReqType = 6
+	ReqType_REQ_TYPE_REFUSE_INVITE                ReqType = 7
+	ReqType_REQ_TYPE_KICK_PLAYER                  ReqType = 8
+	ReqType_REQ_TYPE_CHANGE_ROLE                  ReqType = 9
+	ReqType_REQ_TYPE_SET_NEARBY_JOIN_GROUP        ReqType = 10
+	ReqType_REQ_TYPE_SET_RECENT_JOIN_GROUP        ReqType = 11
+	ReqType_REQ_TYPE_SET_VOICE_STATE              ReqType = 12
+	ReqType_REQ_TYPE_READY                        ReqType = 13
+	ReqType_REQ_TYPE_UNREADY                      ReqType = 14
+	ReqType_REQ_TYPE_START_MATCH                  ReqType = 15
+	ReqType_REQ_TYPE_CANCEL_MATCH                 ReqType = 16
+	ReqType_REQ_TYPE_UPLOAD_PLAYER_ATTR           ReqType = 17
+	ReqType_REQ_TYPE_EXIT_GAME                    ReqType = 18
+	ReqType_REQ_TYPE_OPEN_BACKFILL                ReqType = 19
+	ReqType_REQ_TYPE_ACCEPT_MATCH                 ReqType = 20
+	ReqType_REQ_TYPE_DECLINE_MATCH                ReqType = 21
+	ReqType_REQ_TYPE_SPECTATE                     ReqType = 22
+	ReqType_REQ_TYPE_EXIT_SPECTATE                ReqType = 23
+	ReqType_REQ_TYPE_CREATE_CUSTOM_ROOM           ReqType = 24
+	ReqType_REQ_TYPE_JOIN_CUSTOM_ROOM             ReqType = 25
+	ReqType_REQ_TYPE_LEAVE_CUSTOM_ROOM            ReqType = 26
+	ReqType_REQ_TYPE_MOVE_CUSTOM_ROOM_PLAYER      ReqType = 27
+	ReqType_REQ_TYPE_START_CUSTOM_ROOM            ReqType = 28
+	ReqType_REQ_TYPE_SET_FRIEND_JOIN_GROUP        ReqType = 29
+	ReqType_REQ_TYPE_SET_WORLD_CHANNEL_JOIN_GROUP ReqType = 30
+	ReqType_REQ_TYPE_SET_CLAN_CHANNEL_JOIN_GROUP  ReqType = 31
+	ReqType_REQ_TYPE_SHARE_GROUP                  ReqType = 32
+	ReqType_REQ_TYPE_REPORT_LOCATION              ReqType = 33
+	ReqType_REQ_TYPE_NEARBY_GROUPS                ReqType = 34
+	ReqType_REQ_TYPE_RECENT_GROUPS                ReqType = 35
+	ReqType_REQ_TYPE_REVOKE_INVITE                ReqType = 36
+	ReqType_REQ_TYPE_LIST_RECEIVED_INVITES        ReqType = 37
+	ReqType_REQ_TYPE_LIST_SENT_INVITES            ReqType = 38
+	ReqType_REQ_TYPE_SEND_GROUP_MESSAGE           ReqType = 39
+	ReqType_REQ_TYPE_MATCH_RESPONSE               ReqType = 999
 )
 
 // Enum value maps for ReqType.
@@ -68,29 +89,71 @@ var (
 		16:  "REQ_TYPE_CANCEL_MATCH",
 		17:  "REQ_TYPE_UPLOAD_PLAYER_ATTR",
 		18:  "REQ_TYPE_EXIT_GAME",
+		19:  "REQ_TYPE_OPEN_BACKFILL",
+		20:  "REQ_TYPE_ACCEPT_MATCH",
+		21:  "REQ_TYPE_DECLINE_MATCH",
+		22:  "REQ_TYPE_SPECTATE",
+		23:  "REQ_TYPE_EXIT_SPECTATE",
+		24:  "REQ_TYPE_CREATE_CUSTOM_ROOM",
+		25:  "REQ_TYPE_JOIN_CUSTOM_ROOM",
+		26:  "REQ_TYPE_LEAVE_CUSTOM_ROOM",
+		27:  "REQ_TYPE_MOVE_CUSTOM_ROOM_PLAYER",
+		28:  "REQ_TYPE_START_CUSTOM_ROOM",
+		29:  "REQ_TYPE_SET_FRIEND_JOIN_GROUP",
+		30:  "REQ_TYPE_SET_WORLD_CHANNEL_JOIN_GROUP",
+		31:  "REQ_TYPE_SET_CLAN_CHANNEL_JOIN_GROUP",
+		32:  "REQ_TYPE_SHARE_GROUP",
+		33:  "REQ_TYPE_REPORT_LOCATION",
+		34:  "REQ_TYPE_NEARBY_GROUPS",
+		35:  "REQ_TYPE_RECENT_GROUPS",
+		36:  "REQ_TYPE_REVOKE_INVITE",
+		37:  "REQ_TYPE_LIST_RECEIVED_INVITES",
+		38:  "REQ_TYPE_LIST_SENT_INVITES",
+		39:  "REQ_TYPE_SEND_GROUP_MESSAGE",
 		999: "REQ_TYPE_MATCH_RESPONSE",
 	}
 	ReqType_value = map[string]int32{
-		"REQ_TYPE_BIND":                  0,
-		"REQ_TYPE_CREATE_GROUP":          1,
-		"REQ_TYPE_ENTER_GROUP":           2,
-		"REQ_TYPE_EXIT_GROUP":            3,
-		"REQ_TYPE_DISSOLVE_GROUP":        4,
-		"REQ_TYPE_INVITE":                5,
-		"REQ_TYPE_ACCEPT_INVITE":         6,
-		"REQ_TYPE_REFUSE_INVITE":         7,
-		"REQ_TYPE_KICK_PLAYER":           8,
-		"REQ_TYPE_CHANGE_ROLE":           9,
-		"REQ_TYPE_SET_NEARBY_JOIN_GROUP": 10,
-		"REQ_TYPE_SET_RECENT_JOIN_GROUP": 11,
-		"REQ_TYPE_SET_VOICE_STATE":       12,
-		"REQ_TYPE_READY":                 13,
-		"REQ_TYPE_UNREADY":               14,
-		"REQ_TYPE_START_MATCH":           15,
-		"REQ_TYPE_CANCEL_MATCH":          16,
-		"REQ_TYPE_UPLOAD_PLAYER_ATTR":    17,
-		"REQ_TYPE_EXIT_GAME":             18,
-		"REQ_TYPE_MATCH_RESPONSE":        999,
+		"REQ_TYPE_BIND":                         0,
+		"REQ_TYPE_CREATE_GROUP":                 1,
+		"REQ_TYPE_ENTER_GROUP":                  2,
+		"REQ_TYPE_EXIT_GROUP":                   3,
+		"REQ_TYPE_DISSOLVE_GROUP":               4,
+		"REQ_TYPE_INVITE":                       5,
+		"REQ_TYPE_ACCEPT_INVITE":                6,
+		"REQ_TYPE_REFUSE_INVITE":                7,
+		"REQ_TYPE_KICK_PLAYER":                  8,
+		"REQ_TYPE_CHANGE_ROLE":                  9,
+		"REQ_TYPE_SET_NEARBY_JOIN_GROUP":        10,
+		"REQ_TYPE_SET_RECENT_JOIN_GROUP":        11,
+		"REQ_TYPE_SET_VOICE_STATE":              12,
+		"REQ_TYPE_READY":                        13,
+		"REQ_TYPE_UNREADY":                      14,
+		"REQ_TYPE_START_MATCH":                  15,
+		"REQ_TYPE_CANCEL_MATCH":                 16,
+		"REQ_TYPE_UPLOAD_PLAYER_ATTR":           17,
+		"REQ_TYPE_EXIT_GAME":                    18,
+		"REQ_TYPE_OPEN_BACKFILL":                19,
+		"REQ_TYPE_ACCEPT_MATCH":                 20,
+		"REQ_TYPE_DECLINE_MATCH":                21,
+		"REQ_TYPE_SPECTATE":                     22,
+		"REQ_TYPE_EXIT_SPECTATE":                23,
+		"REQ_TYPE_CREATE_CUSTOM_ROOM":           24,
+		"REQ_TYPE_JOIN_CUSTOM_ROOM":             25,
+		"REQ_TYPE_LEAVE_CUSTOM_ROOM":            26,
+		"REQ_TYPE_MOVE_CUSTOM_ROOM_PLAYER":      27,
+		"REQ_TYPE_START_CUSTOM_ROOM":            28,
+		"REQ_TYPE_SET_FRIEND_JOIN_GROUP":        29,
+		"REQ_TYPE_SET_WORLD_CHANNEL_JOIN_GROUP": 30,
+		"REQ_TYPE_SET_CLAN_CHANNEL_JOIN_GROUP":  31,
+		"REQ_TYPE_SHARE_GROUP":                  32,
+		"REQ_TYPE_REPORT_LOCATION":              33,
+		"REQ_TYPE_NEARBY_GROUPS":                34,
+		"REQ_TYPE_RECENT_GROUPS":                35,
+		"REQ_TYPE_REVOKE_INVITE":                36,
+		"REQ_TYPE_LIST_RECEIVED_INVITES":        37,
+		"REQ_TYPE_LIST_SENT_INVITES":            38,
+		"REQ_TYPE_SEND_GROUP_MESSAGE":           39,
+		"REQ_TYPE_MATCH_RESPONSE":               999,
 	}
 )
 
@@ -188,20 +251,29 @@ func (RspCode) EnumDescriptor() ([]byte, []int) {
 type PushType int32
 
 const (
-	PushType_PUSH_TYPE_UNDEFINED           PushType = 0
-	PushType_PUSH_TYPE_PLAYER_ONLINE_STATE PushType = 1
-	PushType_PUSH_TYPE_GROUP_INFO          PushType = 2
-	PushType_PUSH_TYPE_INVITE_MSG          PushType = 3
-	PushType_PUSH_TYPE_ACCEPT_INVITE       PushType = 4
-	PushType_PUSH_TYPE_REFUSE_INVITE       PushType = 5
-	PushType_PUSH_TYPE_GROUP_DISSOLVE      PushType = 6
-	PushType_PUSH_TYPE_GROUP_STATE         PushType = 7
-	PushType_PUSH_TYPE_PLAYER_VOICE_STATE  PushType = 8
-	PushType_PUSH_TYPE_KICK_MSG            PushType = 9
-	PushType_PUSH_TYPE_MATCH_SUCCESS       PushType = 10
-	PushType_PUSH_TYPE_CANCEL_MATCH        PushType = 11
-	PushType_PUSH_TYPE_READY               PushType = 12
-	PushType_PUSH_TYPE_UNREADY             PushType = 13
+	PushType_PUSH_TYPE_UNDEFINED            PushType = 0
+	PushType_PUSH_TYPE_PLAYER_ONLINE_STATE  PushType = 1
+	PushType_PUSH_TYPE_GROUP_INFO           PushType = 2
+	PushType_PUSH_TYPE_INVITE_MSG           PushType = 3
+	PushType_PUSH_TYPE_ACCEPT_INVITE        PushType = 4
+	PushType_PUSH_TYPE_REFUSE_INVITE        PushType = 5
+	PushType_PUSH_TYPE_GROUP_DISSOLVE       PushType = 6
+	PushType_PUSH_TYPE_GROUP_STATE          PushType = 7
+	PushType_PUSH_TYPE_PLAYER_VOICE_STATE   PushType = 8
+	PushType_PUSH_TYPE_KICK_MSG             PushType = 9
+	PushType_PUSH_TYPE_MATCH_SUCCESS        PushType = 10
+	PushType_PUSH_TYPE_CANCEL_MATCH         PushType = 11
+	PushType_PUSH_TYPE_READY                PushType = 12
+	PushType_PUSH_TYPE_UNREADY              PushType = 13
+	PushType_PUSH_TYPE_READY_CHECK          PushType = 14
+	PushType_PUSH_TYPE_ACCEPT_MATCH         PushType = 15
+	PushType_PUSH_TYPE_DECLINE_MATCH        PushType = 16
+	PushType_PUSH_TYPE_SPECTATE_END         PushType = 17
+	PushType_PUSH_TYPE_CUSTOM_ROOM_INFO     PushType = 18
+	PushType_PUSH_TYPE_CUSTOM_ROOM_DISSOLVE PushType = 19
+	PushType_PUSH_TYPE_INVITE_EXPIRED       PushType = 20
+	PushType_PUSH_TYPE_REVOKE_INVITE        PushType = 21
+	PushType_PUSH_TYPE_GROUP_MESSAGE        PushType = 22
 )
 
 // Enum value maps for PushType.
@@ -221,22 +293,40 @@ var (
 		11: "PUSH_TYPE_CANCEL_MATCH",
 		12: "PUSH_TYPE_READY",
 		13: "PUSH_TYPE_UNREADY",
+		14: "PUSH_TYPE_READY_CHECK",
+		15: "PUSH_TYPE_ACCEPT_MATCH",
+		16: "PUSH_TYPE_DECLINE_MATCH",
+		17: "PUSH_TYPE_SPECTATE_END",
+		18: "PUSH_TYPE_CUSTOM_ROOM_INFO",
+		19: "PUSH_TYPE_CUSTOM_ROOM_DISSOLVE",
+		20: "PUSH_TYPE_INVITE_EXPIRED",
+		21: "PUSH_TYPE_REVOKE_INVITE",
+		22: "PUSH_TYPE_GROUP_MESSAGE",
 	}
 	PushType_value = map[string]int32{
-		"PUSH_TYPE_UNDEFINED":           0,
-		"PUSH_TYPE_PLAYER_ONLINE_STATE": 1,
-		"PUSH_TYPE_GROUP_INFO":          2,
-		"PUSH_TYPE_INVITE_MSG":          3,
-		"PUSH_TYPE_ACCEPT_INVITE":       4,
-		"PUSH_TYPE_REFUSE_INVITE":       5,
-		"PUSH_TYPE_GROUP_DISSOLVE":      6,
-		"PUSH_TYPE_GROUP_STATE":         7,
-		"PUSH_TYPE_PLAYER_VOICE_STATE":  8,
-		"PUSH_TYPE_KICK_MSG":            9,
-		"PUSH_TYPE_MATCH_SUCCESS":       10,
-		"PUSH_TYPE_CANCEL_MATCH":        11,
-		"PUSH_TYPE_READY":               12,
-		"PUSH_TYPE_UNREADY":             13,
+		"PUSH_TYPE_UNDEFINED":            0,
+		"PUSH_TYPE_PLAYER_ONLINE_STATE":  1,
+		"PUSH_TYPE_GROUP_INFO":           2,
+		"PUSH_TYPE_INVITE_MSG":           3,
+		"PUSH_TYPE_ACCEPT_INVITE":        4,
+		"PUSH_TYPE_REFUSE_INVITE":        5,
+		"PUSH_TYPE_GROUP_DISSOLVE":       6,
+		"PUSH_TYPE_GROUP_STATE":          7,
+		"PUSH_TYPE_PLAYER_VOICE_STATE":   8,
+		"PUSH_TYPE_KICK_MSG":             9,
+		"PUSH_TYPE_MATCH_SUCCESS":        10,
+		"PUSH_TYPE_CANCEL_MATCH":         11,
+		"PUSH_TYPE_READY":                12,
+		"PUSH_TYPE_UNREADY":              13,
+		"PUSH_TYPE_READY_CHECK":          14,
+		"PUSH_TYPE_ACCEPT_MATCH":         15,
+		"PUSH_TYPE_DECLINE_MATCH":        16,
+		"PUSH_TYPE_SPECTATE_END":         17,
+		"PUSH_TYPE_CUSTOM_ROOM_INFO":     18,
+		"PUSH_TYPE_CUSTOM_ROOM_DISSOLVE": 19,
+		"PUSH_TYPE_INVITE_EXPIRED":       20,
+		"PUSH_TYPE_REVOKE_INVITE":        21,
+		"PUSH_TYPE_GROUP_MESSAGE":        22,
 	}
 )
 
@@ -417,13 +507,119 @@ func (PlayerVoiceState) EnumDescriptor() ([]byte, []int) {
 	return file_protos_common_proto_rawDescGZIP(), []int{5}
 }
 
+type GroupMessageType int32
+
+const (
+	GroupMessageType_GROUP_MESSAGE_TYPE_UNKNOWN GroupMessageType = 0
+	GroupMessageType_GROUP_MESSAGE_TYPE_TEXT    GroupMessageType = 1
+	GroupMessageType_GROUP_MESSAGE_TYPE_EMOTE   GroupMessageType = 2
+	GroupMessageType_GROUP_MESSAGE_TYPE_QUICK   GroupMessageType = 3
+)
+
+// Enum value maps for GroupMessageType.
+var (
+	GroupMessageType_name = map[int32]string{
+		0: "GROUP_MESSAGE_TYPE_UNKNOWN",
+		1: "GROUP_MESSAGE_TYPE_TEXT",
+		2: "GROUP_MESSAGE_TYPE_EMOTE",
+		3: "GROUP_MESSAGE_TYPE_QUICK",
+	}
+	GroupMessageType_value = map[string]int32{
+		"GROUP_MESSAGE_TYPE_UNKNOWN": 0,
+		"GROUP_MESSAGE_TYPE_TEXT":    1,
+		"GROUP_MESSAGE_TYPE_EMOTE":   2,
+		"GROUP_MESSAGE_TYPE_QUICK":   3,
+	}
+)
+
+func (x GroupMessageType) Enum() *GroupMessageType {
+	p := new(GroupMessageType)
+	*p = x
+	return p
+}
+
+func (x GroupMessageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GroupMessageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_common_proto_enumTypes[6].Descriptor()
+}
+
+func (GroupMessageType) Type() protoreflect.EnumType {
+	return &file_protos_common_proto_enumTypes[6]
+}
+
+func (x GroupMessageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GroupMessageType.Descriptor instead.
+func (GroupMessageType) EnumDescriptor() ([]byte, []int) {
+	return file_protos_common_proto_rawDescGZIP(), []int{6}
+}
+
+type CancelMatchReason int32
+
+const (
+	CancelMatchReason_CANCEL_MATCH_REASON_PLAYER      CancelMatchReason = 0
+	CancelMatchReason_CANCEL_MATCH_REASON_TIMEOUT     CancelMatchReason = 1
+	CancelMatchReason_CANCEL_MATCH_REASON_OPERATOR    CancelMatchReason = 2
+	CancelMatchReason_CANCEL_MATCH_REASON_MAINTENANCE CancelMatchReason = 3
+)
+
+// Enum value maps for CancelMatchReason.
+var (
+	CancelMatchReason_name = map[int32]string{
+		0: "CANCEL_MATCH_REASON_PLAYER",
+		1: "CANCEL_MATCH_REASON_TIMEOUT",
+		2: "CANCEL_MATCH_REASON_OPERATOR",
+		3: "CANCEL_MATCH_REASON_MAINTENANCE",
+	}
+	CancelMatchReason_value = map[string]int32{
+		"CANCEL_MATCH_REASON_PLAYER":      0,
+		"CANCEL_MATCH_REASON_TIMEOUT":     1,
+		"CANCEL_MATCH_REASON_OPERATOR":    2,
+		"CANCEL_MATCH_REASON_MAINTENANCE": 3,
+	}
+)
+
+func (x CancelMatchReason) Enum() *CancelMatchReason {
+	p := new(CancelMatchReason)
+	*p = x
+	return p
+}
+
+func (x CancelMatchReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CancelMatchReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_common_proto_enumTypes[7].Descriptor()
+}
+
+func (CancelMatchReason) Type() protoreflect.EnumType {
+	return &file_protos_common_proto_enumTypes[7]
+}
+
+func (x CancelMatchReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CancelMatchReason.Descriptor instead.
+func (CancelMatchReason) EnumDescriptor() ([]byte, []int) {
+	return file_protos_common_proto_rawDescGZIP(), []int{7}
+}
+
 type GroupState int32
 
 const (
-	GroupState_GROUP_STATE_INVITE    GroupState = 0
-	GroupState_GROUP_STATE_MATCH     GroupState = 1
-	GroupState_GROUP_STATE_GAME      GroupState = 2
-	GroupState_GROUP_STATE_DISSOLVED GroupState = 3
+	GroupState_GROUP_STATE_INVITE      GroupState = 0
+	GroupState_GROUP_STATE_MATCH       GroupState = 1
+	GroupState_GROUP_STATE_GAME        GroupState = 2
+	GroupState_GROUP_STATE_DISSOLVED   GroupState = 3
+	GroupState_GROUP_STATE_READY_CHECK GroupState = 4
+	GroupState_GROUP_STATE_CUSTOM_ROOM GroupState = 5
 )
 
 // Enum value maps for GroupState.
@@ -433,12 +629,16 @@ var (
 		1: "GROUP_STATE_MATCH",
 		2: "GROUP_STATE_GAME",
 		3: "GROUP_STATE_DISSOLVED",
+		4: "GROUP_STATE_READY_CHECK",
+		5: "GROUP_STATE_CUSTOM_ROOM",
 	}
 	GroupState_value = map[string]int32{
-		"GROUP_STATE_INVITE":    0,
-		"GROUP_STATE_MATCH":     1,
-		"GROUP_STATE_GAME":      2,
-		"GROUP_STATE_DISSOLVED": 3,
+		"GROUP_STATE_INVITE":      0,
+		"GROUP_STATE_MATCH":       1,
+		"GROUP_STATE_GAME":        2,
+		"GROUP_STATE_DISSOLVED":   3,
+		"GROUP_STATE_READY_CHECK": 4,
+		"GROUP_STATE_CUSTOM_ROOM": 5,
 	}
 )
 
@@ -453,11 +653,11 @@ func (x GroupState) String() string {
 }
 
 func (GroupState) Descriptor() protoreflect.EnumDescriptor {
-	return file_protos_common_proto_enumTypes[6].Descriptor()
+	return file_protos_common_proto_enumTypes[8].Descriptor()
 }
 
 func (GroupState) Type() protoreflect.EnumType {
-	return &file_protos_common_proto_enumTypes[6]
+	return &file_protos_common_proto_enumTypes[8]
 }
 
 func (x GroupState) Number() protoreflect.EnumNumber {
@@ -466,7 +666,7 @@ func (x GroupState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GroupState.Descriptor instead.
 func (GroupState) EnumDescriptor() ([]byte, []int) {
-	return file_protos_common_proto_rawDescGZIP(), []int{6}
+	return file_protos_common_proto_rawDescGZIP(), []int{8}
 }
 
 type NetProtocol int32
@@ -514,11 +714,11 @@ func (x NetProtocol) String() string {
 }
 
 func (NetProtocol) Descriptor() protoreflect.EnumDescriptor {
-	return file_protos_common_proto_enumTypes[7].Descriptor()
+	return file_protos_common_proto_enumTypes[9].Descriptor()
 }
 
 func (NetProtocol) Type() protoreflect.EnumType {
-	return &file_protos_common_proto_enumTypes[7]
+	return &file_protos_common_proto_enumTypes[9]
 }
 
 func (x NetProtocol) Number() protoreflect.EnumNumber {
@@ -527,7 +727,7 @@ func (x NetProtocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NetProtocol.Descriptor instead.
 func (NetProtocol) EnumDescriptor() ([]byte, []int) {
-	return file_protos_common_proto_rawDescGZIP(), []int{7}
+	return file_protos_common_proto_rawDescGZIP(), []int{9}
 }
 
 // CommonRsp is a general-purpose response structure used in request-response scenarios.
@@ -722,7 +922,7 @@ var file_protos_common_proto_rawDesc = []byte{
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x73, 0x68,
 	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x70, 0x75, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
 	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x2a, 0xa4, 0x04, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11,
+	0x74, 0x61, 0x2a, 0xba, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11,
 	0x0a, 0x0d, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x49, 0x4e, 0x44, 0x10,
 	0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52,
 	0x45, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
@@ -754,89 +954,170 @@ var file_protos_common_proto_rawDesc = []byte{
 	0x10, 0x10, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
 	0x50, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x41, 0x54, 0x54,
 	0x52, 0x10, 0x11, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x45, 0x58, 0x49, 0x54, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x12, 0x12, 0x1c, 0x0a, 0x17, 0x52,
-	0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45,
-	0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0xe7, 0x07, 0x2a, 0xd5, 0x01, 0x0a, 0x07, 0x52, 0x73,
-	0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44,
-	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x10, 0x52,
-	0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10,
-	0xc8, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x42,
-	0x41, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x90, 0x03, 0x12, 0x1a, 0x0a,
-	0x15, 0x52, 0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48,
-	0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x91, 0x03, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x53, 0x50,
-	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10,
-	0x93, 0x03, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e,
-	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x94, 0x03, 0x12, 0x1a, 0x0a, 0x15, 0x52,
-	0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0xf4, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x53, 0x50, 0x5f, 0x43,
-	0x4f, 0x44, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xa0,
-	0x1f, 0x2a, 0x8c, 0x03, 0x0a, 0x08, 0x50, 0x75, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17,
-	0x0a, 0x13, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45,
-	0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x55, 0x53, 0x48, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49,
-	0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x55,
-	0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x49, 0x4e,
-	0x46, 0x4f, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x4d, 0x53, 0x47, 0x10, 0x03, 0x12, 0x1b,
-	0x0a, 0x17, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45,
-	0x50, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x50,
-	0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x53, 0x45, 0x5f,
-	0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x55, 0x53, 0x48,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x44, 0x49, 0x53, 0x53,
-	0x4f, 0x4c, 0x56, 0x45, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10,
-	0x07, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
-	0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x4b, 0x49, 0x43, 0x4b, 0x5f, 0x4d, 0x53, 0x47, 0x10, 0x09, 0x12, 0x1b, 0x0a, 0x17, 0x50,
-	0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53,
-	0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x0a, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x55, 0x53, 0x48,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x4d, 0x41, 0x54,
-	0x43, 0x48, 0x10, 0x0b, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x0c, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x55, 0x53,
-	0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x0d,
-	0x2a, 0xdc, 0x01, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52,
-	0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x46,
-	0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x4c, 0x41, 0x59, 0x45,
-	0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f,
-	0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4c, 0x41, 0x59, 0x45,
-	0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49,
-	0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4c, 0x41,
+	0x45, 0x58, 0x49, 0x54, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x12, 0x12, 0x1a, 0x0a, 0x16, 0x52,
+	0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x42, 0x41, 0x43,
+	0x4b, 0x46, 0x49, 0x4c, 0x4c, 0x10, 0x13, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x51, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x10, 0x14, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
+	0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x15, 0x12, 0x15,
+	0x0a, 0x11, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x54,
+	0x41, 0x54, 0x45, 0x10, 0x16, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x41, 0x54, 0x45, 0x10,
+	0x17, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4f, 0x4d,
+	0x10, 0x18, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a,
+	0x4f, 0x49, 0x4e, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x10,
+	0x19, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45,
+	0x41, 0x56, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x10,
+	0x1a, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f,
+	0x56, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x50,
+	0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x1b, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x51, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x10, 0x1c, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x51, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44, 0x5f, 0x4a,
+	0x4f, 0x49, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x1d, 0x12, 0x29, 0x0a, 0x25, 0x52,
+	0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x57, 0x4f, 0x52, 0x4c,
+	0x44, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x10, 0x1e, 0x12, 0x28, 0x0a, 0x24, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x41, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x4e, 0x45, 0x4c, 0x5f, 0x4a, 0x4f, 0x49, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x1f,
+	0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x48, 0x41,
+	0x52, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x20, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45,
+	0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x4f,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x21, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x51, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x45, 0x41, 0x52, 0x42, 0x59, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x53, 0x10, 0x22, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x52, 0x45, 0x43, 0x45, 0x4e, 0x54, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x53, 0x10, 0x23,
+	0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x56,
+	0x4f, 0x4b, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x10, 0x24, 0x12, 0x22, 0x0a, 0x1e,
+	0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45,
+	0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x53, 0x10, 0x25,
+	0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x49, 0x53,
+	0x54, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x53, 0x10, 0x26,
+	0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x4e,
+	0x44, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10,
+	0x27, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x10, 0xe7, 0x07, 0x2a,
+	0xd5, 0x01, 0x0a, 0x07, 0x52, 0x73, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x52,
+	0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x55,
+	0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0xc8, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x53, 0x50, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x10, 0x90, 0x03, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x91, 0x03, 0x12,
+	0x17, 0x0a, 0x12, 0x52, 0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x42,
+	0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x93, 0x03, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x53, 0x50, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x94,
+	0x03, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45,
+	0x52, 0x56, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xf4, 0x03, 0x12, 0x18, 0x0a,
+	0x13, 0x52, 0x53, 0x50, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0xa0, 0x1f, 0x2a, 0x98, 0x05, 0x0a, 0x08, 0x50, 0x75, 0x73, 0x68,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a,
+	0x1d, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45,
+	0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x01,
+	0x12, 0x18, 0x0a, 0x14, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x55,
+	0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x4d,
+	0x53, 0x47, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x10,
+	0x04, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x46, 0x55, 0x53, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x10, 0x05, 0x12, 0x1c,
+	0x0a, 0x18, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x44, 0x49, 0x53, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15,
+	0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x55, 0x53, 0x48, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x56, 0x4f, 0x49, 0x43,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x55, 0x53,
+	0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x49, 0x43, 0x4b, 0x5f, 0x4d, 0x53, 0x47, 0x10,
+	0x09, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x0a, 0x12, 0x1a,
+	0x0a, 0x16, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0b, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x55,
+	0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x0c, 0x12,
+	0x15, 0x0a, 0x11, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x10, 0x0d, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x10,
+	0x0e, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x50, 0x54, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x0f, 0x12, 0x1b, 0x0a,
+	0x17, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49,
+	0x4e, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x10, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x55,
+	0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x43, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x45, 0x4e, 0x44, 0x10, 0x11, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f,
+	0x49, 0x4e, 0x46, 0x4f, 0x10, 0x12, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x5f,
+	0x44, 0x49, 0x53, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x10, 0x13, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x55,
+	0x53, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x14, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x55, 0x53, 0x48,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x5f, 0x49, 0x4e, 0x56,
+	0x49, 0x54, 0x45, 0x10, 0x15, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x10, 0x16, 0x2a, 0xdc, 0x01, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x4c, 0x41, 0x59,
+	0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x4c, 0x41,
+	0x59, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4c, 0x41,
 	0x59, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x50,
+	0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x50,
 	0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x04, 0x12, 0x21, 0x0a, 0x1d,
-	0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x10, 0x05, 0x2a,
-	0x38, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x47,
-	0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12,
-	0x18, 0x0a, 0x13, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x4f, 0x41,
-	0x54, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x89, 0x07, 0x2a, 0x4e, 0x0a, 0x10, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a,
-	0x17, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x4d, 0x55, 0x54, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x4c,
-	0x41, 0x59, 0x45, 0x52, 0x5f, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x55, 0x4e, 0x4d, 0x55, 0x54, 0x45, 0x10, 0x01, 0x2a, 0x6c, 0x0a, 0x0a, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x52, 0x4f, 0x55, 0x50,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x10, 0x00, 0x12,
-	0x15, 0x0a, 0x11, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4d,
-	0x41, 0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
-	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x53,
-	0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xa9, 0x01, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x45, 0x54, 0x5f, 0x50,
-	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x14, 0x0a,
-	0x10, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x44,
-	0x50, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f,
-	0x43, 0x4f, 0x4c, 0x5f, 0x57, 0x53, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x45, 0x54, 0x5f,
-	0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x57, 0x53, 0x53, 0x10, 0x03, 0x12, 0x14,
-	0x0a, 0x10, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4b,
-	0x43, 0x50, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54,
-	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x47, 0x52, 0x50, 0x43, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x4e,
-	0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x47, 0x52, 0x50, 0x43,
-	0x53, 0x10, 0x06, 0x42, 0x0d, 0x5a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x12, 0x1f, 0x0a,
+	0x1b, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x04, 0x12, 0x21,
+	0x0a, 0x1d, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x10,
+	0x05, 0x2a, 0x38, 0x0a, 0x08, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47,
+	0x4f, 0x41, 0x54, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x89, 0x07, 0x2a, 0x4e, 0x0a, 0x10, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x0a, 0x17, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x55, 0x54, 0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19,
+	0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4d, 0x55, 0x54, 0x45, 0x10, 0x01, 0x2a, 0x8b, 0x01, 0x0a, 0x10,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x1b, 0x0a, 0x17, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x51, 0x55, 0x49, 0x43, 0x4b, 0x10, 0x03, 0x2a, 0x9b, 0x01, 0x0a, 0x11, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1e, 0x0a, 0x1a, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x10, 0x00, 0x12,
+	0x1f, 0x0a, 0x1b, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x01,
+	0x12, 0x20, 0x0a, 0x1c, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x5f, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45,
+	0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x2a, 0xa6, 0x01, 0x0a, 0x0a, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x10, 0x00, 0x12, 0x15,
+	0x0a, 0x11, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41,
+	0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x53, 0x4f,
+	0x4c, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x10, 0x05,
+	0x2a, 0xa9, 0x01, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x14, 0x0a, 0x10, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c,
+	0x5f, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x52,
+	0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x44, 0x50, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x4e, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x57, 0x53, 0x10,
+	0x02, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f,
+	0x4c, 0x5f, 0x57, 0x53, 0x53, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4e, 0x45, 0x54, 0x5f, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x4b, 0x43, 0x50, 0x10, 0x04, 0x12, 0x15, 0x0a,
+	0x11, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x47, 0x52,
+	0x50, 0x43, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x45, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54,
+	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x47, 0x52, 0x50, 0x43, 0x53, 0x10, 0x06, 0x42, 0x0d, 0x5a, 0x0b,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -851,7 +1132,7 @@ func file_protos_common_proto_rawDescGZIP() []byte {
 	return file_protos_common_proto_rawDescData
 }
 
-var file_protos_common_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_protos_common_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
 var file_protos_common_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_protos_common_proto_goTypes = []interface{}{
 	(ReqType)(0),           // 0: pb.ReqType
@@ -860,10 +1141,12 @@ var file_protos_common_proto_goTypes = []interface{}{
 	(PlayerOnlineState)(0), // 3: pb.PlayerOnlineState
 	(GameMode)(0),          // 4: pb.GameMode
 	(PlayerVoiceState)(0),  // 5: pb.PlayerVoiceState
-	(GroupState)(0),        // 6: pb.GroupState
-	(NetProtocol)(0),       // 7: pb.NetProtocol
-	(*CommonRsp)(nil),      // 8: pb.CommonRsp
-	(*PushMsg)(nil),        // 9: pb.PushMsg
+	(GroupMessageType)(0),  // 6: pb.GroupMessageType
+	(CancelMatchReason)(0), // 7: pb.CancelMatchReason
+	(GroupState)(0),        // 8: pb.GroupState
+	(NetProtocol)(0),       // 9: pb.NetProtocol
+	(*CommonRsp)(nil),      // 10: pb.CommonRsp
+	(*PushMsg)(nil),        // 11: pb.PushMsg
 }
 var file_protos_common_proto_depIdxs = []int32{
 	1, // 0: pb.CommonRsp.code:type_name -> pb.RspCode
@@ -912,7 +1195,7 @@ func file_protos_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_common_proto_rawDesc,
-			NumEnums:      8,
+			NumEnums:      10,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -132,12 +132,13 @@ type PlayerInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid         string       `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	GameMode    GameMode     `protobuf:"varint,2,opt,name=game_mode,json=gameMode,proto3,enum=pb.GameMode" json:"game_mode,omitempty"`
-	ModeVersion int64        `protobuf:"varint,3,opt,name=mode_version,json=modeVersion,proto3" json:"mode_version,omitempty"`
-	Star        int64        `protobuf:"varint,4,opt,name=star,proto3" json:"star,omitempty"`
-	Rank        int64        `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
-	Glicko2Info *Glicko2Info `protobuf:"bytes,6,opt,name=glicko2_info,json=glicko2Info,proto3" json:"glicko2_info,omitempty"`
+	Uid         string           `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	GameMode    GameMode         `protobuf:"varint,2,opt,name=game_mode,json=gameMode,proto3,enum=pb.GameMode" json:"game_mode,omitempty"`
+	ModeVersion int64            `protobuf:"varint,3,opt,name=mode_version,json=modeVersion,proto3" json:"mode_version,omitempty"`
+	Star        int64            `protobuf:"varint,4,opt,name=star,proto3" json:"star,omitempty"`
+	Rank        int64            `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	Glicko2Info *Glicko2Info     `protobuf:"bytes,6,opt,name=glicko2_info,json=glicko2Info,proto3" json:"glicko2_info,omitempty"`
+	RegionPings map[string]int64 `protobuf:"bytes,7,rep,name=region_pings,json=regionPings,proto3" json:"region_pings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *PlayerInfo) Reset() {
@@ -214,6 +215,13 @@ func (x *PlayerInfo) GetGlicko2Info() *Glicko2Info {
 	return nil
 }
 
+func (x *PlayerInfo) GetRegionPings() map[string]int64 {
+	if x != nil {
+		return x.RegionPings
+	}
+	return nil
+}
+
 // --->[START] Bind
 type BindReq struct {
 	state         protoimpl.MessageState
@@ -536,13 +544,14 @@ type MatchInfo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoomId          int64            `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
-	GameMode        GameMode         `protobuf:"varint,2,opt,name=game_mode,json=gameMode,proto3,enum=pb.GameMode" json:"game_mode,omitempty"`
-	ModeVersion     int64            `protobuf:"varint,3,opt,name=mode_version,json=modeVersion,proto3" json:"mode_version,omitempty"`
-	MatchStrategy   int32            `protobuf:"varint,4,opt,name=match_strategy,json=matchStrategy,proto3" json:"match_strategy,omitempty"`
-	MatchedTimeUnix int64            `protobuf:"varint,5,opt,name=matched_time_unix,json=matchedTimeUnix,proto3" json:"matched_time_unix,omitempty"`
-	Teams           []*MatchTeamInfo `protobuf:"bytes,6,rep,name=teams,proto3" json:"teams,omitempty"`
-	GameServerInfo  *GameServerInfo  `protobuf:"bytes,7,opt,name=game_server_info,json=gameServerInfo,proto3" json:"game_server_info,omitempty"`
+	RoomId          int64                 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	GameMode        GameMode              `protobuf:"varint,2,opt,name=game_mode,json=gameMode,proto3,enum=pb.GameMode" json:"game_mode,omitempty"`
+	ModeVersion     int64                 `protobuf:"varint,3,opt,name=mode_version,json=modeVersion,proto3" json:"mode_version,omitempty"`
+	MatchStrategy   int32                 `protobuf:"varint,4,opt,name=match_strategy,json=matchStrategy,proto3" json:"match_strategy,omitempty"`
+	MatchedTimeUnix int64                 `protobuf:"varint,5,opt,name=matched_time_unix,json=matchedTimeUnix,proto3" json:"matched_time_unix,omitempty"`
+	Teams           []*MatchTeamInfo      `protobuf:"bytes,6,rep,name=teams,proto3" json:"teams,omitempty"`
+	GameServerInfo  *GameServerInfo       `protobuf:"bytes,7,opt,name=game_server_info,json=gameServerInfo,proto3" json:"game_server_info,omitempty"`
+	Spectators      []*MatchSpectatorInfo `protobuf:"bytes,8,rep,name=spectators,proto3" json:"spectators,omitempty"`
 }
 
 func (x *MatchInfo) Reset() {
@@ -626,6 +635,13 @@ func (x *MatchInfo) GetGameServerInfo() *GameServerInfo {
 	return nil
 }
 
+func (x *MatchInfo) GetSpectators() []*MatchSpectatorInfo {
+	if x != nil {
+		return x.Spectators
+	}
+	return nil
+}
+
 type MatchTeamInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -681,18 +697,22 @@ func (x *MatchTeamInfo) GetPlayers() []*MatchPlayerInfo {
 	return nil
 }
 
-type MatchPlayerInfo struct {
+type CustomRoomInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid     string         `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	GroupId int64          `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	Attr    *UserAttribute `protobuf:"bytes,3,opt,name=attr,proto3" json:"attr,omitempty"`
+	RoomId          int64             `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Code            string            `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Owner           string            `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	GameMode        GameMode          `protobuf:"varint,4,opt,name=game_mode,json=gameMode,proto3,enum=pb.GameMode" json:"game_mode,omitempty"`
+	ModeVersion     int64             `protobuf:"varint,5,opt,name=mode_version,json=modeVersion,proto3" json:"mode_version,omitempty"`
+	TeamPlayerLimit int32             `protobuf:"varint,6,opt,name=team_player_limit,json=teamPlayerLimit,proto3" json:"team_player_limit,omitempty"`
+	Teams           []*CustomRoomTeam `protobuf:"bytes,7,rep,name=teams,proto3" json:"teams,omitempty"`
 }
 
-func (x *MatchPlayerInfo) Reset() {
-	*x = MatchPlayerInfo{}
+func (x *CustomRoomInfo) Reset() {
+	*x = CustomRoomInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_match_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,13 +720,13 @@ func (x *MatchPlayerInfo) Reset() {
 	}
 }
 
-func (x *MatchPlayerInfo) String() string {
+func (x *CustomRoomInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchPlayerInfo) ProtoMessage() {}
+func (*CustomRoomInfo) ProtoMessage() {}
 
-func (x *MatchPlayerInfo) ProtoReflect() protoreflect.Message {
+func (x *CustomRoomInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_match_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -718,44 +738,71 @@ func (x *MatchPlayerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchPlayerInfo.ProtoReflect.Descriptor instead.
-func (*MatchPlayerInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomRoomInfo.ProtoReflect.Descriptor instead.
+func (*CustomRoomInfo) Descriptor() ([]byte, []int) {
 	return file_protos_match_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MatchPlayerInfo) GetUid() string {
+func (x *CustomRoomInfo) GetRoomId() int64 {
 	if x != nil {
-		return x.Uid
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *CustomRoomInfo) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-func (x *MatchPlayerInfo) GetGroupId() int64 {
+func (x *CustomRoomInfo) GetOwner() string {
 	if x != nil {
-		return x.GroupId
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *CustomRoomInfo) GetGameMode() GameMode {
+	if x != nil {
+		return x.GameMode
+	}
+	return GameMode_GAME_MODE_TEST
+}
+
+func (x *CustomRoomInfo) GetModeVersion() int64 {
+	if x != nil {
+		return x.ModeVersion
 	}
 	return 0
 }
 
-func (x *MatchPlayerInfo) GetAttr() *UserAttribute {
+func (x *CustomRoomInfo) GetTeamPlayerLimit() int32 {
 	if x != nil {
-		return x.Attr
+		return x.TeamPlayerLimit
+	}
+	return 0
+}
+
+func (x *CustomRoomInfo) GetTeams() []*CustomRoomTeam {
+	if x != nil {
+		return x.Teams
 	}
 	return nil
 }
 
-type GameServerInfo struct {
+type CustomRoomTeam struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Host     string      `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Port     int32       `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Protocol NetProtocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=pb.NetProtocol" json:"protocol,omitempty"`
+	Slot int32    `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Uids []string `protobuf:"bytes,2,rep,name=uids,proto3" json:"uids,omitempty"`
 }
 
-func (x *GameServerInfo) Reset() {
-	*x = GameServerInfo{}
+func (x *CustomRoomTeam) Reset() {
+	*x = CustomRoomTeam{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_match_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -763,13 +810,13 @@ func (x *GameServerInfo) Reset() {
 	}
 }
 
-func (x *GameServerInfo) String() string {
+func (x *CustomRoomTeam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameServerInfo) ProtoMessage() {}
+func (*CustomRoomTeam) ProtoMessage() {}
 
-func (x *GameServerInfo) ProtoReflect() protoreflect.Message {
+func (x *CustomRoomTeam) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_match_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -781,44 +828,41 @@ func (x *GameServerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameServerInfo.ProtoReflect.Descriptor instead.
-func (*GameServerInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomRoomTeam.ProtoReflect.Descriptor instead.
+func (*CustomRoomTeam) Descriptor() ([]byte, []int) {
 	return file_protos_match_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GameServerInfo) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
-}
-
-func (x *GameServerInfo) GetPort() int32 {
+func (x *CustomRoomTeam) GetSlot() int32 {
 	if x != nil {
-		return x.Port
+		return x.Slot
 	}
 	return 0
 }
 
-func (x *GameServerInfo) GetProtocol() NetProtocol {
+func (x *CustomRoomTeam) GetUids() []string {
 	if x != nil {
-		return x.Protocol
+		return x.Uids
 	}
-	return NetProtocol_NET_PROTOCOL_TCP
+	return nil
 }
 
-type UserAttribute struct {
+type PendingInvite struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Avatar   string `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Star     int64  `protobuf:"varint,3,opt,name=star,proto3" json:"star,omitempty"`
+	GroupId     int64    `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	InviterUid  string   `protobuf:"bytes,2,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
+	InviteeUid  string   `protobuf:"bytes,3,opt,name=invitee_uid,json=inviteeUid,proto3" json:"invitee_uid,omitempty"`
+	GameMode    GameMode `protobuf:"varint,4,opt,name=game_mode,json=gameMode,proto3,enum=pb.GameMode" json:"game_mode,omitempty"`
+	ModeVersion int64    `protobuf:"varint,5,opt,name=mode_version,json=modeVersion,proto3" json:"mode_version,omitempty"`
+	CreateSec   int64    `protobuf:"varint,6,opt,name=create_sec,json=createSec,proto3" json:"create_sec,omitempty"`
+	ExpireSec   int64    `protobuf:"varint,7,opt,name=expire_sec,json=expireSec,proto3" json:"expire_sec,omitempty"`
 }
 
-func (x *UserAttribute) Reset() {
-	*x = UserAttribute{}
+func (x *PendingInvite) Reset() {
+	*x = PendingInvite{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_match_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -826,13 +870,13 @@ func (x *UserAttribute) Reset() {
 	}
 }
 
-func (x *UserAttribute) String() string {
+func (x *PendingInvite) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAttribute) ProtoMessage() {}
+func (*PendingInvite) ProtoMessage() {}
 
-func (x *UserAttribute) ProtoReflect() protoreflect.Message {
+func (x *PendingInvite) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_match_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -844,43 +888,79 @@ func (x *UserAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAttribute.ProtoReflect.Descriptor instead.
-func (*UserAttribute) Descriptor() ([]byte, []int) {
+// Deprecated: Use PendingInvite.ProtoReflect.Descriptor instead.
+func (*PendingInvite) Descriptor() ([]byte, []int) {
 	return file_protos_match_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UserAttribute) GetNickname() string {
+func (x *PendingInvite) GetGroupId() int64 {
 	if x != nil {
-		return x.Nickname
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *PendingInvite) GetInviterUid() string {
+	if x != nil {
+		return x.InviterUid
 	}
 	return ""
 }
 
-func (x *UserAttribute) GetAvatar() string {
+func (x *PendingInvite) GetInviteeUid() string {
 	if x != nil {
-		return x.Avatar
+		return x.InviteeUid
 	}
 	return ""
 }
 
-func (x *UserAttribute) GetStar() int64 {
+func (x *PendingInvite) GetGameMode() GameMode {
 	if x != nil {
-		return x.Star
+		return x.GameMode
+	}
+	return GameMode_GAME_MODE_TEST
+}
+
+func (x *PendingInvite) GetModeVersion() int64 {
+	if x != nil {
+		return x.ModeVersion
 	}
 	return 0
 }
 
-// --->[START] CreateGroup
-type CreateGroupReq struct {
+func (x *PendingInvite) GetCreateSec() int64 {
+	if x != nil {
+		return x.CreateSec
+	}
+	return 0
+}
+
+func (x *PendingInvite) GetExpireSec() int64 {
+	if x != nil {
+		return x.ExpireSec
+	}
+	return 0
+}
+
+type JoinableGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerInfo *PlayerInfo `protobuf:"bytes,1,opt,name=player_info,json=playerInfo,proto3" json:"player_info,omitempty"`
+	GroupId     int64    `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Captain     string   `protobuf:"bytes,2,opt,name=captain,proto3" json:"captain,omitempty"`
+	GameMode    GameMode `protobuf:"varint,3,opt,name=game_mode,json=gameMode,proto3,enum=pb.GameMode" json:"game_mode,omitempty"`
+	ModeVersion int64    `protobuf:"varint,4,opt,name=mode_version,json=modeVersion,proto3" json:"mode_version,omitempty"`
+	PlayerCount int32    `protobuf:"varint,5,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	PlayerLimit int32    `protobuf:"varint,6,opt,name=player_limit,json=playerLimit,proto3" json:"player_limit,omitempty"`
+	Uid         string   `protobuf:"bytes,7,opt,name=uid,proto3" json:"uid,omitempty"`
+	DistanceKm  float64  `protobuf:"fixed64,8,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	Relation    int32    `protobuf:"varint,9,opt,name=relation,proto3" json:"relation,omitempty"`
+	PlayedAtSec int64    `protobuf:"varint,10,opt,name=played_at_sec,json=playedAtSec,proto3" json:"played_at_sec,omitempty"`
 }
 
-func (x *CreateGroupReq) Reset() {
-	*x = CreateGroupReq{}
+func (x *JoinableGroup) Reset() {
+	*x = JoinableGroup{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_match_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -888,13 +968,13 @@ func (x *CreateGroupReq) Reset() {
 	}
 }
 
-func (x *CreateGroupReq) String() string {
+func (x *JoinableGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupReq) ProtoMessage() {}
+func (*JoinableGroup) ProtoMessage() {}
 
-func (x *CreateGroupReq) ProtoReflect() protoreflect.Message {
+func (x *JoinableGroup) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_match_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -906,106 +986,106 @@ func (x *CreateGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupReq.ProtoReflect.Descriptor instead.
-func (*CreateGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinableGroup.ProtoReflect.Descriptor instead.
+func (*JoinableGroup) Descriptor() ([]byte, []int) {
 	return file_protos_match_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateGroupReq) GetPlayerInfo() *PlayerInfo {
+func (x *JoinableGroup) GetGroupId() int64 {
 	if x != nil {
-		return x.PlayerInfo
+		return x.GroupId
 	}
-	return nil
+	return 0
 }
 
-type Glicko2Info struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Mmr  float64 `protobuf:"fixed64,1,opt,name=mmr,proto3" json:"mmr,omitempty"`
-	Star int64   `protobuf:"varint,2,opt,name=star,proto3" json:"star,omitempty"`
-	Rank int64   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+func (x *JoinableGroup) GetCaptain() string {
+	if x != nil {
+		return x.Captain
+	}
+	return ""
 }
 
-func (x *Glicko2Info) Reset() {
-	*x = Glicko2Info{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *JoinableGroup) GetGameMode() GameMode {
+	if x != nil {
+		return x.GameMode
 	}
+	return GameMode_GAME_MODE_TEST
 }
 
-func (x *Glicko2Info) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *JoinableGroup) GetModeVersion() int64 {
+	if x != nil {
+		return x.ModeVersion
+	}
+	return 0
 }
 
-func (*Glicko2Info) ProtoMessage() {}
+func (x *JoinableGroup) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
 
-func (x *Glicko2Info) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *JoinableGroup) GetPlayerLimit() int32 {
+	if x != nil {
+		return x.PlayerLimit
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Glicko2Info.ProtoReflect.Descriptor instead.
-func (*Glicko2Info) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{11}
+func (x *JoinableGroup) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
 }
 
-func (x *Glicko2Info) GetMmr() float64 {
+func (x *JoinableGroup) GetDistanceKm() float64 {
 	if x != nil {
-		return x.Mmr
+		return x.DistanceKm
 	}
 	return 0
 }
 
-func (x *Glicko2Info) GetStar() int64 {
+func (x *JoinableGroup) GetRelation() int32 {
 	if x != nil {
-		return x.Star
+		return x.Relation
 	}
 	return 0
 }
 
-func (x *Glicko2Info) GetRank() int64 {
+func (x *JoinableGroup) GetPlayedAtSec() int64 {
 	if x != nil {
-		return x.Rank
+		return x.PlayedAtSec
 	}
 	return 0
 }
 
-type CreateGroupRsp struct {
+type MatchSpectatorInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupId int64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 }
 
-func (x *CreateGroupRsp) Reset() {
-	*x = CreateGroupRsp{}
+func (x *MatchSpectatorInfo) Reset() {
+	*x = MatchSpectatorInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[12]
+		mi := &file_protos_match_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateGroupRsp) String() string {
+func (x *MatchSpectatorInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRsp) ProtoMessage() {}
+func (*MatchSpectatorInfo) ProtoMessage() {}
 
-func (x *CreateGroupRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[12]
+func (x *MatchSpectatorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,46 +1096,45 @@ func (x *CreateGroupRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRsp.ProtoReflect.Descriptor instead.
-func (*CreateGroupRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MatchSpectatorInfo.ProtoReflect.Descriptor instead.
+func (*MatchSpectatorInfo) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateGroupRsp) GetGroupId() int64 {
+func (x *MatchSpectatorInfo) GetUid() string {
 	if x != nil {
-		return x.GroupId
+		return x.Uid
 	}
-	return 0
+	return ""
 }
 
-// --->[START] EnterGroup
-type EnterGroupReq struct {
+type MatchPlayerInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PlayerInfo *PlayerInfo      `protobuf:"bytes,1,opt,name=player_info,json=playerInfo,proto3" json:"player_info,omitempty"`
-	Source     EnterGroupSource `protobuf:"varint,2,opt,name=source,proto3,enum=pb.EnterGroupSource" json:"source,omitempty"`
-	GroupId    int64            `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Uid     string         `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	GroupId int64          `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Attr    *UserAttribute `protobuf:"bytes,3,opt,name=attr,proto3" json:"attr,omitempty"`
 }
 
-func (x *EnterGroupReq) Reset() {
-	*x = EnterGroupReq{}
+func (x *MatchPlayerInfo) Reset() {
+	*x = MatchPlayerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[13]
+		mi := &file_protos_match_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnterGroupReq) String() string {
+func (x *MatchPlayerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnterGroupReq) ProtoMessage() {}
+func (*MatchPlayerInfo) ProtoMessage() {}
 
-func (x *EnterGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[13]
+func (x *MatchPlayerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,55 +1145,60 @@ func (x *EnterGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnterGroupReq.ProtoReflect.Descriptor instead.
-func (*EnterGroupReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MatchPlayerInfo.ProtoReflect.Descriptor instead.
+func (*MatchPlayerInfo) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *EnterGroupReq) GetPlayerInfo() *PlayerInfo {
+func (x *MatchPlayerInfo) GetUid() string {
 	if x != nil {
-		return x.PlayerInfo
+		return x.Uid
 	}
-	return nil
+	return ""
 }
 
-func (x *EnterGroupReq) GetSource() EnterGroupSource {
+func (x *MatchPlayerInfo) GetGroupId() int64 {
 	if x != nil {
-		return x.Source
+		return x.GroupId
 	}
-	return EnterGroupSource_ENTER_GROUP_SOURCE_INVITATION
+	return 0
 }
 
-func (x *EnterGroupReq) GetGroupId() int64 {
+func (x *MatchPlayerInfo) GetAttr() *UserAttribute {
 	if x != nil {
-		return x.GroupId
+		return x.Attr
 	}
-	return 0
+	return nil
 }
 
-type EnterGroupRsp struct {
+type GameServerInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *EnterGroupRsp) Reset() {
-	*x = EnterGroupRsp{}
+	Host     string      `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port     int32       `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Protocol NetProtocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=pb.NetProtocol" json:"protocol,omitempty"`
+	Region   string      `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (x *GameServerInfo) Reset() {
+	*x = GameServerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[14]
+		mi := &file_protos_match_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EnterGroupRsp) String() string {
+func (x *GameServerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnterGroupRsp) ProtoMessage() {}
+func (*GameServerInfo) ProtoMessage() {}
 
-func (x *EnterGroupRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[14]
+func (x *GameServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1125,37 +1209,66 @@ func (x *EnterGroupRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnterGroupRsp.ProtoReflect.Descriptor instead.
-func (*EnterGroupRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GameServerInfo.ProtoReflect.Descriptor instead.
+func (*GameServerInfo) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{13}
 }
 
-// --->[START] ExitGroup
-type ExitGroupReq struct {
+func (x *GameServerInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GameServerInfo) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *GameServerInfo) GetProtocol() NetProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return NetProtocol_NET_PROTOCOL_TCP
+}
+
+func (x *GameServerInfo) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type UserAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Nickname string `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Avatar   string `protobuf:"bytes,2,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Star     int64  `protobuf:"varint,3,opt,name=star,proto3" json:"star,omitempty"`
 }
 
-func (x *ExitGroupReq) Reset() {
-	*x = ExitGroupReq{}
+func (x *UserAttribute) Reset() {
+	*x = UserAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[15]
+		mi := &file_protos_match_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExitGroupReq) String() string {
+func (x *UserAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExitGroupReq) ProtoMessage() {}
+func (*UserAttribute) ProtoMessage() {}
 
-func (x *ExitGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[15]
+func (x *UserAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,41 +1279,58 @@ func (x *ExitGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExitGroupReq.ProtoReflect.Descriptor instead.
-func (*ExitGroupReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use UserAttribute.ProtoReflect.Descriptor instead.
+func (*UserAttribute) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ExitGroupReq) GetUid() string {
+func (x *UserAttribute) GetNickname() string {
 	if x != nil {
-		return x.Uid
+		return x.Nickname
 	}
 	return ""
 }
 
-type ExitGroupRsp struct {
+func (x *UserAttribute) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *UserAttribute) GetStar() int64 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+// --->[START] CreateGroup
+type CreateGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PlayerInfo *PlayerInfo `protobuf:"bytes,1,opt,name=player_info,json=playerInfo,proto3" json:"player_info,omitempty"`
 }
 
-func (x *ExitGroupRsp) Reset() {
-	*x = ExitGroupRsp{}
+func (x *CreateGroupReq) Reset() {
+	*x = CreateGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[16]
+		mi := &file_protos_match_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExitGroupRsp) String() string {
+func (x *CreateGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExitGroupRsp) ProtoMessage() {}
+func (*CreateGroupReq) ProtoMessage() {}
 
-func (x *ExitGroupRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[16]
+func (x *CreateGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1211,37 +1341,48 @@ func (x *ExitGroupRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExitGroupRsp.ProtoReflect.Descriptor instead.
-func (*ExitGroupRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CreateGroupReq.ProtoReflect.Descriptor instead.
+func (*CreateGroupReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{15}
 }
 
-// --->[START] DissolveGroup
-type DissolveGroupReq struct {
+func (x *CreateGroupReq) GetPlayerInfo() *PlayerInfo {
+	if x != nil {
+		return x.PlayerInfo
+	}
+	return nil
+}
+
+type Glicko2Info struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Mmr              float64 `protobuf:"fixed64,1,opt,name=mmr,proto3" json:"mmr,omitempty"`
+	Star             int64   `protobuf:"varint,2,opt,name=star,proto3" json:"star,omitempty"`
+	Rank             int64   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Rd               float64 `protobuf:"fixed64,4,opt,name=rd,proto3" json:"rd,omitempty"`
+	AccountCreateSec int64   `protobuf:"varint,5,opt,name=account_create_sec,json=accountCreateSec,proto3" json:"account_create_sec,omitempty"`
+	MatchCount       int64   `protobuf:"varint,6,opt,name=match_count,json=matchCount,proto3" json:"match_count,omitempty"`
 }
 
-func (x *DissolveGroupReq) Reset() {
-	*x = DissolveGroupReq{}
+func (x *Glicko2Info) Reset() {
+	*x = Glicko2Info{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[17]
+		mi := &file_protos_match_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DissolveGroupReq) String() string {
+func (x *Glicko2Info) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DissolveGroupReq) ProtoMessage() {}
+func (*Glicko2Info) ProtoMessage() {}
 
-func (x *DissolveGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[17]
+func (x *Glicko2Info) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,41 +1393,78 @@ func (x *DissolveGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DissolveGroupReq.ProtoReflect.Descriptor instead.
-func (*DissolveGroupReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Glicko2Info.ProtoReflect.Descriptor instead.
+func (*Glicko2Info) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DissolveGroupReq) GetUid() string {
+func (x *Glicko2Info) GetMmr() float64 {
 	if x != nil {
-		return x.Uid
+		return x.Mmr
 	}
-	return ""
+	return 0
 }
 
-type DissolveGroupRsp struct {
+func (x *Glicko2Info) GetStar() int64 {
+	if x != nil {
+		return x.Star
+	}
+	return 0
+}
+
+func (x *Glicko2Info) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *Glicko2Info) GetRd() float64 {
+	if x != nil {
+		return x.Rd
+	}
+	return 0
+}
+
+func (x *Glicko2Info) GetAccountCreateSec() int64 {
+	if x != nil {
+		return x.AccountCreateSec
+	}
+	return 0
+}
+
+func (x *Glicko2Info) GetMatchCount() int64 {
+	if x != nil {
+		return x.MatchCount
+	}
+	return 0
+}
+
+type CreateGroupRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	GroupId int64 `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (x *DissolveGroupRsp) Reset() {
-	*x = DissolveGroupRsp{}
+func (x *CreateGroupRsp) Reset() {
+	*x = CreateGroupRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[18]
+		mi := &file_protos_match_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DissolveGroupRsp) String() string {
+func (x *CreateGroupRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DissolveGroupRsp) ProtoMessage() {}
+func (*CreateGroupRsp) ProtoMessage() {}
 
-func (x *DissolveGroupRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[18]
+func (x *CreateGroupRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1297,38 +1475,47 @@ func (x *DissolveGroupRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DissolveGroupRsp.ProtoReflect.Descriptor instead.
-func (*DissolveGroupRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CreateGroupRsp.ProtoReflect.Descriptor instead.
+func (*CreateGroupRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{17}
 }
 
-// --->[START] Invite
-type InviteReq struct {
+func (x *CreateGroupRsp) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+// --->[START] EnterGroup
+type EnterGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InviterUid string `protobuf:"bytes,1,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
-	InviteeUid string `protobuf:"bytes,2,opt,name=invitee_uid,json=inviteeUid,proto3" json:"invitee_uid,omitempty"`
+	PlayerInfo *PlayerInfo      `protobuf:"bytes,1,opt,name=player_info,json=playerInfo,proto3" json:"player_info,omitempty"`
+	Source     EnterGroupSource `protobuf:"varint,2,opt,name=source,proto3,enum=pb.EnterGroupSource" json:"source,omitempty"`
+	GroupId    int64            `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ShareToken string           `protobuf:"bytes,4,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
 }
 
-func (x *InviteReq) Reset() {
-	*x = InviteReq{}
+func (x *EnterGroupReq) Reset() {
+	*x = EnterGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[19]
+		mi := &file_protos_match_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InviteReq) String() string {
+func (x *EnterGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteReq) ProtoMessage() {}
+func (*EnterGroupReq) ProtoMessage() {}
 
-func (x *InviteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[19]
+func (x *EnterGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1339,48 +1526,62 @@ func (x *InviteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteReq.ProtoReflect.Descriptor instead.
-func (*InviteReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use EnterGroupReq.ProtoReflect.Descriptor instead.
+func (*EnterGroupReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *InviteReq) GetInviterUid() string {
+func (x *EnterGroupReq) GetPlayerInfo() *PlayerInfo {
 	if x != nil {
-		return x.InviterUid
+		return x.PlayerInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *InviteReq) GetInviteeUid() string {
+func (x *EnterGroupReq) GetSource() EnterGroupSource {
 	if x != nil {
-		return x.InviteeUid
+		return x.Source
+	}
+	return EnterGroupSource_ENTER_GROUP_SOURCE_INVITATION
+}
+
+func (x *EnterGroupReq) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *EnterGroupReq) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
 	}
 	return ""
 }
 
-type InviteRsp struct {
+type EnterGroupRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *InviteRsp) Reset() {
-	*x = InviteRsp{}
+func (x *EnterGroupRsp) Reset() {
+	*x = EnterGroupRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[20]
+		mi := &file_protos_match_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InviteRsp) String() string {
+func (x *EnterGroupRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InviteRsp) ProtoMessage() {}
+func (*EnterGroupRsp) ProtoMessage() {}
 
-func (x *InviteRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[20]
+func (x *EnterGroupRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,39 +1592,37 @@ func (x *InviteRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InviteRsp.ProtoReflect.Descriptor instead.
-func (*InviteRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use EnterGroupRsp.ProtoReflect.Descriptor instead.
+func (*EnterGroupRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{19}
 }
 
-// --->[START] AcceptInvite
-type AcceptInviteReq struct {
+// --->[START] ExitGroup
+type ExitGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InviterUid  string      `protobuf:"bytes,1,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
-	InviteeInfo *PlayerInfo `protobuf:"bytes,2,opt,name=invitee_info,json=inviteeInfo,proto3" json:"invitee_info,omitempty"`
-	GroupId     int64       `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 }
 
-func (x *AcceptInviteReq) Reset() {
-	*x = AcceptInviteReq{}
+func (x *ExitGroupReq) Reset() {
+	*x = ExitGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[21]
+		mi := &file_protos_match_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AcceptInviteReq) String() string {
+func (x *ExitGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptInviteReq) ProtoMessage() {}
+func (*ExitGroupReq) ProtoMessage() {}
 
-func (x *AcceptInviteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[21]
+func (x *ExitGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,55 +1633,41 @@ func (x *AcceptInviteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptInviteReq.ProtoReflect.Descriptor instead.
-func (*AcceptInviteReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ExitGroupReq.ProtoReflect.Descriptor instead.
+func (*ExitGroupReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *AcceptInviteReq) GetInviterUid() string {
+func (x *ExitGroupReq) GetUid() string {
 	if x != nil {
-		return x.InviterUid
+		return x.Uid
 	}
 	return ""
 }
 
-func (x *AcceptInviteReq) GetInviteeInfo() *PlayerInfo {
-	if x != nil {
-		return x.InviteeInfo
-	}
-	return nil
-}
-
-func (x *AcceptInviteReq) GetGroupId() int64 {
-	if x != nil {
-		return x.GroupId
-	}
-	return 0
-}
-
-type AcceptInviteRsp struct {
+type ExitGroupRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AcceptInviteRsp) Reset() {
-	*x = AcceptInviteRsp{}
+func (x *ExitGroupRsp) Reset() {
+	*x = ExitGroupRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[22]
+		mi := &file_protos_match_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AcceptInviteRsp) String() string {
+func (x *ExitGroupRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptInviteRsp) ProtoMessage() {}
+func (*ExitGroupRsp) ProtoMessage() {}
 
-func (x *AcceptInviteRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[22]
+func (x *ExitGroupRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1493,40 +1678,37 @@ func (x *AcceptInviteRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptInviteRsp.ProtoReflect.Descriptor instead.
-func (*AcceptInviteRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ExitGroupRsp.ProtoReflect.Descriptor instead.
+func (*ExitGroupRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{21}
 }
 
-// --->[START] RefuseInvite
-type RefuseInviteReq struct {
+// --->[START] DissolveGroup
+type DissolveGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InviterUid string `protobuf:"bytes,1,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
-	InviteeUid string `protobuf:"bytes,2,opt,name=invitee_uid,json=inviteeUid,proto3" json:"invitee_uid,omitempty"`
-	GroupId    int64  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
-	RefuseMsg  string `protobuf:"bytes,4,opt,name=refuse_msg,json=refuseMsg,proto3" json:"refuse_msg,omitempty"`
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 }
 
-func (x *RefuseInviteReq) Reset() {
-	*x = RefuseInviteReq{}
+func (x *DissolveGroupReq) Reset() {
+	*x = DissolveGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[23]
+		mi := &file_protos_match_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefuseInviteReq) String() string {
+func (x *DissolveGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefuseInviteReq) ProtoMessage() {}
+func (*DissolveGroupReq) ProtoMessage() {}
 
-func (x *RefuseInviteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[23]
+func (x *DissolveGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1537,62 +1719,41 @@ func (x *RefuseInviteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefuseInviteReq.ProtoReflect.Descriptor instead.
-func (*RefuseInviteReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *RefuseInviteReq) GetInviterUid() string {
-	if x != nil {
-		return x.InviterUid
-	}
-	return ""
-}
-
-func (x *RefuseInviteReq) GetInviteeUid() string {
-	if x != nil {
-		return x.InviteeUid
-	}
-	return ""
-}
-
-func (x *RefuseInviteReq) GetGroupId() int64 {
-	if x != nil {
-		return x.GroupId
-	}
-	return 0
+// Deprecated: Use DissolveGroupReq.ProtoReflect.Descriptor instead.
+func (*DissolveGroupReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *RefuseInviteReq) GetRefuseMsg() string {
+func (x *DissolveGroupReq) GetUid() string {
 	if x != nil {
-		return x.RefuseMsg
+		return x.Uid
 	}
 	return ""
 }
 
-type RefuseInviteRsp struct {
+type DissolveGroupRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RefuseInviteRsp) Reset() {
-	*x = RefuseInviteRsp{}
+func (x *DissolveGroupRsp) Reset() {
+	*x = DissolveGroupRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[24]
+		mi := &file_protos_match_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefuseInviteRsp) String() string {
+func (x *DissolveGroupRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefuseInviteRsp) ProtoMessage() {}
+func (*DissolveGroupRsp) ProtoMessage() {}
 
-func (x *RefuseInviteRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[24]
+func (x *DissolveGroupRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1603,38 +1764,38 @@ func (x *RefuseInviteRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefuseInviteRsp.ProtoReflect.Descriptor instead.
-func (*RefuseInviteRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DissolveGroupRsp.ProtoReflect.Descriptor instead.
+func (*DissolveGroupRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{23}
 }
 
-// --->[START] KickPlayer
-type KickPlayerReq struct {
+// --->[START] Invite
+type InviteReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CaptainUid string `protobuf:"bytes,1,opt,name=captain_uid,json=captainUid,proto3" json:"captain_uid,omitempty"`
-	KickedUid  string `protobuf:"bytes,2,opt,name=kicked_uid,json=kickedUid,proto3" json:"kicked_uid,omitempty"`
+	InviterUid string `protobuf:"bytes,1,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
+	InviteeUid string `protobuf:"bytes,2,opt,name=invitee_uid,json=inviteeUid,proto3" json:"invitee_uid,omitempty"`
 }
 
-func (x *KickPlayerReq) Reset() {
-	*x = KickPlayerReq{}
+func (x *InviteReq) Reset() {
+	*x = InviteReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[25]
+		mi := &file_protos_match_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KickPlayerReq) String() string {
+func (x *InviteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KickPlayerReq) ProtoMessage() {}
+func (*InviteReq) ProtoMessage() {}
 
-func (x *KickPlayerReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[25]
+func (x *InviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1645,48 +1806,48 @@ func (x *KickPlayerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KickPlayerReq.ProtoReflect.Descriptor instead.
-func (*KickPlayerReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use InviteReq.ProtoReflect.Descriptor instead.
+func (*InviteReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *KickPlayerReq) GetCaptainUid() string {
+func (x *InviteReq) GetInviterUid() string {
 	if x != nil {
-		return x.CaptainUid
+		return x.InviterUid
 	}
 	return ""
 }
 
-func (x *KickPlayerReq) GetKickedUid() string {
+func (x *InviteReq) GetInviteeUid() string {
 	if x != nil {
-		return x.KickedUid
+		return x.InviteeUid
 	}
 	return ""
 }
 
-type KickPlayerRsp struct {
+type InviteRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *KickPlayerRsp) Reset() {
-	*x = KickPlayerRsp{}
+func (x *InviteRsp) Reset() {
+	*x = InviteRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[26]
+		mi := &file_protos_match_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KickPlayerRsp) String() string {
+func (x *InviteRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KickPlayerRsp) ProtoMessage() {}
+func (*InviteRsp) ProtoMessage() {}
 
-func (x *KickPlayerRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[26]
+func (x *InviteRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1697,39 +1858,39 @@ func (x *KickPlayerRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KickPlayerRsp.ProtoReflect.Descriptor instead.
-func (*KickPlayerRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use InviteRsp.ProtoReflect.Descriptor instead.
+func (*InviteRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{25}
 }
 
-// --->[START] ChangeRole
-type ChangeRoleReq struct {
+// --->[START] AcceptInvite
+type AcceptInviteReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CaptainUid string    `protobuf:"bytes,1,opt,name=captain_uid,json=captainUid,proto3" json:"captain_uid,omitempty"`
-	TargetUid  string    `protobuf:"bytes,2,opt,name=target_uid,json=targetUid,proto3" json:"target_uid,omitempty"`
-	Role       GroupRole `protobuf:"varint,3,opt,name=role,proto3,enum=pb.GroupRole" json:"role,omitempty"`
+	InviterUid  string      `protobuf:"bytes,1,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
+	InviteeInfo *PlayerInfo `protobuf:"bytes,2,opt,name=invitee_info,json=inviteeInfo,proto3" json:"invitee_info,omitempty"`
+	GroupId     int64       `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
-func (x *ChangeRoleReq) Reset() {
-	*x = ChangeRoleReq{}
+func (x *AcceptInviteReq) Reset() {
+	*x = AcceptInviteReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[27]
+		mi := &file_protos_match_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeRoleReq) String() string {
+func (x *AcceptInviteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeRoleReq) ProtoMessage() {}
+func (*AcceptInviteReq) ProtoMessage() {}
 
-func (x *ChangeRoleReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[27]
+func (x *AcceptInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,55 +1901,55 @@ func (x *ChangeRoleReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeRoleReq.ProtoReflect.Descriptor instead.
-func (*ChangeRoleReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use AcceptInviteReq.ProtoReflect.Descriptor instead.
+func (*AcceptInviteReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ChangeRoleReq) GetCaptainUid() string {
+func (x *AcceptInviteReq) GetInviterUid() string {
 	if x != nil {
-		return x.CaptainUid
+		return x.InviterUid
 	}
 	return ""
 }
 
-func (x *ChangeRoleReq) GetTargetUid() string {
+func (x *AcceptInviteReq) GetInviteeInfo() *PlayerInfo {
 	if x != nil {
-		return x.TargetUid
+		return x.InviteeInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *ChangeRoleReq) GetRole() GroupRole {
+func (x *AcceptInviteReq) GetGroupId() int64 {
 	if x != nil {
-		return x.Role
+		return x.GroupId
 	}
-	return GroupRole_GROUP_ROLE_NORMAL
+	return 0
 }
 
-type ChangeRoleRsp struct {
+type AcceptInviteRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ChangeRoleRsp) Reset() {
-	*x = ChangeRoleRsp{}
+func (x *AcceptInviteRsp) Reset() {
+	*x = AcceptInviteRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[28]
+		mi := &file_protos_match_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeRoleRsp) String() string {
+func (x *AcceptInviteRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeRoleRsp) ProtoMessage() {}
+func (*AcceptInviteRsp) ProtoMessage() {}
 
-func (x *ChangeRoleRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[28]
+func (x *AcceptInviteRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,38 +1960,40 @@ func (x *ChangeRoleRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeRoleRsp.ProtoReflect.Descriptor instead.
-func (*ChangeRoleRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use AcceptInviteRsp.ProtoReflect.Descriptor instead.
+func (*AcceptInviteRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{27}
 }
 
-// --->[START] SetNearbyJoinGroup
-type SetNearbyJoinGroupReq struct {
+// --->[START] RefuseInvite
+type RefuseInviteReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Allow bool   `protobuf:"varint,2,opt,name=allow,proto3" json:"allow,omitempty"`
+	InviterUid string `protobuf:"bytes,1,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
+	InviteeUid string `protobuf:"bytes,2,opt,name=invitee_uid,json=inviteeUid,proto3" json:"invitee_uid,omitempty"`
+	GroupId    int64  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	RefuseMsg  string `protobuf:"bytes,4,opt,name=refuse_msg,json=refuseMsg,proto3" json:"refuse_msg,omitempty"`
 }
 
-func (x *SetNearbyJoinGroupReq) Reset() {
-	*x = SetNearbyJoinGroupReq{}
+func (x *RefuseInviteReq) Reset() {
+	*x = RefuseInviteReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[29]
+		mi := &file_protos_match_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetNearbyJoinGroupReq) String() string {
+func (x *RefuseInviteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetNearbyJoinGroupReq) ProtoMessage() {}
+func (*RefuseInviteReq) ProtoMessage() {}
 
-func (x *SetNearbyJoinGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[29]
+func (x *RefuseInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1841,48 +2004,62 @@ func (x *SetNearbyJoinGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetNearbyJoinGroupReq.ProtoReflect.Descriptor instead.
-func (*SetNearbyJoinGroupReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use RefuseInviteReq.ProtoReflect.Descriptor instead.
+func (*RefuseInviteReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SetNearbyJoinGroupReq) GetUid() string {
+func (x *RefuseInviteReq) GetInviterUid() string {
 	if x != nil {
-		return x.Uid
+		return x.InviterUid
 	}
 	return ""
 }
 
-func (x *SetNearbyJoinGroupReq) GetAllow() bool {
+func (x *RefuseInviteReq) GetInviteeUid() string {
 	if x != nil {
-		return x.Allow
+		return x.InviteeUid
 	}
-	return false
+	return ""
 }
 
-type SetNearbyJoinGroupRsp struct {
+func (x *RefuseInviteReq) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *RefuseInviteReq) GetRefuseMsg() string {
+	if x != nil {
+		return x.RefuseMsg
+	}
+	return ""
+}
+
+type RefuseInviteRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetNearbyJoinGroupRsp) Reset() {
-	*x = SetNearbyJoinGroupRsp{}
+func (x *RefuseInviteRsp) Reset() {
+	*x = RefuseInviteRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[30]
+		mi := &file_protos_match_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetNearbyJoinGroupRsp) String() string {
+func (x *RefuseInviteRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetNearbyJoinGroupRsp) ProtoMessage() {}
+func (*RefuseInviteRsp) ProtoMessage() {}
 
-func (x *SetNearbyJoinGroupRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[30]
+func (x *RefuseInviteRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1893,38 +2070,38 @@ func (x *SetNearbyJoinGroupRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetNearbyJoinGroupRsp.ProtoReflect.Descriptor instead.
-func (*SetNearbyJoinGroupRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use RefuseInviteRsp.ProtoReflect.Descriptor instead.
+func (*RefuseInviteRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{29}
 }
 
-// --->[START] SetRecentJoinGroup
-type SetRecentJoinGroupReq struct {
+// --->[START] RevokeInvite
+type RevokeInviteReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Allow bool   `protobuf:"varint,2,opt,name=allow,proto3" json:"allow,omitempty"`
+	InviterUid string `protobuf:"bytes,1,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
+	InviteeUid string `protobuf:"bytes,2,opt,name=invitee_uid,json=inviteeUid,proto3" json:"invitee_uid,omitempty"`
 }
 
-func (x *SetRecentJoinGroupReq) Reset() {
-	*x = SetRecentJoinGroupReq{}
+func (x *RevokeInviteReq) Reset() {
+	*x = RevokeInviteReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[31]
+		mi := &file_protos_match_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetRecentJoinGroupReq) String() string {
+func (x *RevokeInviteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetRecentJoinGroupReq) ProtoMessage() {}
+func (*RevokeInviteReq) ProtoMessage() {}
 
-func (x *SetRecentJoinGroupReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[31]
+func (x *RevokeInviteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1935,48 +2112,48 @@ func (x *SetRecentJoinGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetRecentJoinGroupReq.ProtoReflect.Descriptor instead.
-func (*SetRecentJoinGroupReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use RevokeInviteReq.ProtoReflect.Descriptor instead.
+func (*RevokeInviteReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SetRecentJoinGroupReq) GetUid() string {
+func (x *RevokeInviteReq) GetInviterUid() string {
 	if x != nil {
-		return x.Uid
+		return x.InviterUid
 	}
 	return ""
 }
 
-func (x *SetRecentJoinGroupReq) GetAllow() bool {
+func (x *RevokeInviteReq) GetInviteeUid() string {
 	if x != nil {
-		return x.Allow
+		return x.InviteeUid
 	}
-	return false
+	return ""
 }
 
-type SetRecentJoinGroupRsp struct {
+type RevokeInviteRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SetRecentJoinGroupRsp) Reset() {
-	*x = SetRecentJoinGroupRsp{}
+func (x *RevokeInviteRsp) Reset() {
+	*x = RevokeInviteRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[32]
+		mi := &file_protos_match_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetRecentJoinGroupRsp) String() string {
+func (x *RevokeInviteRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetRecentJoinGroupRsp) ProtoMessage() {}
+func (*RevokeInviteRsp) ProtoMessage() {}
 
-func (x *SetRecentJoinGroupRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[32]
+func (x *RevokeInviteRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1987,38 +2164,37 @@ func (x *SetRecentJoinGroupRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetRecentJoinGroupRsp.ProtoReflect.Descriptor instead.
-func (*SetRecentJoinGroupRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use RevokeInviteRsp.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{31}
 }
 
-// --->[START] SetVoiceState
-type SetVoiceStateReq struct {
+// --->[START] ListReceivedInvites
+type ListReceivedInvitesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid   string           `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	State PlayerVoiceState `protobuf:"varint,2,opt,name=state,proto3,enum=pb.PlayerVoiceState" json:"state,omitempty"`
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 }
 
-func (x *SetVoiceStateReq) Reset() {
-	*x = SetVoiceStateReq{}
+func (x *ListReceivedInvitesReq) Reset() {
+	*x = ListReceivedInvitesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[33]
+		mi := &file_protos_match_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetVoiceStateReq) String() string {
+func (x *ListReceivedInvitesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetVoiceStateReq) ProtoMessage() {}
+func (*ListReceivedInvitesReq) ProtoMessage() {}
 
-func (x *SetVoiceStateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[33]
+func (x *ListReceivedInvitesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2029,48 +2205,43 @@ func (x *SetVoiceStateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetVoiceStateReq.ProtoReflect.Descriptor instead.
-func (*SetVoiceStateReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListReceivedInvitesReq.ProtoReflect.Descriptor instead.
+func (*ListReceivedInvitesReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SetVoiceStateReq) GetUid() string {
+func (x *ListReceivedInvitesReq) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *SetVoiceStateReq) GetState() PlayerVoiceState {
-	if x != nil {
-		return x.State
-	}
-	return PlayerVoiceState_PLAYER_VOICE_STATE_MUTE
-}
-
-type SetVoiceStateRsp struct {
+type ListReceivedInvitesRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Invites []*PendingInvite `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
 }
 
-func (x *SetVoiceStateRsp) Reset() {
-	*x = SetVoiceStateRsp{}
+func (x *ListReceivedInvitesRsp) Reset() {
+	*x = ListReceivedInvitesRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[34]
+		mi := &file_protos_match_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetVoiceStateRsp) String() string {
+func (x *ListReceivedInvitesRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetVoiceStateRsp) ProtoMessage() {}
+func (*ListReceivedInvitesRsp) ProtoMessage() {}
 
-func (x *SetVoiceStateRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[34]
+func (x *ListReceivedInvitesRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2081,13 +2252,20 @@ func (x *SetVoiceStateRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetVoiceStateRsp.ProtoReflect.Descriptor instead.
-func (*SetVoiceStateRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ListReceivedInvitesRsp.ProtoReflect.Descriptor instead.
+func (*ListReceivedInvitesRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{33}
 }
 
-// --->[START] Ready
-type ReadyReq struct {
+func (x *ListReceivedInvitesRsp) GetInvites() []*PendingInvite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+// --->[START] ListSentInvites
+type ListSentInvitesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2095,23 +2273,23 @@ type ReadyReq struct {
 	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
 }
 
-func (x *ReadyReq) Reset() {
-	*x = ReadyReq{}
+func (x *ListSentInvitesReq) Reset() {
+	*x = ListSentInvitesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[35]
+		mi := &file_protos_match_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadyReq) String() string {
+func (x *ListSentInvitesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadyReq) ProtoMessage() {}
+func (*ListSentInvitesReq) ProtoMessage() {}
 
-func (x *ReadyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[35]
+func (x *ListSentInvitesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2122,41 +2300,43 @@ func (x *ReadyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadyReq.ProtoReflect.Descriptor instead.
-func (*ReadyReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListSentInvitesReq.ProtoReflect.Descriptor instead.
+func (*ListSentInvitesReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ReadyReq) GetUid() string {
+func (x *ListSentInvitesReq) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-type ReadyRsp struct {
+type ListSentInvitesRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Invites []*PendingInvite `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
 }
 
-func (x *ReadyRsp) Reset() {
-	*x = ReadyRsp{}
+func (x *ListSentInvitesRsp) Reset() {
+	*x = ListSentInvitesRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[36]
+		mi := &file_protos_match_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadyRsp) String() string {
+func (x *ListSentInvitesRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadyRsp) ProtoMessage() {}
+func (*ListSentInvitesRsp) ProtoMessage() {}
 
-func (x *ReadyRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[36]
+func (x *ListSentInvitesRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2167,37 +2347,45 @@ func (x *ReadyRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadyRsp.ProtoReflect.Descriptor instead.
-func (*ReadyRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ListSentInvitesRsp.ProtoReflect.Descriptor instead.
+func (*ListSentInvitesRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{35}
 }
 
-// --->[START] Unready
-type UnreadyReq struct {
+func (x *ListSentInvitesRsp) GetInvites() []*PendingInvite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+// --->[START] KickPlayer
+type KickPlayerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CaptainUid string `protobuf:"bytes,1,opt,name=captain_uid,json=captainUid,proto3" json:"captain_uid,omitempty"`
+	KickedUid  string `protobuf:"bytes,2,opt,name=kicked_uid,json=kickedUid,proto3" json:"kicked_uid,omitempty"`
 }
 
-func (x *UnreadyReq) Reset() {
-	*x = UnreadyReq{}
+func (x *KickPlayerReq) Reset() {
+	*x = KickPlayerReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[37]
+		mi := &file_protos_match_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnreadyReq) String() string {
+func (x *KickPlayerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnreadyReq) ProtoMessage() {}
+func (*KickPlayerReq) ProtoMessage() {}
 
-func (x *UnreadyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[37]
+func (x *KickPlayerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2208,41 +2396,48 @@ func (x *UnreadyReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnreadyReq.ProtoReflect.Descriptor instead.
-func (*UnreadyReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use KickPlayerReq.ProtoReflect.Descriptor instead.
+func (*KickPlayerReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *UnreadyReq) GetUid() string {
+func (x *KickPlayerReq) GetCaptainUid() string {
 	if x != nil {
-		return x.Uid
+		return x.CaptainUid
 	}
 	return ""
 }
 
-type UnreadyRsp struct {
+func (x *KickPlayerReq) GetKickedUid() string {
+	if x != nil {
+		return x.KickedUid
+	}
+	return ""
+}
+
+type KickPlayerRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UnreadyRsp) Reset() {
-	*x = UnreadyRsp{}
+func (x *KickPlayerRsp) Reset() {
+	*x = KickPlayerRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[38]
+		mi := &file_protos_match_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnreadyRsp) String() string {
+func (x *KickPlayerRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnreadyRsp) ProtoMessage() {}
+func (*KickPlayerRsp) ProtoMessage() {}
 
-func (x *UnreadyRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[38]
+func (x *KickPlayerRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2253,37 +2448,39 @@ func (x *UnreadyRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnreadyRsp.ProtoReflect.Descriptor instead.
-func (*UnreadyRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use KickPlayerRsp.ProtoReflect.Descriptor instead.
+func (*KickPlayerRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{37}
 }
 
-// --->[START] StartMatch
-type StartMatchReq struct {
+// --->[START] ChangeRole
+type ChangeRoleReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	CaptainUid string    `protobuf:"bytes,1,opt,name=captain_uid,json=captainUid,proto3" json:"captain_uid,omitempty"`
+	TargetUid  string    `protobuf:"bytes,2,opt,name=target_uid,json=targetUid,proto3" json:"target_uid,omitempty"`
+	Role       GroupRole `protobuf:"varint,3,opt,name=role,proto3,enum=pb.GroupRole" json:"role,omitempty"`
 }
 
-func (x *StartMatchReq) Reset() {
-	*x = StartMatchReq{}
+func (x *ChangeRoleReq) Reset() {
+	*x = ChangeRoleReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[39]
+		mi := &file_protos_match_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartMatchReq) String() string {
+func (x *ChangeRoleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartMatchReq) ProtoMessage() {}
+func (*ChangeRoleReq) ProtoMessage() {}
 
-func (x *StartMatchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[39]
+func (x *ChangeRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2294,41 +2491,55 @@ func (x *StartMatchReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartMatchReq.ProtoReflect.Descriptor instead.
-func (*StartMatchReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ChangeRoleReq.ProtoReflect.Descriptor instead.
+func (*ChangeRoleReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *StartMatchReq) GetUid() string {
+func (x *ChangeRoleReq) GetCaptainUid() string {
 	if x != nil {
-		return x.Uid
+		return x.CaptainUid
 	}
 	return ""
 }
 
-type StartMatchRsp struct {
+func (x *ChangeRoleReq) GetTargetUid() string {
+	if x != nil {
+		return x.TargetUid
+	}
+	return ""
+}
+
+func (x *ChangeRoleReq) GetRole() GroupRole {
+	if x != nil {
+		return x.Role
+	}
+	return GroupRole_GROUP_ROLE_NORMAL
+}
+
+type ChangeRoleRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *StartMatchRsp) Reset() {
-	*x = StartMatchRsp{}
+func (x *ChangeRoleRsp) Reset() {
+	*x = ChangeRoleRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[40]
+		mi := &file_protos_match_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartMatchRsp) String() string {
+func (x *ChangeRoleRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartMatchRsp) ProtoMessage() {}
+func (*ChangeRoleRsp) ProtoMessage() {}
 
-func (x *StartMatchRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[40]
+func (x *ChangeRoleRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2339,37 +2550,38 @@ func (x *StartMatchRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartMatchRsp.ProtoReflect.Descriptor instead.
-func (*StartMatchRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ChangeRoleRsp.ProtoReflect.Descriptor instead.
+func (*ChangeRoleRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{39}
 }
 
-// --->[START] CancelMatch
-type CancelMatchReq struct {
+// --->[START] SetNearbyJoinGroup
+type SetNearbyJoinGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Allow bool   `protobuf:"varint,2,opt,name=allow,proto3" json:"allow,omitempty"`
 }
 
-func (x *CancelMatchReq) Reset() {
-	*x = CancelMatchReq{}
+func (x *SetNearbyJoinGroupReq) Reset() {
+	*x = SetNearbyJoinGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[41]
+		mi := &file_protos_match_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelMatchReq) String() string {
+func (x *SetNearbyJoinGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelMatchReq) ProtoMessage() {}
+func (*SetNearbyJoinGroupReq) ProtoMessage() {}
 
-func (x *CancelMatchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[41]
+func (x *SetNearbyJoinGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2380,41 +2592,48 @@ func (x *CancelMatchReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelMatchReq.ProtoReflect.Descriptor instead.
-func (*CancelMatchReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use SetNearbyJoinGroupReq.ProtoReflect.Descriptor instead.
+func (*SetNearbyJoinGroupReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CancelMatchReq) GetUid() string {
+func (x *SetNearbyJoinGroupReq) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-type CancelMatchRsp struct {
+func (x *SetNearbyJoinGroupReq) GetAllow() bool {
+	if x != nil {
+		return x.Allow
+	}
+	return false
+}
+
+type SetNearbyJoinGroupRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CancelMatchRsp) Reset() {
-	*x = CancelMatchRsp{}
+func (x *SetNearbyJoinGroupRsp) Reset() {
+	*x = SetNearbyJoinGroupRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[42]
+		mi := &file_protos_match_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelMatchRsp) String() string {
+func (x *SetNearbyJoinGroupRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelMatchRsp) ProtoMessage() {}
+func (*SetNearbyJoinGroupRsp) ProtoMessage() {}
 
-func (x *CancelMatchRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[42]
+func (x *SetNearbyJoinGroupRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2425,42 +2644,38 @@ func (x *CancelMatchRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelMatchRsp.ProtoReflect.Descriptor instead.
-func (*CancelMatchRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use SetNearbyJoinGroupRsp.ProtoReflect.Descriptor instead.
+func (*SetNearbyJoinGroupRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{41}
 }
 
-// --->[START] UploadPlayerAttr
-type UploadPlayerAttrReq struct {
+// --->[START] SetRecentJoinGroup
+type SetRecentJoinGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid  string         `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Attr *UserAttribute `protobuf:"bytes,2,opt,name=attr,proto3" json:"attr,omitempty"`
-	// Types that are assignable to Type:
-	//
-	//	*UploadPlayerAttrReq_GoatGameAttr
-	Type isUploadPlayerAttrReq_Type `protobuf_oneof:"type"`
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Allow bool   `protobuf:"varint,2,opt,name=allow,proto3" json:"allow,omitempty"`
 }
 
-func (x *UploadPlayerAttrReq) Reset() {
-	*x = UploadPlayerAttrReq{}
+func (x *SetRecentJoinGroupReq) Reset() {
+	*x = SetRecentJoinGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[43]
+		mi := &file_protos_match_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadPlayerAttrReq) String() string {
+func (x *SetRecentJoinGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadPlayerAttrReq) ProtoMessage() {}
+func (*SetRecentJoinGroupReq) ProtoMessage() {}
 
-func (x *UploadPlayerAttrReq) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_match_proto_msgTypes[43]
+func (x *SetRecentJoinGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,73 +2686,89 @@ func (x *UploadPlayerAttrReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadPlayerAttrReq.ProtoReflect.Descriptor instead.
-func (*UploadPlayerAttrReq) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use SetRecentJoinGroupReq.ProtoReflect.Descriptor instead.
+func (*SetRecentJoinGroupReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UploadPlayerAttrReq) GetUid() string {
+func (x *SetRecentJoinGroupReq) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *UploadPlayerAttrReq) GetAttr() *UserAttribute {
+func (x *SetRecentJoinGroupReq) GetAllow() bool {
 	if x != nil {
-		return x.Attr
-	}
-	return nil
-}
-
-func (m *UploadPlayerAttrReq) GetType() isUploadPlayerAttrReq_Type {
-	if m != nil {
-		return m.Type
-	}
-	return nil
-}
-
-func (x *UploadPlayerAttrReq) GetGoatGameAttr() *GoatGameAttribute {
-	if x, ok := x.GetType().(*UploadPlayerAttrReq_GoatGameAttr); ok {
-		return x.GoatGameAttr
+		return x.Allow
 	}
-	return nil
-}
-
-type isUploadPlayerAttrReq_Type interface {
-	isUploadPlayerAttrReq_Type()
-}
-
-type UploadPlayerAttrReq_GoatGameAttr struct {
-	GoatGameAttr *GoatGameAttribute `protobuf:"bytes,3,opt,name=goat_game_attr,json=goatGameAttr,proto3,oneof"`
+	return false
 }
 
-func (*UploadPlayerAttrReq_GoatGameAttr) isUploadPlayerAttrReq_Type() {}
-
-type GoatGameAttribute struct {
+type SetRecentJoinGroupRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Mmr float64 `protobuf:"fixed64,1,opt,name=mmr,proto3" json:"mmr,omitempty"`
 }
 
-func (x *GoatGameAttribute) Reset() {
-	*x = GoatGameAttribute{}
+func (x *SetRecentJoinGroupRsp) Reset() {
+	*x = SetRecentJoinGroupRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_match_proto_msgTypes[44]
+		mi := &file_protos_match_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GoatGameAttribute) String() string {
+func (x *SetRecentJoinGroupRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GoatGameAttribute) ProtoMessage() {}
+func (*SetRecentJoinGroupRsp) ProtoMessage() {}
 
-func (x *GoatGameAttribute) ProtoReflect() protoreflect.Message {
+func (x *SetRecentJoinGroupRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecentJoinGroupRsp.ProtoReflect.Descriptor instead.
+func (*SetRecentJoinGroupRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{43}
+}
+
+// --->[START] SetFriendJoinGroup
+type SetFriendJoinGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Allow bool   `protobuf:"varint,2,opt,name=allow,proto3" json:"allow,omitempty"`
+}
+
+func (x *SetFriendJoinGroupReq) Reset() {
+	*x = SetFriendJoinGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendJoinGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendJoinGroupReq) ProtoMessage() {}
+
+func (x *SetFriendJoinGroupReq) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_match_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2549,26 +2780,33 @@ func (x *GoatGameAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GoatGameAttribute.ProtoReflect.Descriptor instead.
-func (*GoatGameAttribute) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetFriendJoinGroupReq.ProtoReflect.Descriptor instead.
+func (*SetFriendJoinGroupReq) Descriptor() ([]byte, []int) {
 	return file_protos_match_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GoatGameAttribute) GetMmr() float64 {
+func (x *SetFriendJoinGroupReq) GetUid() string {
 	if x != nil {
-		return x.Mmr
+		return x.Uid
 	}
-	return 0
+	return ""
 }
 
-type UploadPlayerAttrRsp struct {
+func (x *SetFriendJoinGroupReq) GetAllow() bool {
+	if x != nil {
+		return x.Allow
+	}
+	return false
+}
+
+type SetFriendJoinGroupRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UploadPlayerAttrRsp) Reset() {
-	*x = UploadPlayerAttrRsp{}
+func (x *SetFriendJoinGroupRsp) Reset() {
+	*x = SetFriendJoinGroupRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_match_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2576,13 +2814,13 @@ func (x *UploadPlayerAttrRsp) Reset() {
 	}
 }
 
-func (x *UploadPlayerAttrRsp) String() string {
+func (x *SetFriendJoinGroupRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadPlayerAttrRsp) ProtoMessage() {}
+func (*SetFriendJoinGroupRsp) ProtoMessage() {}
 
-func (x *UploadPlayerAttrRsp) ProtoReflect() protoreflect.Message {
+func (x *SetFriendJoinGroupRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_match_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2594,23 +2832,23 @@ func (x *UploadPlayerAttrRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadPlayerAttrRsp.ProtoReflect.Descriptor instead.
-func (*UploadPlayerAttrRsp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetFriendJoinGroupRsp.ProtoReflect.Descriptor instead.
+func (*SetFriendJoinGroupRsp) Descriptor() ([]byte, []int) {
 	return file_protos_match_proto_rawDescGZIP(), []int{45}
 }
 
-// -->[START] ExitGame
-type ExitGameReq struct {
+// --->[START] SetWorldChannelJoinGroup
+type SetWorldChannelJoinGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid    string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	RoomId int64  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Allow bool   `protobuf:"varint,2,opt,name=allow,proto3" json:"allow,omitempty"`
 }
 
-func (x *ExitGameReq) Reset() {
-	*x = ExitGameReq{}
+func (x *SetWorldChannelJoinGroupReq) Reset() {
+	*x = SetWorldChannelJoinGroupReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_match_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2618,13 +2856,13 @@ func (x *ExitGameReq) Reset() {
 	}
 }
 
-func (x *ExitGameReq) String() string {
+func (x *SetWorldChannelJoinGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExitGameReq) ProtoMessage() {}
+func (*SetWorldChannelJoinGroupReq) ProtoMessage() {}
 
-func (x *ExitGameReq) ProtoReflect() protoreflect.Message {
+func (x *SetWorldChannelJoinGroupReq) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_match_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2636,33 +2874,33 @@ func (x *ExitGameReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExitGameReq.ProtoReflect.Descriptor instead.
-func (*ExitGameReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetWorldChannelJoinGroupReq.ProtoReflect.Descriptor instead.
+func (*SetWorldChannelJoinGroupReq) Descriptor() ([]byte, []int) {
 	return file_protos_match_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ExitGameReq) GetUid() string {
+func (x *SetWorldChannelJoinGroupReq) GetUid() string {
 	if x != nil {
 		return x.Uid
 	}
 	return ""
 }
 
-func (x *ExitGameReq) GetRoomId() int64 {
+func (x *SetWorldChannelJoinGroupReq) GetAllow() bool {
 	if x != nil {
-		return x.RoomId
+		return x.Allow
 	}
-	return 0
+	return false
 }
 
-type ExitGameRsp struct {
+type SetWorldChannelJoinGroupRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ExitGameRsp) Reset() {
-	*x = ExitGameRsp{}
+func (x *SetWorldChannelJoinGroupRsp) Reset() {
+	*x = SetWorldChannelJoinGroupRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_match_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2670,397 +2908,3500 @@ func (x *ExitGameRsp) Reset() {
 	}
 }
 
-func (x *ExitGameRsp) String() string {
+func (x *SetWorldChannelJoinGroupRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExitGameRsp) ProtoMessage() {}
+func (*SetWorldChannelJoinGroupRsp) ProtoMessage() {}
 
-func (x *ExitGameRsp) ProtoReflect() protoreflect.Message {
+func (x *SetWorldChannelJoinGroupRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_match_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ExitGameRsp.ProtoReflect.Descriptor instead.
-func (*ExitGameRsp) Descriptor() ([]byte, []int) {
-	return file_protos_match_proto_rawDescGZIP(), []int{47}
-}
-
-var File_protos_match_proto protoreflect.FileDescriptor
-
-var file_protos_match_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x01,
-	0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x29,
-	0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
-	0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x64,
-	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x61, 0x72,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x32, 0x0a, 0x0c, 0x67, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x32, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
-	0x47, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x32, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x67, 0x6c, 0x69,
-	0x63, 0x6b, 0x6f, 0x32, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xb2, 0x02, 0x0a, 0x07, 0x42, 0x69, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x6f, 0x74, 0x66, 0x69, 0x78, 0x5f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x6f, 0x74,
-	0x66, 0x69, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x70,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x42, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x65,
-	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x2e, 0x4d, 0x6f, 0x64,
-	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c,
-	0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x3f, 0x0a, 0x11,
-	0x4d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x01,
-	0x0a, 0x07, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x0c, 0x6f, 0x6e, 0x6c,
-	0x69, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x2c, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22,
-	0xe4, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a,
-	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74,
-	0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x61,
-	0x69, 0x6e, 0x12, 0x29, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d,
-	0x6f, 0x64, 0x65, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x08, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36,
-	0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65,
-	0x12, 0x38, 0x0a, 0x0c, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x6f,
-	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0xac, 0x02, 0x0a, 0x09, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x29,
-	0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
-	0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x64,
-	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x12,
-	0x27, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x10, 0x67, 0x61, 0x6d, 0x65,
-	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x57, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54,
-	0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64,
-	0x12, 0x2d, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22,
-	0x65, 0x0a, 0x0f, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
-	0x25, 0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x22, 0x65, 0x0a, 0x0e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x57, 0x0a,
-	0x0d, 0x55, 0x73, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76,
-	0x61, 0x74, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74,
-	0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x73, 0x74, 0x61, 0x72, 0x22, 0x41, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x2f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x47, 0x0a, 0x0b, 0x47, 0x6c, 0x69,
-	0x63, 0x6b, 0x6f, 0x32, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x6d, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x6d, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74,
-	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x61, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61,
-	0x6e, 0x6b, 0x22, 0x2b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x52, 0x73, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22,
-	0x89, 0x01, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x12, 0x2f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x45,
-	0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x20, 0x0a, 0x0c,
-	0x45, 0x78, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x0e,
-	0x0a, 0x0c, 0x45, 0x78, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x24,
-	0x0a, 0x10, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x69, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x4d, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72,
-	0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x65, 0x55, 0x69, 0x64, 0x22, 0x0b, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x52, 0x73, 0x70, 0x22, 0x80, 0x01, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x73, 0x70, 0x22, 0x8d, 0x01, 0x0a, 0x0f, 0x52,
-	0x65, 0x66, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1f,
-	0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x55, 0x69, 0x64,
-	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
-	0x65, 0x66, 0x75, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x72, 0x65, 0x66, 0x75, 0x73, 0x65, 0x4d, 0x73, 0x67, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65,
-	0x66, 0x75, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x73, 0x70, 0x22, 0x4f, 0x0a,
-	0x0d, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1f,
-	0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x55, 0x69, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x69, 0x64, 0x22, 0x0f,
-	0x0a, 0x0d, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x73, 0x70, 0x22,
-	0x72, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x55, 0x69,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64,
-	0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
-	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
-	0x6f, 0x6c, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x6f, 0x6c,
-	0x65, 0x52, 0x73, 0x70, 0x22, 0x3f, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x62,
-	0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72,
-	0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x3f,
-	0x0a, 0x15, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22,
-	0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x69, 0x6e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x50, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x56,
-	0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x2a,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65,
-	0x74, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x73, 0x70, 0x22, 0x1c,
-	0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x0a, 0x0a, 0x08,
-	0x52, 0x65, 0x61, 0x64, 0x79, 0x52, 0x73, 0x70, 0x22, 0x1e, 0x0a, 0x0a, 0x55, 0x6e, 0x72, 0x65,
-	0x61, 0x64, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x0c, 0x0a, 0x0a, 0x55, 0x6e, 0x72, 0x65,
-	0x61, 0x64, 0x79, 0x52, 0x73, 0x70, 0x22, 0x21, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x22, 0x0a, 0x0e, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x10,
-	0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70,
-	0x22, 0x95, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x41, 0x74, 0x74, 0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x61, 0x74,
-	0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x04, 0x61, 0x74, 0x74,
-	0x72, 0x12, 0x3d, 0x0a, 0x0e, 0x67, 0x6f, 0x61, 0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x61,
-	0x74, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47,
-	0x6f, 0x61, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x48, 0x00, 0x52, 0x0c, 0x67, 0x6f, 0x61, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x74, 0x72,
-	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x25, 0x0a, 0x11, 0x47, 0x6f, 0x61, 0x74,
-	0x47, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x6d, 0x6d, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x6d, 0x72, 0x22,
-	0x15, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41,
-	0x74, 0x74, 0x72, 0x52, 0x73, 0x70, 0x22, 0x38, 0x0a, 0x0b, 0x45, 0x78, 0x69, 0x74, 0x47, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64,
-	0x22, 0x0d, 0x0a, 0x0b, 0x45, 0x78, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x73, 0x70, 0x2a,
-	0xfb, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52,
-	0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54,
-	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x54, 0x45, 0x52,
-	0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x45,
-	0x41, 0x52, 0x42, 0x59, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f,
-	0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x43,
-	0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x47,
-	0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x52, 0x49, 0x45,
-	0x4e, 0x44, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52,
-	0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4c, 0x44,
-	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x4e,
-	0x54, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
-	0x5f, 0x43, 0x4c, 0x41, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x05, 0x12,
-	0x1c, 0x0a, 0x18, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53,
-	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10, 0x06, 0x2a, 0x3a, 0x0a,
-	0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x52,
-	0x4f, 0x55, 0x50, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10,
-	0x00, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
-	0x43, 0x41, 0x50, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x42, 0x0d, 0x5a, 0x0b, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_protos_match_proto_rawDescOnce sync.Once
-	file_protos_match_proto_rawDescData = file_protos_match_proto_rawDesc
-)
-
-func file_protos_match_proto_rawDescGZIP() []byte {
-	file_protos_match_proto_rawDescOnce.Do(func() {
-		file_protos_match_proto_rawDescData = protoimpl.X.CompressGZIP(file_protos_match_proto_rawDescData)
-	})
-	return file_protos_match_proto_rawDescData
-}
-
-var file_protos_match_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_protos_match_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
-var file_protos_match_proto_goTypes = []interface{}{
-	(EnterGroupSource)(0),         // 0: pb.EnterGroupSource
-	(GroupRole)(0),                // 1: pb.GroupRole
-	(*PlayerInfo)(nil),            // 2: pb.PlayerInfo
-	(*BindReq)(nil),               // 3: pb.BindReq
-	(*BindRsp)(nil),               // 4: pb.BindRsp
-	(*GroupInfo)(nil),             // 5: pb.GroupInfo
-	(*GroupPlayerInfo)(nil),       // 6: pb.GroupPlayerInfo
-	(*MatchInfo)(nil),             // 7: pb.MatchInfo
-	(*MatchTeamInfo)(nil),         // 8: pb.MatchTeamInfo
-	(*MatchPlayerInfo)(nil),       // 9: pb.MatchPlayerInfo
-	(*GameServerInfo)(nil),        // 10: pb.GameServerInfo
-	(*UserAttribute)(nil),         // 11: pb.UserAttribute
-	(*CreateGroupReq)(nil),        // 12: pb.CreateGroupReq
-	(*Glicko2Info)(nil),           // 13: pb.Glicko2Info
-	(*CreateGroupRsp)(nil),        // 14: pb.CreateGroupRsp
-	(*EnterGroupReq)(nil),         // 15: pb.EnterGroupReq
-	(*EnterGroupRsp)(nil),         // 16: pb.EnterGroupRsp
-	(*ExitGroupReq)(nil),          // 17: pb.ExitGroupReq
-	(*ExitGroupRsp)(nil),          // 18: pb.ExitGroupRsp
-	(*DissolveGroupReq)(nil),      // 19: pb.DissolveGroupReq
-	(*DissolveGroupRsp)(nil),      // 20: pb.DissolveGroupRsp
-	(*InviteReq)(nil),             // 21: pb.InviteReq
-	(*InviteRsp)(nil),             // 22: pb.InviteRsp
-	(*AcceptInviteReq)(nil),       // 23: pb.AcceptInviteReq
-	(*AcceptInviteRsp)(nil),       // 24: pb.AcceptInviteRsp
-	(*RefuseInviteReq)(nil),       // 25: pb.RefuseInviteReq
-	(*RefuseInviteRsp)(nil),       // 26: pb.RefuseInviteRsp
-	(*KickPlayerReq)(nil),         // 27: pb.KickPlayerReq
-	(*KickPlayerRsp)(nil),         // 28: pb.KickPlayerRsp
-	(*ChangeRoleReq)(nil),         // 29: pb.ChangeRoleReq
-	(*ChangeRoleRsp)(nil),         // 30: pb.ChangeRoleRsp
-	(*SetNearbyJoinGroupReq)(nil), // 31: pb.SetNearbyJoinGroupReq
-	(*SetNearbyJoinGroupRsp)(nil), // 32: pb.SetNearbyJoinGroupRsp
-	(*SetRecentJoinGroupReq)(nil), // 33: pb.SetRecentJoinGroupReq
-	(*SetRecentJoinGroupRsp)(nil), // 34: pb.SetRecentJoinGroupRsp
-	(*SetVoiceStateReq)(nil),      // 35: pb.SetVoiceStateReq
-	(*SetVoiceStateRsp)(nil),      // 36: pb.SetVoiceStateRsp
-	(*ReadyReq)(nil),              // 37: pb.ReadyReq
-	(*ReadyRsp)(nil),              // 38: pb.ReadyRsp
-	(*UnreadyReq)(nil),            // 39: pb.UnreadyReq
-	(*UnreadyRsp)(nil),            // 40: pb.UnreadyRsp
-	(*StartMatchReq)(nil),         // 41: pb.StartMatchReq
-	(*StartMatchRsp)(nil),         // 42: pb.StartMatchRsp
-	(*CancelMatchReq)(nil),        // 43: pb.CancelMatchReq
-	(*CancelMatchRsp)(nil),        // 44: pb.CancelMatchRsp
-	(*UploadPlayerAttrReq)(nil),   // 45: pb.UploadPlayerAttrReq
-	(*GoatGameAttribute)(nil),     // 46: pb.GoatGameAttribute
-	(*UploadPlayerAttrRsp)(nil),   // 47: pb.UploadPlayerAttrRsp
-	(*ExitGameReq)(nil),           // 48: pb.ExitGameReq
-	(*ExitGameRsp)(nil),           // 49: pb.ExitGameRsp
-	nil,                           // 50: pb.BindReq.ModeVersionsEntry
-	(GameMode)(0),                 // 51: pb.GameMode
-	(PlayerOnlineState)(0),        // 52: pb.PlayerOnlineState
-	(PlayerVoiceState)(0),         // 53: pb.PlayerVoiceState
-	(NetProtocol)(0),              // 54: pb.NetProtocol
-}
-var file_protos_match_proto_depIdxs = []int32{
-	51, // 0: pb.PlayerInfo.game_mode:type_name -> pb.GameMode
-	13, // 1: pb.PlayerInfo.glicko2_info:type_name -> pb.Glicko2Info
-	50, // 2: pb.BindReq.mode_versions:type_name -> pb.BindReq.ModeVersionsEntry
-	52, // 3: pb.BindRsp.online_state:type_name -> pb.PlayerOnlineState
-	5,  // 4: pb.BindRsp.group_info:type_name -> pb.GroupInfo
-	7,  // 5: pb.BindRsp.match_info:type_name -> pb.MatchInfo
-	51, // 6: pb.GroupInfo.game_mode:type_name -> pb.GameMode
-	6,  // 7: pb.GroupInfo.player_infos:type_name -> pb.GroupPlayerInfo
-	52, // 8: pb.GroupPlayerInfo.online_state:type_name -> pb.PlayerOnlineState
-	53, // 9: pb.GroupPlayerInfo.voice_state:type_name -> pb.PlayerVoiceState
-	51, // 10: pb.MatchInfo.game_mode:type_name -> pb.GameMode
-	8,  // 11: pb.MatchInfo.teams:type_name -> pb.MatchTeamInfo
-	10, // 12: pb.MatchInfo.game_server_info:type_name -> pb.GameServerInfo
-	9,  // 13: pb.MatchTeamInfo.players:type_name -> pb.MatchPlayerInfo
-	11, // 14: pb.MatchPlayerInfo.attr:type_name -> pb.UserAttribute
-	54, // 15: pb.GameServerInfo.protocol:type_name -> pb.NetProtocol
-	2,  // 16: pb.CreateGroupReq.player_info:type_name -> pb.PlayerInfo
-	2,  // 17: pb.EnterGroupReq.player_info:type_name -> pb.PlayerInfo
-	0,  // 18: pb.EnterGroupReq.source:type_name -> pb.EnterGroupSource
-	2,  // 19: pb.AcceptInviteReq.invitee_info:type_name -> pb.PlayerInfo
-	1,  // 20: pb.ChangeRoleReq.role:type_name -> pb.GroupRole
-	53, // 21: pb.SetVoiceStateReq.state:type_name -> pb.PlayerVoiceState
-	11, // 22: pb.UploadPlayerAttrReq.attr:type_name -> pb.UserAttribute
-	46, // 23: pb.UploadPlayerAttrReq.goat_game_attr:type_name -> pb.GoatGameAttribute
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
-}
-
-func init() { file_protos_match_proto_init() }
-func file_protos_match_proto_init() {
-	if File_protos_match_proto != nil {
-		return
-	}
-	file_protos_common_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_protos_match_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerInfo); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWorldChannelJoinGroupRsp.ProtoReflect.Descriptor instead.
+func (*SetWorldChannelJoinGroupRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{47}
+}
+
+// --->[START] SetClanChannelJoinGroup
+type SetClanChannelJoinGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Allow bool   `protobuf:"varint,2,opt,name=allow,proto3" json:"allow,omitempty"`
+}
+
+func (x *SetClanChannelJoinGroupReq) Reset() {
+	*x = SetClanChannelJoinGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetClanChannelJoinGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClanChannelJoinGroupReq) ProtoMessage() {}
+
+func (x *SetClanChannelJoinGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClanChannelJoinGroupReq.ProtoReflect.Descriptor instead.
+func (*SetClanChannelJoinGroupReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetClanChannelJoinGroupReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SetClanChannelJoinGroupReq) GetAllow() bool {
+	if x != nil {
+		return x.Allow
+	}
+	return false
+}
+
+type SetClanChannelJoinGroupRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetClanChannelJoinGroupRsp) Reset() {
+	*x = SetClanChannelJoinGroupRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetClanChannelJoinGroupRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClanChannelJoinGroupRsp) ProtoMessage() {}
+
+func (x *SetClanChannelJoinGroupRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClanChannelJoinGroupRsp.ProtoReflect.Descriptor instead.
+func (*SetClanChannelJoinGroupRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{49}
+}
+
+// --->[START] ShareGroup
+type ShareGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *ShareGroupReq) Reset() {
+	*x = ShareGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareGroupReq) ProtoMessage() {}
+
+func (x *ShareGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareGroupReq.ProtoReflect.Descriptor instead.
+func (*ShareGroupReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ShareGroupReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type ShareGroupRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpireAt int64  `protobuf:"varint,2,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+}
+
+func (x *ShareGroupRsp) Reset() {
+	*x = ShareGroupRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareGroupRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareGroupRsp) ProtoMessage() {}
+
+func (x *ShareGroupRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareGroupRsp.ProtoReflect.Descriptor instead.
+func (*ShareGroupRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ShareGroupRsp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareGroupRsp) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+// --->[START] ReportLocation
+type ReportLocationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid       string  `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *ReportLocationReq) Reset() {
+	*x = ReportLocationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportLocationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLocationReq) ProtoMessage() {}
+
+func (x *ReportLocationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLocationReq.ProtoReflect.Descriptor instead.
+func (*ReportLocationReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ReportLocationReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ReportLocationReq) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ReportLocationReq) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type ReportLocationRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReportLocationRsp) Reset() {
+	*x = ReportLocationRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportLocationRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLocationRsp) ProtoMessage() {}
+
+func (x *ReportLocationRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportLocationRsp.ProtoReflect.Descriptor instead.
+func (*ReportLocationRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{53}
+}
+
+// --->[START] NearbyGroups
+type NearbyGroupsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *NearbyGroupsReq) Reset() {
+	*x = NearbyGroupsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearbyGroupsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyGroupsReq) ProtoMessage() {}
+
+func (x *NearbyGroupsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyGroupsReq.ProtoReflect.Descriptor instead.
+func (*NearbyGroupsReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *NearbyGroupsReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type NearbyGroupsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*JoinableGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *NearbyGroupsRsp) Reset() {
+	*x = NearbyGroupsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NearbyGroupsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyGroupsRsp) ProtoMessage() {}
+
+func (x *NearbyGroupsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyGroupsRsp.ProtoReflect.Descriptor instead.
+func (*NearbyGroupsRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *NearbyGroupsRsp) GetGroups() []*JoinableGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// --->[START] RecentGroups
+type RecentGroupsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *RecentGroupsReq) Reset() {
+	*x = RecentGroupsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecentGroupsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecentGroupsReq) ProtoMessage() {}
+
+func (x *RecentGroupsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecentGroupsReq.ProtoReflect.Descriptor instead.
+func (*RecentGroupsReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RecentGroupsReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type RecentGroupsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*JoinableGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *RecentGroupsRsp) Reset() {
+	*x = RecentGroupsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecentGroupsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecentGroupsRsp) ProtoMessage() {}
+
+func (x *RecentGroupsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecentGroupsRsp.ProtoReflect.Descriptor instead.
+func (*RecentGroupsRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RecentGroupsRsp) GetGroups() []*JoinableGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// --->[START] SetVoiceState
+type SetVoiceStateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   string           `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	State PlayerVoiceState `protobuf:"varint,2,opt,name=state,proto3,enum=pb.PlayerVoiceState" json:"state,omitempty"`
+}
+
+func (x *SetVoiceStateReq) Reset() {
+	*x = SetVoiceStateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVoiceStateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVoiceStateReq) ProtoMessage() {}
+
+func (x *SetVoiceStateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVoiceStateReq.ProtoReflect.Descriptor instead.
+func (*SetVoiceStateReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetVoiceStateReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SetVoiceStateReq) GetState() PlayerVoiceState {
+	if x != nil {
+		return x.State
+	}
+	return PlayerVoiceState_PLAYER_VOICE_STATE_MUTE
+}
+
+type SetVoiceStateRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetVoiceStateRsp) Reset() {
+	*x = SetVoiceStateRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVoiceStateRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVoiceStateRsp) ProtoMessage() {}
+
+func (x *SetVoiceStateRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVoiceStateRsp.ProtoReflect.Descriptor instead.
+func (*SetVoiceStateRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{59}
+}
+
+// --->[START] SendGroupMessage
+type SendGroupMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid     string           `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Type    GroupMessageType `protobuf:"varint,2,opt,name=type,proto3,enum=pb.GroupMessageType" json:"type,omitempty"`
+	Content string           `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *SendGroupMessageReq) Reset() {
+	*x = SendGroupMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendGroupMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendGroupMessageReq) ProtoMessage() {}
+
+func (x *SendGroupMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendGroupMessageReq.ProtoReflect.Descriptor instead.
+func (*SendGroupMessageReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SendGroupMessageReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SendGroupMessageReq) GetType() GroupMessageType {
+	if x != nil {
+		return x.Type
+	}
+	return GroupMessageType_GROUP_MESSAGE_TYPE_UNKNOWN
+}
+
+func (x *SendGroupMessageReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type SendGroupMessageRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendGroupMessageRsp) Reset() {
+	*x = SendGroupMessageRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendGroupMessageRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendGroupMessageRsp) ProtoMessage() {}
+
+func (x *SendGroupMessageRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendGroupMessageRsp.ProtoReflect.Descriptor instead.
+func (*SendGroupMessageRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{61}
+}
+
+// --->[START] Ready
+type ReadyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *ReadyReq) Reset() {
+	*x = ReadyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyReq) ProtoMessage() {}
+
+func (x *ReadyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyReq.ProtoReflect.Descriptor instead.
+func (*ReadyReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ReadyReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type ReadyRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReadyRsp) Reset() {
+	*x = ReadyRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyRsp) ProtoMessage() {}
+
+func (x *ReadyRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyRsp.ProtoReflect.Descriptor instead.
+func (*ReadyRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{63}
+}
+
+// --->[START] Unready
+type UnreadyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *UnreadyReq) Reset() {
+	*x = UnreadyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnreadyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadyReq) ProtoMessage() {}
+
+func (x *UnreadyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadyReq.ProtoReflect.Descriptor instead.
+func (*UnreadyReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UnreadyReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type UnreadyRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnreadyRsp) Reset() {
+	*x = UnreadyRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnreadyRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnreadyRsp) ProtoMessage() {}
+
+func (x *UnreadyRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnreadyRsp.ProtoReflect.Descriptor instead.
+func (*UnreadyRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{65}
+}
+
+// --->[START] StartMatch
+type StartMatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *StartMatchReq) Reset() {
+	*x = StartMatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMatchReq) ProtoMessage() {}
+
+func (x *StartMatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMatchReq.ProtoReflect.Descriptor instead.
+func (*StartMatchReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StartMatchReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type StartMatchRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartMatchRsp) Reset() {
+	*x = StartMatchRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartMatchRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMatchRsp) ProtoMessage() {}
+
+func (x *StartMatchRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMatchRsp.ProtoReflect.Descriptor instead.
+func (*StartMatchRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{67}
+}
+
+// --->[START] CancelMatch
+type CancelMatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *CancelMatchReq) Reset() {
+	*x = CancelMatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMatchReq) ProtoMessage() {}
+
+func (x *CancelMatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMatchReq.ProtoReflect.Descriptor instead.
+func (*CancelMatchReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CancelMatchReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+type CancelMatchRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelMatchRsp) Reset() {
+	*x = CancelMatchRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMatchRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMatchRsp) ProtoMessage() {}
+
+func (x *CancelMatchRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMatchRsp.ProtoReflect.Descriptor instead.
+func (*CancelMatchRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{69}
+}
+
+// --->[START] UploadPlayerAttr
+type UploadPlayerAttrReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid  string         `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Attr *UserAttribute `protobuf:"bytes,2,opt,name=attr,proto3" json:"attr,omitempty"`
+	// Types that are assignable to Type:
+	//
+	//	*UploadPlayerAttrReq_GoatGameAttr
+	Type isUploadPlayerAttrReq_Type `protobuf_oneof:"type"`
+}
+
+func (x *UploadPlayerAttrReq) Reset() {
+	*x = UploadPlayerAttrReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadPlayerAttrReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPlayerAttrReq) ProtoMessage() {}
+
+func (x *UploadPlayerAttrReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPlayerAttrReq.ProtoReflect.Descriptor instead.
+func (*UploadPlayerAttrReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UploadPlayerAttrReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *UploadPlayerAttrReq) GetAttr() *UserAttribute {
+	if x != nil {
+		return x.Attr
+	}
+	return nil
+}
+
+func (m *UploadPlayerAttrReq) GetType() isUploadPlayerAttrReq_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *UploadPlayerAttrReq) GetGoatGameAttr() *GoatGameAttribute {
+	if x, ok := x.GetType().(*UploadPlayerAttrReq_GoatGameAttr); ok {
+		return x.GoatGameAttr
+	}
+	return nil
+}
+
+type isUploadPlayerAttrReq_Type interface {
+	isUploadPlayerAttrReq_Type()
+}
+
+type UploadPlayerAttrReq_GoatGameAttr struct {
+	GoatGameAttr *GoatGameAttribute `protobuf:"bytes,3,opt,name=goat_game_attr,json=goatGameAttr,proto3,oneof"`
+}
+
+func (*UploadPlayerAttrReq_GoatGameAttr) isUploadPlayerAttrReq_Type() {}
+
+type GoatGameAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mmr           float64 `protobuf:"fixed64,1,opt,name=mmr,proto3" json:"mmr,omitempty"`
+	TotalPvpCount int64   `protobuf:"varint,2,opt,name=total_pvp_count,json=totalPvpCount,proto3" json:"total_pvp_count,omitempty"`
+}
+
+func (x *GoatGameAttribute) Reset() {
+	*x = GoatGameAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GoatGameAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoatGameAttribute) ProtoMessage() {}
+
+func (x *GoatGameAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoatGameAttribute.ProtoReflect.Descriptor instead.
+func (*GoatGameAttribute) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GoatGameAttribute) GetMmr() float64 {
+	if x != nil {
+		return x.Mmr
+	}
+	return 0
+}
+
+func (x *GoatGameAttribute) GetTotalPvpCount() int64 {
+	if x != nil {
+		return x.TotalPvpCount
+	}
+	return 0
+}
+
+type UploadPlayerAttrRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UploadPlayerAttrRsp) Reset() {
+	*x = UploadPlayerAttrRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadPlayerAttrRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPlayerAttrRsp) ProtoMessage() {}
+
+func (x *UploadPlayerAttrRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPlayerAttrRsp.ProtoReflect.Descriptor instead.
+func (*UploadPlayerAttrRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{72}
+}
+
+// -->[START] ExitGame
+type ExitGameReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid    string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RoomId int64  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *ExitGameReq) Reset() {
+	*x = ExitGameReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitGameReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitGameReq) ProtoMessage() {}
+
+func (x *ExitGameReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitGameReq.ProtoReflect.Descriptor instead.
+func (*ExitGameReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ExitGameReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ExitGameReq) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type ExitGameRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExitGameRsp) Reset() {
+	*x = ExitGameRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitGameRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitGameRsp) ProtoMessage() {}
+
+func (x *ExitGameRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitGameRsp.ProtoReflect.Descriptor instead.
+func (*ExitGameRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{74}
+}
+
+// -->[START] OpenBackfill
+type OpenBackfillReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId int64 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	TeamId int64 `protobuf:"varint,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Slots  int64 `protobuf:"varint,3,opt,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *OpenBackfillReq) Reset() {
+	*x = OpenBackfillReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenBackfillReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenBackfillReq) ProtoMessage() {}
+
+func (x *OpenBackfillReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenBackfillReq.ProtoReflect.Descriptor instead.
+func (*OpenBackfillReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *OpenBackfillReq) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *OpenBackfillReq) GetTeamId() int64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *OpenBackfillReq) GetSlots() int64 {
+	if x != nil {
+		return x.Slots
+	}
+	return 0
+}
+
+type OpenBackfillRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OpenBackfillRsp) Reset() {
+	*x = OpenBackfillRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenBackfillRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenBackfillRsp) ProtoMessage() {}
+
+func (x *OpenBackfillRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenBackfillRsp.ProtoReflect.Descriptor instead.
+func (*OpenBackfillRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{76}
+}
+
+// -->[START] AcceptMatch
+type AcceptMatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid    string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RoomId int64  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *AcceptMatchReq) Reset() {
+	*x = AcceptMatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptMatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptMatchReq) ProtoMessage() {}
+
+func (x *AcceptMatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptMatchReq.ProtoReflect.Descriptor instead.
+func (*AcceptMatchReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AcceptMatchReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *AcceptMatchReq) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type AcceptMatchRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AcceptMatchRsp) Reset() {
+	*x = AcceptMatchRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptMatchRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptMatchRsp) ProtoMessage() {}
+
+func (x *AcceptMatchRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptMatchRsp.ProtoReflect.Descriptor instead.
+func (*AcceptMatchRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{78}
+}
+
+// -->[START] DeclineMatch
+type DeclineMatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid    string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RoomId int64  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *DeclineMatchReq) Reset() {
+	*x = DeclineMatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineMatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineMatchReq) ProtoMessage() {}
+
+func (x *DeclineMatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineMatchReq.ProtoReflect.Descriptor instead.
+func (*DeclineMatchReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeclineMatchReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *DeclineMatchReq) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type DeclineMatchRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeclineMatchRsp) Reset() {
+	*x = DeclineMatchRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeclineMatchRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineMatchRsp) ProtoMessage() {}
+
+func (x *DeclineMatchRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineMatchRsp.ProtoReflect.Descriptor instead.
+func (*DeclineMatchRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{80}
+}
+
+// -->[START] Spectate
+type SpectateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid    string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RoomId int64  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *SpectateReq) Reset() {
+	*x = SpectateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpectateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateReq) ProtoMessage() {}
+
+func (x *SpectateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateReq.ProtoReflect.Descriptor instead.
+func (*SpectateReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SpectateReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *SpectateReq) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type SpectateRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SpectateRsp) Reset() {
+	*x = SpectateRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpectateRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRsp) ProtoMessage() {}
+
+func (x *SpectateRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRsp.ProtoReflect.Descriptor instead.
+func (*SpectateRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{82}
+}
+
+// -->[START] ExitSpectate
+type ExitSpectateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid    string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RoomId int64  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *ExitSpectateReq) Reset() {
+	*x = ExitSpectateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitSpectateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitSpectateReq) ProtoMessage() {}
+
+func (x *ExitSpectateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitSpectateReq.ProtoReflect.Descriptor instead.
+func (*ExitSpectateReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ExitSpectateReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ExitSpectateReq) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type ExitSpectateRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExitSpectateRsp) Reset() {
+	*x = ExitSpectateRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitSpectateRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitSpectateRsp) ProtoMessage() {}
+
+func (x *ExitSpectateRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitSpectateRsp.ProtoReflect.Descriptor instead.
+func (*ExitSpectateRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{84}
+}
+
+// -->[START] CreateCustomRoom
+type CreateCustomRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid             string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	TeamLimit       int32  `protobuf:"varint,2,opt,name=team_limit,json=teamLimit,proto3" json:"team_limit,omitempty"`
+	TeamPlayerLimit int32  `protobuf:"varint,3,opt,name=team_player_limit,json=teamPlayerLimit,proto3" json:"team_player_limit,omitempty"`
+	Password        string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *CreateCustomRoomReq) Reset() {
+	*x = CreateCustomRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCustomRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomRoomReq) ProtoMessage() {}
+
+func (x *CreateCustomRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomRoomReq.ProtoReflect.Descriptor instead.
+func (*CreateCustomRoomReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateCustomRoomReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CreateCustomRoomReq) GetTeamLimit() int32 {
+	if x != nil {
+		return x.TeamLimit
+	}
+	return 0
+}
+
+func (x *CreateCustomRoomReq) GetTeamPlayerLimit() int32 {
+	if x != nil {
+		return x.TeamPlayerLimit
+	}
+	return 0
+}
+
+func (x *CreateCustomRoomReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type CreateCustomRoomRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId int64  `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *CreateCustomRoomRsp) Reset() {
+	*x = CreateCustomRoomRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCustomRoomRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomRoomRsp) ProtoMessage() {}
+
+func (x *CreateCustomRoomRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomRoomRsp.ProtoReflect.Descriptor instead.
+func (*CreateCustomRoomRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateCustomRoomRsp) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *CreateCustomRoomRsp) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// -->[START] JoinCustomRoom
+type JoinCustomRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid      string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Code     string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *JoinCustomRoomReq) Reset() {
+	*x = JoinCustomRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinCustomRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinCustomRoomReq) ProtoMessage() {}
+
+func (x *JoinCustomRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinCustomRoomReq.ProtoReflect.Descriptor instead.
+func (*JoinCustomRoomReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *JoinCustomRoomReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *JoinCustomRoomReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *JoinCustomRoomReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type JoinCustomRoomRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *JoinCustomRoomRsp) Reset() {
+	*x = JoinCustomRoomRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinCustomRoomRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinCustomRoomRsp) ProtoMessage() {}
+
+func (x *JoinCustomRoomRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinCustomRoomRsp.ProtoReflect.Descriptor instead.
+func (*JoinCustomRoomRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{88}
+}
+
+// -->[START] LeaveCustomRoom
+type LeaveCustomRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid    string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RoomId int64  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *LeaveCustomRoomReq) Reset() {
+	*x = LeaveCustomRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveCustomRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveCustomRoomReq) ProtoMessage() {}
+
+func (x *LeaveCustomRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveCustomRoomReq.ProtoReflect.Descriptor instead.
+func (*LeaveCustomRoomReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *LeaveCustomRoomReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *LeaveCustomRoomReq) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type LeaveCustomRoomRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LeaveCustomRoomRsp) Reset() {
+	*x = LeaveCustomRoomRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveCustomRoomRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveCustomRoomRsp) ProtoMessage() {}
+
+func (x *LeaveCustomRoomRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveCustomRoomRsp.ProtoReflect.Descriptor instead.
+func (*LeaveCustomRoomRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{90}
+}
+
+// -->[START] MoveCustomRoomPlayer
+type MoveCustomRoomPlayerReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid       string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	TargetUid string `protobuf:"bytes,2,opt,name=target_uid,json=targetUid,proto3" json:"target_uid,omitempty"`
+	RoomId    int64  `protobuf:"varint,3,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Slot      int32  `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`
+}
+
+func (x *MoveCustomRoomPlayerReq) Reset() {
+	*x = MoveCustomRoomPlayerReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveCustomRoomPlayerReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCustomRoomPlayerReq) ProtoMessage() {}
+
+func (x *MoveCustomRoomPlayerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCustomRoomPlayerReq.ProtoReflect.Descriptor instead.
+func (*MoveCustomRoomPlayerReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MoveCustomRoomPlayerReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *MoveCustomRoomPlayerReq) GetTargetUid() string {
+	if x != nil {
+		return x.TargetUid
+	}
+	return ""
+}
+
+func (x *MoveCustomRoomPlayerReq) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *MoveCustomRoomPlayerReq) GetSlot() int32 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+type MoveCustomRoomPlayerRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MoveCustomRoomPlayerRsp) Reset() {
+	*x = MoveCustomRoomPlayerRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveCustomRoomPlayerRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCustomRoomPlayerRsp) ProtoMessage() {}
+
+func (x *MoveCustomRoomPlayerRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCustomRoomPlayerRsp.ProtoReflect.Descriptor instead.
+func (*MoveCustomRoomPlayerRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{92}
+}
+
+// -->[START] StartCustomRoom
+type StartCustomRoomReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid    string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	RoomId int64  `protobuf:"varint,2,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *StartCustomRoomReq) Reset() {
+	*x = StartCustomRoomReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartCustomRoomReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartCustomRoomReq) ProtoMessage() {}
+
+func (x *StartCustomRoomReq) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartCustomRoomReq.ProtoReflect.Descriptor instead.
+func (*StartCustomRoomReq) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *StartCustomRoomReq) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *StartCustomRoomReq) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type StartCustomRoomRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartCustomRoomRsp) Reset() {
+	*x = StartCustomRoomRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_match_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartCustomRoomRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartCustomRoomRsp) ProtoMessage() {}
+
+func (x *StartCustomRoomRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_match_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartCustomRoomRsp.ProtoReflect.Descriptor instead.
+func (*StartCustomRoomRsp) Descriptor() ([]byte, []int) {
+	return file_protos_match_proto_rawDescGZIP(), []int{94}
+}
+
+var File_protos_match_proto protoreflect.FileDescriptor
+
+var file_protos_match_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x02,
+	0x0a, 0x0a, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x29,
+	0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x61, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x32, 0x0a, 0x0c, 0x67, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x32, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e,
+	0x47, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x32, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x67, 0x6c, 0x69,
+	0x63, 0x6b, 0x6f, 0x32, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x50, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x50, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x3e, 0x0a, 0x10,
+	0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x50, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb2, 0x02, 0x0a,
+	0x07, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x6f, 0x74, 0x66, 0x69,
+	0x78, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x68, 0x6f, 0x74, 0x66, 0x69, 0x78, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x42, 0x0a, 0x0d,
+	0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x1a, 0x3f, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x9f, 0x01, 0x0a, 0x07, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x12, 0x38, 0x0a,
+	0x0c, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x22, 0xe4, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x12, 0x29, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x08, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x0b, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35,
+	0x0a, 0x0b, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x56,
+	0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x22, 0xe4, 0x02, 0x0a, 0x09,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x55,
+	0x6e, 0x69, 0x78, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x61,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x10,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x67, 0x61, 0x6d, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x22, 0x57, 0x0a, 0x0d, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54, 0x65, 0x61, 0x6d, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x62, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0xf7, 0x01, 0x0a, 0x0e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2a, 0x0a, 0x11, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x74, 0x65, 0x61, 0x6d,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x38, 0x0a, 0x0e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
+	0x6f, 0x6f, 0x6d, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x69, 0x64, 0x73, 0x22,
+	0xf8, 0x01, 0x0a, 0x0d, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x55, 0x69, 0x64, 0x12, 0x29,
+	0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x64,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x65, 0x63, 0x22, 0xcb, 0x02, 0x0a, 0x0d, 0x4a,
+	0x6f, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x61,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69,
+	0x6e, 0x12, 0x29, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x6b, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4b, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x41, 0x74, 0x53, 0x65, 0x63, 0x22, 0x26, 0x0a, 0x12, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x22, 0x65, 0x0a, 0x0f, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x22, 0x7d, 0x0a, 0x0e, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x74, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x61, 0x72, 0x22,
+	0x41, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x12, 0x2f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0xa6, 0x01, 0x0a, 0x0b, 0x47, 0x6c, 0x69, 0x63, 0x6b, 0x6f, 0x32, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x6d, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x6d, 0x6d, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x74, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02,
+	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x12,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x12, 0x19, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x45, 0x6e, 0x74,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x2f, 0x0a, 0x0b, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x20, 0x0a, 0x0c, 0x45, 0x78, 0x69, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x0e, 0x0a, 0x0c, 0x45, 0x78, 0x69, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x24, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x12,
+	0x0a, 0x10, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x73, 0x70, 0x22, 0x4d, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x69, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x55, 0x69,
+	0x64, 0x22, 0x0b, 0x0a, 0x09, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x73, 0x70, 0x22, 0x80,
+	0x01, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72,
+	0x55, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x64, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x52, 0x73, 0x70, 0x22, 0x8d, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x75, 0x73, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x55, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x75, 0x73, 0x65, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x75, 0x73,
+	0x65, 0x4d, 0x73, 0x67, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x75, 0x73, 0x65, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x52, 0x73, 0x70, 0x22, 0x53, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x55, 0x69, 0x64, 0x22, 0x11, 0x0a, 0x0f,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x73, 0x70, 0x22,
+	0x2a, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x52, 0x73, 0x70, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x73, 0x22, 0x26, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x73, 0x70,
+	0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x22, 0x4f, 0x0a,
+	0x0d, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x55, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x69, 0x64, 0x22, 0x0f,
+	0x0a, 0x0d, 0x4b, 0x69, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x73, 0x70, 0x22,
+	0x72, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x61, 0x69, 0x6e, 0x55, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x73, 0x70, 0x22, 0x3f, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72, 0x62,
+	0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4e, 0x65, 0x61, 0x72,
+	0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x3f,
+	0x0a, 0x15, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22,
+	0x17, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x4a, 0x6f, 0x69, 0x6e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x3f, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x73, 0x70, 0x22, 0x45, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x1d, 0x0a, 0x1b, 0x53, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4a, 0x6f, 0x69, 0x6e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x44, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43,
+	0x6c, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x22, 0x1c,
+	0x0a, 0x1a, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x4a, 0x6f, 0x69, 0x6e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70, 0x22, 0x21, 0x0a, 0x0d,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22,
+	0x42, 0x0a, 0x0d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x73, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x41, 0x74, 0x22, 0x5f, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x22, 0x23, 0x0a, 0x0f, 0x4e, 0x65, 0x61,
+	0x72, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x3c,
+	0x0a, 0x0f, 0x4e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x73,
+	0x70, 0x12, 0x29, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x23, 0x0a, 0x0f,
+	0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x22, 0x3c, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x52, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
+	0x50, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x73, 0x70, 0x22, 0x6b, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x28,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x73, 0x70, 0x22, 0x1c, 0x0a, 0x08, 0x52, 0x65, 0x61,
+	0x64, 0x79, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x0a, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x79,
+	0x52, 0x73, 0x70, 0x22, 0x1e, 0x0a, 0x0a, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x22, 0x0c, 0x0a, 0x0a, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x73,
+	0x70, 0x22, 0x21, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x22, 0x0a, 0x0e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22, 0x95, 0x01, 0x0a, 0x13,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x74, 0x74, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x61, 0x74, 0x74, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x04, 0x61, 0x74, 0x74, 0x72, 0x12, 0x3d, 0x0a, 0x0e,
+	0x67, 0x6f, 0x61, 0x74, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x6f, 0x61, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x67,
+	0x6f, 0x61, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x74, 0x74, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x4d, 0x0a, 0x11, 0x47, 0x6f, 0x61, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x6d, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x6d, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x70, 0x76, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x76, 0x70, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x41, 0x74, 0x74, 0x72, 0x52, 0x73, 0x70, 0x22, 0x38, 0x0a, 0x0b, 0x45, 0x78, 0x69,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f,
+	0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f,
+	0x6d, 0x49, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x45, 0x78, 0x69, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x73, 0x70, 0x22, 0x59, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x11, 0x0a,
+	0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x52, 0x73, 0x70,
+	0x22, 0x3b, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x10, 0x0a,
+	0x0e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70, 0x22,
+	0x3c, 0x0a, 0x0f, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x11, 0x0a,
+	0x0f, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x73, 0x70,
+	0x22, 0x38, 0x0a, 0x0b, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x53, 0x70,
+	0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x73, 0x70, 0x22, 0x3c, 0x0a, 0x0f, 0x45, 0x78, 0x69,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x45, 0x78, 0x69, 0x74, 0x53,
+	0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x73, 0x70, 0x22, 0x8e, 0x01, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x74, 0x65, 0x61, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x42, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x73, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x55, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f,
+	0x6d, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x73, 0x70, 0x22, 0x3f, 0x0a, 0x12, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
+	0x71, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x73, 0x70, 0x22, 0x77, 0x0a, 0x17, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x52, 0x6f, 0x6f, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x4d,
+	0x6f, 0x76, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x52, 0x73, 0x70, 0x22, 0x3f, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x73, 0x70, 0x2a, 0xfb, 0x01,
+	0x0a, 0x10, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x45, 0x41, 0x52,
+	0x42, 0x59, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52,
+	0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x4e,
+	0x54, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x4f,
+	0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x52, 0x49, 0x45, 0x4e, 0x44,
+	0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x4c, 0x44, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x4e, 0x54, 0x45,
+	0x52, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43,
+	0x4c, 0x41, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x05, 0x12, 0x1c, 0x0a,
+	0x18, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10, 0x06, 0x2a, 0x3a, 0x0a, 0x09, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x41,
+	0x50, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x42, 0x0d, 0x5a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protos_match_proto_rawDescOnce sync.Once
+	file_protos_match_proto_rawDescData = file_protos_match_proto_rawDesc
+)
+
+func file_protos_match_proto_rawDescGZIP() []byte {
+	file_protos_match_proto_rawDescOnce.Do(func() {
+		file_protos_match_proto_rawDescData = protoimpl.X.CompressGZIP(file_protos_match_proto_rawDescData)
+	})
+	return file_protos_match_proto_rawDescData
+}
+
+var file_protos_match_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_protos_match_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
+var file_protos_match_proto_goTypes = []interface{}{
+	(EnterGroupSource)(0),               // 0: pb.EnterGroupSource
+	(GroupRole)(0),                      // 1: pb.GroupRole
+	(*PlayerInfo)(nil),                  // 2: pb.PlayerInfo
+	(*BindReq)(nil),                     // 3: pb.BindReq
+	(*BindRsp)(nil),                     // 4: pb.BindRsp
+	(*GroupInfo)(nil),                   // 5: pb.GroupInfo
+	(*GroupPlayerInfo)(nil),             // 6: pb.GroupPlayerInfo
+	(*MatchInfo)(nil),                   // 7: pb.MatchInfo
+	(*MatchTeamInfo)(nil),               // 8: pb.MatchTeamInfo
+	(*CustomRoomInfo)(nil),              // 9: pb.CustomRoomInfo
+	(*CustomRoomTeam)(nil),              // 10: pb.CustomRoomTeam
+	(*PendingInvite)(nil),               // 11: pb.PendingInvite
+	(*JoinableGroup)(nil),               // 12: pb.JoinableGroup
+	(*MatchSpectatorInfo)(nil),          // 13: pb.MatchSpectatorInfo
+	(*MatchPlayerInfo)(nil),             // 14: pb.MatchPlayerInfo
+	(*GameServerInfo)(nil),              // 15: pb.GameServerInfo
+	(*UserAttribute)(nil),               // 16: pb.UserAttribute
+	(*CreateGroupReq)(nil),              // 17: pb.CreateGroupReq
+	(*Glicko2Info)(nil),                 // 18: pb.Glicko2Info
+	(*CreateGroupRsp)(nil),              // 19: pb.CreateGroupRsp
+	(*EnterGroupReq)(nil),               // 20: pb.EnterGroupReq
+	(*EnterGroupRsp)(nil),               // 21: pb.EnterGroupRsp
+	(*ExitGroupReq)(nil),                // 22: pb.ExitGroupReq
+	(*ExitGroupRsp)(nil),                // 23: pb.ExitGroupRsp
+	(*DissolveGroupReq)(nil),            // 24: pb.DissolveGroupReq
+	(*DissolveGroupRsp)(nil),            // 25: pb.DissolveGroupRsp
+	(*InviteReq)(nil),                   // 26: pb.InviteReq
+	(*InviteRsp)(nil),                   // 27: pb.InviteRsp
+	(*AcceptInviteReq)(nil),             // 28: pb.AcceptInviteReq
+	(*AcceptInviteRsp)(nil),             // 29: pb.AcceptInviteRsp
+	(*RefuseInviteReq)(nil),             // 30: pb.RefuseInviteReq
+	(*RefuseInviteRsp)(nil),             // 31: pb.RefuseInviteRsp
+	(*RevokeInviteReq)(nil),             // 32: pb.RevokeInviteReq
+	(*RevokeInviteRsp)(nil),             // 33: pb.RevokeInviteRsp
+	(*ListReceivedInvitesReq)(nil),      // 34: pb.ListReceivedInvitesReq
+	(*ListReceivedInvitesRsp)(nil),      // 35: pb.ListReceivedInvitesRsp
+	(*ListSentInvitesReq)(nil),          // 36: pb.ListSentInvitesReq
+	(*ListSentInvitesRsp)(nil),          // 37: pb.ListSentInvitesRsp
+	(*KickPlayerReq)(nil),               // 38: pb.KickPlayerReq
+	(*KickPlayerRsp)(nil),               // 39: pb.KickPlayerRsp
+	(*ChangeRoleReq)(nil),               // 40: pb.ChangeRoleReq
+	(*ChangeRoleRsp)(nil),               // 41: pb.ChangeRoleRsp
+	(*SetNearbyJoinGroupReq)(nil),       // 42: pb.SetNearbyJoinGroupReq
+	(*SetNearbyJoinGroupRsp)(nil),       // 43: pb.SetNearbyJoinGroupRsp
+	(*SetRecentJoinGroupReq)(nil),       // 44: pb.SetRecentJoinGroupReq
+	(*SetRecentJoinGroupRsp)(nil),       // 45: pb.SetRecentJoinGroupRsp
+	(*SetFriendJoinGroupReq)(nil),       // 46: pb.SetFriendJoinGroupReq
+	(*SetFriendJoinGroupRsp)(nil),       // 47: pb.SetFriendJoinGroupRsp
+	(*SetWorldChannelJoinGroupReq)(nil), // 48: pb.SetWorldChannelJoinGroupReq
+	(*SetWorldChannelJoinGroupRsp)(nil), // 49: pb.SetWorldChannelJoinGroupRsp
+	(*SetClanChannelJoinGroupReq)(nil),  // 50: pb.SetClanChannelJoinGroupReq
+	(*SetClanChannelJoinGroupRsp)(nil),  // 51: pb.SetClanChannelJoinGroupRsp
+	(*ShareGroupReq)(nil),               // 52: pb.ShareGroupReq
+	(*ShareGroupRsp)(nil),               // 53: pb.ShareGroupRsp
+	(*ReportLocationReq)(nil),           // 54: pb.ReportLocationReq
+	(*ReportLocationRsp)(nil),           // 55: pb.ReportLocationRsp
+	(*NearbyGroupsReq)(nil),             // 56: pb.NearbyGroupsReq
+	(*NearbyGroupsRsp)(nil),             // 57: pb.NearbyGroupsRsp
+	(*RecentGroupsReq)(nil),             // 58: pb.RecentGroupsReq
+	(*RecentGroupsRsp)(nil),             // 59: pb.RecentGroupsRsp
+	(*SetVoiceStateReq)(nil),            // 60: pb.SetVoiceStateReq
+	(*SetVoiceStateRsp)(nil),            // 61: pb.SetVoiceStateRsp
+	(*SendGroupMessageReq)(nil),         // 62: pb.SendGroupMessageReq
+	(*SendGroupMessageRsp)(nil),         // 63: pb.SendGroupMessageRsp
+	(*ReadyReq)(nil),                    // 64: pb.ReadyReq
+	(*ReadyRsp)(nil),                    // 65: pb.ReadyRsp
+	(*UnreadyReq)(nil),                  // 66: pb.UnreadyReq
+	(*UnreadyRsp)(nil),                  // 67: pb.UnreadyRsp
+	(*StartMatchReq)(nil),               // 68: pb.StartMatchReq
+	(*StartMatchRsp)(nil),               // 69: pb.StartMatchRsp
+	(*CancelMatchReq)(nil),              // 70: pb.CancelMatchReq
+	(*CancelMatchRsp)(nil),              // 71: pb.CancelMatchRsp
+	(*UploadPlayerAttrReq)(nil),         // 72: pb.UploadPlayerAttrReq
+	(*GoatGameAttribute)(nil),           // 73: pb.GoatGameAttribute
+	(*UploadPlayerAttrRsp)(nil),         // 74: pb.UploadPlayerAttrRsp
+	(*ExitGameReq)(nil),                 // 75: pb.ExitGameReq
+	(*ExitGameRsp)(nil),                 // 76: pb.ExitGameRsp
+	(*OpenBackfillReq)(nil),             // 77: pb.OpenBackfillReq
+	(*OpenBackfillRsp)(nil),             // 78: pb.OpenBackfillRsp
+	(*AcceptMatchReq)(nil),              // 79: pb.AcceptMatchReq
+	(*AcceptMatchRsp)(nil),              // 80: pb.AcceptMatchRsp
+	(*DeclineMatchReq)(nil),             // 81: pb.DeclineMatchReq
+	(*DeclineMatchRsp)(nil),             // 82: pb.DeclineMatchRsp
+	(*SpectateReq)(nil),                 // 83: pb.SpectateReq
+	(*SpectateRsp)(nil),                 // 84: pb.SpectateRsp
+	(*ExitSpectateReq)(nil),             // 85: pb.ExitSpectateReq
+	(*ExitSpectateRsp)(nil),             // 86: pb.ExitSpectateRsp
+	(*CreateCustomRoomReq)(nil),         // 87: pb.CreateCustomRoomReq
+	(*CreateCustomRoomRsp)(nil),         // 88: pb.CreateCustomRoomRsp
+	(*JoinCustomRoomReq)(nil),           // 89: pb.JoinCustomRoomReq
+	(*JoinCustomRoomRsp)(nil),           // 90: pb.JoinCustomRoomRsp
+	(*LeaveCustomRoomReq)(nil),          // 91: pb.LeaveCustomRoomReq
+	(*LeaveCustomRoomRsp)(nil),          // 92: pb.LeaveCustomRoomRsp
+	(*MoveCustomRoomPlayerReq)(nil),     // 93: pb.MoveCustomRoomPlayerReq
+	(*MoveCustomRoomPlayerRsp)(nil),     // 94: pb.MoveCustomRoomPlayerRsp
+	(*StartCustomRoomReq)(nil),          // 95: pb.StartCustomRoomReq
+	(*StartCustomRoomRsp)(nil),          // 96: pb.StartCustomRoomRsp
+	nil,                                 // 97: pb.PlayerInfo.RegionPingsEntry
+	nil,                                 // 98: pb.BindReq.ModeVersionsEntry
+	(GameMode)(0),                       // 99: pb.GameMode
+	(PlayerOnlineState)(0),              // 100: pb.PlayerOnlineState
+	(PlayerVoiceState)(0),               // 101: pb.PlayerVoiceState
+	(NetProtocol)(0),                    // 102: pb.NetProtocol
+	(GroupMessageType)(0),               // 103: pb.GroupMessageType
+}
+var file_protos_match_proto_depIdxs = []int32{
+	99,  // 0: pb.PlayerInfo.game_mode:type_name -> pb.GameMode
+	18,  // 1: pb.PlayerInfo.glicko2_info:type_name -> pb.Glicko2Info
+	97,  // 2: pb.PlayerInfo.region_pings:type_name -> pb.PlayerInfo.RegionPingsEntry
+	98,  // 3: pb.BindReq.mode_versions:type_name -> pb.BindReq.ModeVersionsEntry
+	100, // 4: pb.BindRsp.online_state:type_name -> pb.PlayerOnlineState
+	5,   // 5: pb.BindRsp.group_info:type_name -> pb.GroupInfo
+	7,   // 6: pb.BindRsp.match_info:type_name -> pb.MatchInfo
+	99,  // 7: pb.GroupInfo.game_mode:type_name -> pb.GameMode
+	6,   // 8: pb.GroupInfo.player_infos:type_name -> pb.GroupPlayerInfo
+	100, // 9: pb.GroupPlayerInfo.online_state:type_name -> pb.PlayerOnlineState
+	101, // 10: pb.GroupPlayerInfo.voice_state:type_name -> pb.PlayerVoiceState
+	99,  // 11: pb.MatchInfo.game_mode:type_name -> pb.GameMode
+	8,   // 12: pb.MatchInfo.teams:type_name -> pb.MatchTeamInfo
+	15,  // 13: pb.MatchInfo.game_server_info:type_name -> pb.GameServerInfo
+	13,  // 14: pb.MatchInfo.spectators:type_name -> pb.MatchSpectatorInfo
+	14,  // 15: pb.MatchTeamInfo.players:type_name -> pb.MatchPlayerInfo
+	99,  // 16: pb.CustomRoomInfo.game_mode:type_name -> pb.GameMode
+	10,  // 17: pb.CustomRoomInfo.teams:type_name -> pb.CustomRoomTeam
+	99,  // 18: pb.PendingInvite.game_mode:type_name -> pb.GameMode
+	99,  // 19: pb.JoinableGroup.game_mode:type_name -> pb.GameMode
+	16,  // 20: pb.MatchPlayerInfo.attr:type_name -> pb.UserAttribute
+	102, // 21: pb.GameServerInfo.protocol:type_name -> pb.NetProtocol
+	2,   // 22: pb.CreateGroupReq.player_info:type_name -> pb.PlayerInfo
+	2,   // 23: pb.EnterGroupReq.player_info:type_name -> pb.PlayerInfo
+	0,   // 24: pb.EnterGroupReq.source:type_name -> pb.EnterGroupSource
+	2,   // 25: pb.AcceptInviteReq.invitee_info:type_name -> pb.PlayerInfo
+	11,  // 26: pb.ListReceivedInvitesRsp.invites:type_name -> pb.PendingInvite
+	11,  // 27: pb.ListSentInvitesRsp.invites:type_name -> pb.PendingInvite
+	1,   // 28: pb.ChangeRoleReq.role:type_name -> pb.GroupRole
+	12,  // 29: pb.NearbyGroupsRsp.groups:type_name -> pb.JoinableGroup
+	12,  // 30: pb.RecentGroupsRsp.groups:type_name -> pb.JoinableGroup
+	101, // 31: pb.SetVoiceStateReq.state:type_name -> pb.PlayerVoiceState
+	103, // 32: pb.SendGroupMessageReq.type:type_name -> pb.GroupMessageType
+	16,  // 33: pb.UploadPlayerAttrReq.attr:type_name -> pb.UserAttribute
+	73,  // 34: pb.UploadPlayerAttrReq.goat_game_attr:type_name -> pb.GoatGameAttribute
+	35,  // [35:35] is the sub-list for method output_type
+	35,  // [35:35] is the sub-list for method input_type
+	35,  // [35:35] is the sub-list for extension type_name
+	35,  // [35:35] is the sub-list for extension extendee
+	0,   // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_protos_match_proto_init() }
+func file_protos_match_proto_init() {
+	if File_protos_match_proto != nil {
+		return
+	}
+	file_protos_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_protos_match_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupPlayerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchTeamInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomRoomInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomRoomTeam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingInvite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinableGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchSpectatorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MatchPlayerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameServerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Glicko2Info); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnterGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnterGroupRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitGroupRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DissolveGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DissolveGroupRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptInviteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptInviteRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefuseInviteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefuseInviteRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeInviteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeInviteRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReceivedInvitesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReceivedInvitesRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSentInvitesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSentInvitesRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickPlayerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KickPlayerRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeRoleRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNearbyJoinGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNearbyJoinGroupRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRecentJoinGroupReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRecentJoinGroupRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3071,8 +6412,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BindReq); i {
+		file_protos_match_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendJoinGroupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3083,8 +6424,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BindRsp); i {
+		file_protos_match_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendJoinGroupRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3095,8 +6436,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupInfo); i {
+		file_protos_match_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWorldChannelJoinGroupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3107,8 +6448,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupPlayerInfo); i {
+		file_protos_match_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWorldChannelJoinGroupRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3119,8 +6460,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchInfo); i {
+		file_protos_match_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetClanChannelJoinGroupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3131,8 +6472,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchTeamInfo); i {
+		file_protos_match_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetClanChannelJoinGroupRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3143,8 +6484,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MatchPlayerInfo); i {
+		file_protos_match_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareGroupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3155,8 +6496,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameServerInfo); i {
+		file_protos_match_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareGroupRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3167,8 +6508,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserAttribute); i {
+		file_protos_match_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportLocationReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3179,8 +6520,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGroupReq); i {
+		file_protos_match_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportLocationRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3191,8 +6532,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Glicko2Info); i {
+		file_protos_match_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearbyGroupsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3203,8 +6544,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateGroupRsp); i {
+		file_protos_match_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NearbyGroupsRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3215,8 +6556,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnterGroupReq); i {
+		file_protos_match_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecentGroupsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3227,8 +6568,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnterGroupRsp); i {
+		file_protos_match_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecentGroupsRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3239,8 +6580,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExitGroupReq); i {
+		file_protos_match_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVoiceStateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3251,8 +6592,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExitGroupRsp); i {
+		file_protos_match_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVoiceStateRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3263,8 +6604,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DissolveGroupReq); i {
+		file_protos_match_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendGroupMessageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3275,8 +6616,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DissolveGroupRsp); i {
+		file_protos_match_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendGroupMessageRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3287,8 +6628,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InviteReq); i {
+		file_protos_match_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3299,8 +6640,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InviteRsp); i {
+		file_protos_match_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3311,8 +6652,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptInviteReq); i {
+		file_protos_match_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreadyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3323,8 +6664,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AcceptInviteRsp); i {
+		file_protos_match_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnreadyRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3335,8 +6676,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefuseInviteReq); i {
+		file_protos_match_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMatchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3347,8 +6688,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RefuseInviteRsp); i {
+		file_protos_match_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartMatchRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3359,8 +6700,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KickPlayerReq); i {
+		file_protos_match_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMatchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3371,8 +6712,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KickPlayerRsp); i {
+		file_protos_match_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelMatchRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3383,8 +6724,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeRoleReq); i {
+		file_protos_match_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadPlayerAttrReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3395,8 +6736,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeRoleRsp); i {
+		file_protos_match_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GoatGameAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3407,8 +6748,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNearbyJoinGroupReq); i {
+		file_protos_match_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadPlayerAttrRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3419,8 +6760,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNearbyJoinGroupRsp); i {
+		file_protos_match_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitGameReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3431,8 +6772,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetRecentJoinGroupReq); i {
+		file_protos_match_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitGameRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3443,8 +6784,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetRecentJoinGroupRsp); i {
+		file_protos_match_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenBackfillReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3455,8 +6796,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetVoiceStateReq); i {
+		file_protos_match_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenBackfillRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3467,8 +6808,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetVoiceStateRsp); i {
+		file_protos_match_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptMatchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3479,8 +6820,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadyReq); i {
+		file_protos_match_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptMatchRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3491,8 +6832,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadyRsp); i {
+		file_protos_match_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineMatchReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3503,8 +6844,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnreadyReq); i {
+		file_protos_match_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeclineMatchRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3515,8 +6856,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnreadyRsp); i {
+		file_protos_match_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3527,8 +6868,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartMatchReq); i {
+		file_protos_match_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectateRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3539,8 +6880,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartMatchRsp); i {
+		file_protos_match_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitSpectateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3551,8 +6892,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelMatchReq); i {
+		file_protos_match_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitSpectateRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3563,8 +6904,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelMatchRsp); i {
+		file_protos_match_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCustomRoomReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3575,8 +6916,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadPlayerAttrReq); i {
+		file_protos_match_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCustomRoomRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3587,8 +6928,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GoatGameAttribute); i {
+		file_protos_match_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinCustomRoomReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3599,8 +6940,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadPlayerAttrRsp); i {
+		file_protos_match_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinCustomRoomRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3611,8 +6952,8 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExitGameReq); i {
+		file_protos_match_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveCustomRoomReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3623,8 +6964,56 @@ func file_protos_match_proto_init() {
 				return nil
 			}
 		}
-		file_protos_match_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExitGameRsp); i {
+		file_protos_match_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveCustomRoomRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveCustomRoomPlayerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveCustomRoomPlayerRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCustomRoomReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_match_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCustomRoomRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3636,7 +7025,7 @@ func file_protos_match_proto_init() {
 			}
 		}
 	}
-	file_protos_match_proto_msgTypes[43].OneofWrappers = []interface{}{
+	file_protos_match_proto_msgTypes[70].OneofWrappers = []interface{}{
 		(*UploadPlayerAttrReq_GoatGameAttr)(nil),
 	}
 	type x struct{}
@@ -3645,7 +7034,7 @@ func file_protos_match_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_match_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   49,
+			NumMessages:   97,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -319,6 +319,132 @@ func (x *PushRefuseInvite) GetRefuseMsg() string {
 	return ""
 }
 
+type PushInviteExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InviterUid string `protobuf:"bytes,1,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
+	InviteeUid string `protobuf:"bytes,2,opt,name=invitee_uid,json=inviteeUid,proto3" json:"invitee_uid,omitempty"`
+	GroupId    int64  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *PushInviteExpired) Reset() {
+	*x = PushInviteExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_push_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushInviteExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushInviteExpired) ProtoMessage() {}
+
+func (x *PushInviteExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_push_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushInviteExpired.ProtoReflect.Descriptor instead.
+func (*PushInviteExpired) Descriptor() ([]byte, []int) {
+	return file_protos_push_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PushInviteExpired) GetInviterUid() string {
+	if x != nil {
+		return x.InviterUid
+	}
+	return ""
+}
+
+func (x *PushInviteExpired) GetInviteeUid() string {
+	if x != nil {
+		return x.InviteeUid
+	}
+	return ""
+}
+
+func (x *PushInviteExpired) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type PushRevokeInvite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InviterUid string `protobuf:"bytes,1,opt,name=inviter_uid,json=inviterUid,proto3" json:"inviter_uid,omitempty"`
+	InviteeUid string `protobuf:"bytes,2,opt,name=invitee_uid,json=inviteeUid,proto3" json:"invitee_uid,omitempty"`
+	GroupId    int64  `protobuf:"varint,3,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (x *PushRevokeInvite) Reset() {
+	*x = PushRevokeInvite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_push_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushRevokeInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushRevokeInvite) ProtoMessage() {}
+
+func (x *PushRevokeInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_push_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushRevokeInvite.ProtoReflect.Descriptor instead.
+func (*PushRevokeInvite) Descriptor() ([]byte, []int) {
+	return file_protos_push_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PushRevokeInvite) GetInviterUid() string {
+	if x != nil {
+		return x.InviterUid
+	}
+	return ""
+}
+
+func (x *PushRevokeInvite) GetInviteeUid() string {
+	if x != nil {
+		return x.InviteeUid
+	}
+	return ""
+}
+
+func (x *PushRevokeInvite) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
 type PushGroupDissolve struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -330,7 +456,7 @@ type PushGroupDissolve struct {
 func (x *PushGroupDissolve) Reset() {
 	*x = PushGroupDissolve{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_push_proto_msgTypes[5]
+		mi := &file_protos_push_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -343,7 +469,7 @@ func (x *PushGroupDissolve) String() string {
 func (*PushGroupDissolve) ProtoMessage() {}
 
 func (x *PushGroupDissolve) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_push_proto_msgTypes[5]
+	mi := &file_protos_push_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +482,7 @@ func (x *PushGroupDissolve) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushGroupDissolve.ProtoReflect.Descriptor instead.
 func (*PushGroupDissolve) Descriptor() ([]byte, []int) {
-	return file_protos_push_proto_rawDescGZIP(), []int{5}
+	return file_protos_push_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PushGroupDissolve) GetGroupId() int64 {
@@ -377,7 +503,7 @@ type PushGroupState struct {
 func (x *PushGroupState) Reset() {
 	*x = PushGroupState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_push_proto_msgTypes[6]
+		mi := &file_protos_push_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -390,7 +516,7 @@ func (x *PushGroupState) String() string {
 func (*PushGroupState) ProtoMessage() {}
 
 func (x *PushGroupState) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_push_proto_msgTypes[6]
+	mi := &file_protos_push_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +529,7 @@ func (x *PushGroupState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushGroupState.ProtoReflect.Descriptor instead.
 func (*PushGroupState) Descriptor() ([]byte, []int) {
-	return file_protos_push_proto_rawDescGZIP(), []int{6}
+	return file_protos_push_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PushGroupState) GetGroupState() GroupState {
@@ -425,7 +551,7 @@ type PushPlayerVoiceState struct {
 func (x *PushPlayerVoiceState) Reset() {
 	*x = PushPlayerVoiceState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_push_proto_msgTypes[7]
+		mi := &file_protos_push_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -438,7 +564,7 @@ func (x *PushPlayerVoiceState) String() string {
 func (*PushPlayerVoiceState) ProtoMessage() {}
 
 func (x *PushPlayerVoiceState) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_push_proto_msgTypes[7]
+	mi := &file_protos_push_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -451,7 +577,7 @@ func (x *PushPlayerVoiceState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushPlayerVoiceState.ProtoReflect.Descriptor instead.
 func (*PushPlayerVoiceState) Descriptor() ([]byte, []int) {
-	return file_protos_push_proto_rawDescGZIP(), []int{7}
+	return file_protos_push_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PushPlayerVoiceState) GetUid() string {
@@ -479,7 +605,7 @@ type PushKick struct {
 func (x *PushKick) Reset() {
 	*x = PushKick{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_push_proto_msgTypes[8]
+		mi := &file_protos_push_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -492,7 +618,7 @@ func (x *PushKick) String() string {
 func (*PushKick) ProtoMessage() {}
 
 func (x *PushKick) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_push_proto_msgTypes[8]
+	mi := &file_protos_push_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -505,7 +631,7 @@ func (x *PushKick) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushKick.ProtoReflect.Descriptor instead.
 func (*PushKick) Descriptor() ([]byte, []int) {
-	return file_protos_push_proto_rawDescGZIP(), []int{8}
+	return file_protos_push_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PushKick) GetGroupId() int64 {
@@ -526,7 +652,7 @@ type PushMatchInfo struct {
 func (x *PushMatchInfo) Reset() {
 	*x = PushMatchInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_push_proto_msgTypes[9]
+		mi := &file_protos_push_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -539,7 +665,7 @@ func (x *PushMatchInfo) String() string {
 func (*PushMatchInfo) ProtoMessage() {}
 
 func (x *PushMatchInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_push_proto_msgTypes[9]
+	mi := &file_protos_push_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +678,7 @@ func (x *PushMatchInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushMatchInfo.ProtoReflect.Descriptor instead.
 func (*PushMatchInfo) Descriptor() ([]byte, []int) {
-	return file_protos_push_proto_rawDescGZIP(), []int{9}
+	return file_protos_push_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PushMatchInfo) GetMatchInfo() *MatchInfo {
@@ -562,18 +688,388 @@ func (x *PushMatchInfo) GetMatchInfo() *MatchInfo {
 	return nil
 }
 
+type PushReadyCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId      int64 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	DeadlineSec int64 `protobuf:"varint,2,opt,name=deadline_sec,json=deadlineSec,proto3" json:"deadline_sec,omitempty"`
+}
+
+func (x *PushReadyCheck) Reset() {
+	*x = PushReadyCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_push_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushReadyCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushReadyCheck) ProtoMessage() {}
+
+func (x *PushReadyCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_push_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushReadyCheck.ProtoReflect.Descriptor instead.
+func (*PushReadyCheck) Descriptor() ([]byte, []int) {
+	return file_protos_push_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PushReadyCheck) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *PushReadyCheck) GetDeadlineSec() int64 {
+	if x != nil {
+		return x.DeadlineSec
+	}
+	return 0
+}
+
+type PushAcceptMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AcceptUid string `protobuf:"bytes,1,opt,name=accept_uid,json=acceptUid,proto3" json:"accept_uid,omitempty"`
+}
+
+func (x *PushAcceptMatch) Reset() {
+	*x = PushAcceptMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_push_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushAcceptMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushAcceptMatch) ProtoMessage() {}
+
+func (x *PushAcceptMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_push_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushAcceptMatch.ProtoReflect.Descriptor instead.
+func (*PushAcceptMatch) Descriptor() ([]byte, []int) {
+	return file_protos_push_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PushAcceptMatch) GetAcceptUid() string {
+	if x != nil {
+		return x.AcceptUid
+	}
+	return ""
+}
+
+type PushDeclineMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeclineUids []string `protobuf:"bytes,1,rep,name=decline_uids,json=declineUids,proto3" json:"decline_uids,omitempty"`
+}
+
+func (x *PushDeclineMatch) Reset() {
+	*x = PushDeclineMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_push_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushDeclineMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushDeclineMatch) ProtoMessage() {}
+
+func (x *PushDeclineMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_push_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushDeclineMatch.ProtoReflect.Descriptor instead.
+func (*PushDeclineMatch) Descriptor() ([]byte, []int) {
+	return file_protos_push_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PushDeclineMatch) GetDeclineUids() []string {
+	if x != nil {
+		return x.DeclineUids
+	}
+	return nil
+}
+
+type PushSpectateEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId int64 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *PushSpectateEnd) Reset() {
+	*x = PushSpectateEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_push_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushSpectateEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushSpectateEnd) ProtoMessage() {}
+
+func (x *PushSpectateEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_push_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushSpectateEnd.ProtoReflect.Descriptor instead.
+func (*PushSpectateEnd) Descriptor() ([]byte, []int) {
+	return file_protos_push_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PushSpectateEnd) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type PushCustomRoomInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomRoomInfo *CustomRoomInfo `protobuf:"bytes,1,opt,name=custom_room_info,json=customRoomInfo,proto3" json:"custom_room_info,omitempty"`
+}
+
+func (x *PushCustomRoomInfo) Reset() {
+	*x = PushCustomRoomInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_push_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushCustomRoomInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushCustomRoomInfo) ProtoMessage() {}
+
+func (x *PushCustomRoomInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_push_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushCustomRoomInfo.ProtoReflect.Descriptor instead.
+func (*PushCustomRoomInfo) Descriptor() ([]byte, []int) {
+	return file_protos_push_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PushCustomRoomInfo) GetCustomRoomInfo() *CustomRoomInfo {
+	if x != nil {
+		return x.CustomRoomInfo
+	}
+	return nil
+}
+
+type PushCustomRoomDissolve struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId int64 `protobuf:"varint,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+}
+
+func (x *PushCustomRoomDissolve) Reset() {
+	*x = PushCustomRoomDissolve{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_push_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushCustomRoomDissolve) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushCustomRoomDissolve) ProtoMessage() {}
+
+func (x *PushCustomRoomDissolve) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_push_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushCustomRoomDissolve.ProtoReflect.Descriptor instead.
+func (*PushCustomRoomDissolve) Descriptor() ([]byte, []int) {
+	return file_protos_push_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PushCustomRoomDissolve) GetRoomId() int64 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+type PushGroupMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId   int64            `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	SenderUid string           `protobuf:"bytes,2,opt,name=sender_uid,json=senderUid,proto3" json:"sender_uid,omitempty"`
+	Type      GroupMessageType `protobuf:"varint,3,opt,name=type,proto3,enum=pb.GroupMessageType" json:"type,omitempty"`
+	Content   string           `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	SendSec   int64            `protobuf:"varint,5,opt,name=send_sec,json=sendSec,proto3" json:"send_sec,omitempty"`
+}
+
+func (x *PushGroupMessage) Reset() {
+	*x = PushGroupMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_push_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushGroupMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushGroupMessage) ProtoMessage() {}
+
+func (x *PushGroupMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_push_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushGroupMessage.ProtoReflect.Descriptor instead.
+func (*PushGroupMessage) Descriptor() ([]byte, []int) {
+	return file_protos_push_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PushGroupMessage) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *PushGroupMessage) GetSenderUid() string {
+	if x != nil {
+		return x.SenderUid
+	}
+	return ""
+}
+
+func (x *PushGroupMessage) GetType() GroupMessageType {
+	if x != nil {
+		return x.Type
+	}
+	return GroupMessageType_GROUP_MESSAGE_TYPE_UNKNOWN
+}
+
+func (x *PushGroupMessage) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PushGroupMessage) GetSendSec() int64 {
+	if x != nil {
+		return x.SendSec
+	}
+	return 0
+}
+
 type PushCancelMatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CancelUid string `protobuf:"bytes,1,opt,name=cancel_uid,json=cancelUid,proto3" json:"cancel_uid,omitempty"`
+	CancelUid string            `protobuf:"bytes,1,opt,name=cancel_uid,json=cancelUid,proto3" json:"cancel_uid,omitempty"`
+	Reason    CancelMatchReason `protobuf:"varint,2,opt,name=reason,proto3,enum=pb.CancelMatchReason" json:"reason,omitempty"`
 }
 
 func (x *PushCancelMatch) Reset() {
 	*x = PushCancelMatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_push_proto_msgTypes[10]
+		mi := &file_protos_push_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -586,7 +1082,7 @@ func (x *PushCancelMatch) String() string {
 func (*PushCancelMatch) ProtoMessage() {}
 
 func (x *PushCancelMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_push_proto_msgTypes[10]
+	mi := &file_protos_push_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +1095,7 @@ func (x *PushCancelMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushCancelMatch.ProtoReflect.Descriptor instead.
 func (*PushCancelMatch) Descriptor() ([]byte, []int) {
-	return file_protos_push_proto_rawDescGZIP(), []int{10}
+	return file_protos_push_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PushCancelMatch) GetCancelUid() string {
@@ -609,6 +1105,13 @@ func (x *PushCancelMatch) GetCancelUid() string {
 	return ""
 }
 
+func (x *PushCancelMatch) GetReason() CancelMatchReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CancelMatchReason_CANCEL_MATCH_REASON_PLAYER
+}
+
 type PushReady struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -620,7 +1123,7 @@ type PushReady struct {
 func (x *PushReady) Reset() {
 	*x = PushReady{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_push_proto_msgTypes[11]
+		mi := &file_protos_push_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -633,7 +1136,7 @@ func (x *PushReady) String() string {
 func (*PushReady) ProtoMessage() {}
 
 func (x *PushReady) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_push_proto_msgTypes[11]
+	mi := &file_protos_push_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +1149,7 @@ func (x *PushReady) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushReady.ProtoReflect.Descriptor instead.
 func (*PushReady) Descriptor() ([]byte, []int) {
-	return file_protos_push_proto_rawDescGZIP(), []int{11}
+	return file_protos_push_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PushReady) GetReadyUid() string {
@@ -667,7 +1170,7 @@ type PushUnready struct {
 func (x *PushUnready) Reset() {
 	*x = PushUnready{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_push_proto_msgTypes[12]
+		mi := &file_protos_push_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -680,7 +1183,7 @@ func (x *PushUnready) String() string {
 func (*PushUnready) ProtoMessage() {}
 
 func (x *PushUnready) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_push_proto_msgTypes[12]
+	mi := &file_protos_push_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +1196,7 @@ func (x *PushUnready) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushUnready.ProtoReflect.Descriptor instead.
 func (*PushUnready) Descriptor() ([]byte, []int) {
-	return file_protos_push_proto_rawDescGZIP(), []int{12}
+	return file_protos_push_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *PushUnready) GetUnreadyUid() string {
@@ -747,37 +1250,87 @@ var file_protos_push_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
 	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x75, 0x73, 0x65, 0x5f,
 	0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x75, 0x73,
-	0x65, 0x4d, 0x73, 0x67, 0x22, 0x2e, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x62,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x5f, 0x0a, 0x14, 0x50, 0x75, 0x73, 0x68, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69,
-	0x64, 0x12, 0x35, 0x0a, 0x0b, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x25, 0x0a, 0x08, 0x50, 0x75, 0x73, 0x68,
-	0x4b, 0x69, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22,
-	0x3d, 0x0a, 0x0d, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x2c, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x30,
-	0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63,
-	0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x75, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x69, 0x64,
-	0x22, 0x28, 0x0a, 0x09, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1b, 0x0a,
-	0x09, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x79, 0x55, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x0b, 0x50, 0x75,
-	0x73, 0x68, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x72,
-	0x65, 0x61, 0x64, 0x79, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x55, 0x69, 0x64, 0x42, 0x0d, 0x5a, 0x0b, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x4d, 0x73, 0x67, 0x22, 0x70, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x55, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x55, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x11, 0x50, 0x75, 0x73, 0x68, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x5f, 0x0a, 0x14, 0x50, 0x75,
+	0x73, 0x68, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0a, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x25, 0x0a, 0x08, 0x50,
+	0x75, 0x73, 0x68, 0x4b, 0x69, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x22, 0x3d, 0x0a, 0x0d, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x4c, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x63, 0x22,
+	0x30, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x55, 0x69,
+	0x64, 0x22, 0x35, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x63,
+	0x6c, 0x69, 0x6e, 0x65, 0x55, 0x69, 0x64, 0x73, 0x22, 0x2a, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x12, 0x50, 0x75, 0x73, 0x68, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3c, 0x0a, 0x10, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x52, 0x6f, 0x6f, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x31, 0x0a, 0x16, 0x50, 0x75, 0x73, 0x68,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6f, 0x6d, 0x44, 0x69, 0x73, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x10,
+	0x50, 0x75, 0x73, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x55, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x63, 0x22, 0x5f, 0x0a, 0x0f, 0x50, 0x75, 0x73,
+	0x68, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x09, 0x50, 0x75,
+	0x73, 0x68, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x55, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x0b, 0x50, 0x75, 0x73, 0x68, 0x55, 0x6e, 0x72, 0x65,
+	0x61, 0x64, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x55, 0x69, 0x64, 0x42, 0x0d, 0x5a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -792,42 +1345,57 @@ func file_protos_push_proto_rawDescGZIP() []byte {
 	return file_protos_push_proto_rawDescData
 }
 
-var file_protos_push_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_protos_push_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_protos_push_proto_goTypes = []interface{}{
-	(*PushPlayerOnlineState)(nil), // 0: pb.PushPlayerOnlineState
-	(*PushGroupInfo)(nil),         // 1: pb.PushGroupInfo
-	(*PushInviteMsg)(nil),         // 2: pb.PushInviteMsg
-	(*PushAcceptInvite)(nil),      // 3: pb.PushAcceptInvite
-	(*PushRefuseInvite)(nil),      // 4: pb.PushRefuseInvite
-	(*PushGroupDissolve)(nil),     // 5: pb.PushGroupDissolve
-	(*PushGroupState)(nil),        // 6: pb.PushGroupState
-	(*PushPlayerVoiceState)(nil),  // 7: pb.PushPlayerVoiceState
-	(*PushKick)(nil),              // 8: pb.PushKick
-	(*PushMatchInfo)(nil),         // 9: pb.PushMatchInfo
-	(*PushCancelMatch)(nil),       // 10: pb.PushCancelMatch
-	(*PushReady)(nil),             // 11: pb.PushReady
-	(*PushUnready)(nil),           // 12: pb.PushUnready
-	(PlayerOnlineState)(0),        // 13: pb.PlayerOnlineState
-	(*GroupInfo)(nil),             // 14: pb.GroupInfo
-	(EnterGroupSource)(0),         // 15: pb.EnterGroupSource
-	(GameMode)(0),                 // 16: pb.GameMode
-	(GroupState)(0),               // 17: pb.GroupState
-	(PlayerVoiceState)(0),         // 18: pb.PlayerVoiceState
-	(*MatchInfo)(nil),             // 19: pb.MatchInfo
+	(*PushPlayerOnlineState)(nil),  // 0: pb.PushPlayerOnlineState
+	(*PushGroupInfo)(nil),          // 1: pb.PushGroupInfo
+	(*PushInviteMsg)(nil),          // 2: pb.PushInviteMsg
+	(*PushAcceptInvite)(nil),       // 3: pb.PushAcceptInvite
+	(*PushRefuseInvite)(nil),       // 4: pb.PushRefuseInvite
+	(*PushInviteExpired)(nil),      // 5: pb.PushInviteExpired
+	(*PushRevokeInvite)(nil),       // 6: pb.PushRevokeInvite
+	(*PushGroupDissolve)(nil),      // 7: pb.PushGroupDissolve
+	(*PushGroupState)(nil),         // 8: pb.PushGroupState
+	(*PushPlayerVoiceState)(nil),   // 9: pb.PushPlayerVoiceState
+	(*PushKick)(nil),               // 10: pb.PushKick
+	(*PushMatchInfo)(nil),          // 11: pb.PushMatchInfo
+	(*PushReadyCheck)(nil),         // 12: pb.PushReadyCheck
+	(*PushAcceptMatch)(nil),        // 13: pb.PushAcceptMatch
+	(*PushDeclineMatch)(nil),       // 14: pb.PushDeclineMatch
+	(*PushSpectateEnd)(nil),        // 15: pb.PushSpectateEnd
+	(*PushCustomRoomInfo)(nil),     // 16: pb.PushCustomRoomInfo
+	(*PushCustomRoomDissolve)(nil), // 17: pb.PushCustomRoomDissolve
+	(*PushGroupMessage)(nil),       // 18: pb.PushGroupMessage
+	(*PushCancelMatch)(nil),        // 19: pb.PushCancelMatch
+	(*PushReady)(nil),              // 20: pb.PushReady
+	(*PushUnready)(nil),            // 21: pb.PushUnready
+	(PlayerOnlineState)(0),         // 22: pb.PlayerOnlineState
+	(*GroupInfo)(nil),              // 23: pb.GroupInfo
+	(EnterGroupSource)(0),          // 24: pb.EnterGroupSource
+	(GameMode)(0),                  // 25: pb.GameMode
+	(GroupState)(0),                // 26: pb.GroupState
+	(PlayerVoiceState)(0),          // 27: pb.PlayerVoiceState
+	(*MatchInfo)(nil),              // 28: pb.MatchInfo
+	(*CustomRoomInfo)(nil),         // 29: pb.CustomRoomInfo
+	(GroupMessageType)(0),          // 30: pb.GroupMessageType
+	(CancelMatchReason)(0),         // 31: pb.CancelMatchReason
 }
 var file_protos_push_proto_depIdxs = []int32{
-	13, // 0: pb.PushPlayerOnlineState.online_state:type_name -> pb.PlayerOnlineState
-	14, // 1: pb.PushGroupInfo.group_info:type_name -> pb.GroupInfo
-	15, // 2: pb.PushInviteMsg.source:type_name -> pb.EnterGroupSource
-	16, // 3: pb.PushInviteMsg.game_mode:type_name -> pb.GameMode
-	17, // 4: pb.PushGroupState.group_state:type_name -> pb.GroupState
-	18, // 5: pb.PushPlayerVoiceState.voice_state:type_name -> pb.PlayerVoiceState
-	19, // 6: pb.PushMatchInfo.match_info:type_name -> pb.MatchInfo
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	22, // 0: pb.PushPlayerOnlineState.online_state:type_name -> pb.PlayerOnlineState
+	23, // 1: pb.PushGroupInfo.group_info:type_name -> pb.GroupInfo
+	24, // 2: pb.PushInviteMsg.source:type_name -> pb.EnterGroupSource
+	25, // 3: pb.PushInviteMsg.game_mode:type_name -> pb.GameMode
+	26, // 4: pb.PushGroupState.group_state:type_name -> pb.GroupState
+	27, // 5: pb.PushPlayerVoiceState.voice_state:type_name -> pb.PlayerVoiceState
+	28, // 6: pb.PushMatchInfo.match_info:type_name -> pb.MatchInfo
+	29, // 7: pb.PushCustomRoomInfo.custom_room_info:type_name -> pb.CustomRoomInfo
+	30, // 8: pb.PushGroupMessage.type:type_name -> pb.GroupMessageType
+	31, // 9: pb.PushCancelMatch.reason:type_name -> pb.CancelMatchReason
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_protos_push_proto_init() }
@@ -899,7 +1467,7 @@ func file_protos_push_proto_init() {
 			}
 		}
 		file_protos_push_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushGroupDissolve); i {
+			switch v := v.(*PushInviteExpired); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -911,7 +1479,7 @@ func file_protos_push_proto_init() {
 			}
 		}
 		file_protos_push_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushGroupState); i {
+			switch v := v.(*PushRevokeInvite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -923,7 +1491,7 @@ func file_protos_push_proto_init() {
 			}
 		}
 		file_protos_push_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushPlayerVoiceState); i {
+			switch v := v.(*PushGroupDissolve); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -935,7 +1503,7 @@ func file_protos_push_proto_init() {
 			}
 		}
 		file_protos_push_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushKick); i {
+			switch v := v.(*PushGroupState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -947,7 +1515,7 @@ func file_protos_push_proto_init() {
 			}
 		}
 		file_protos_push_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushMatchInfo); i {
+			switch v := v.(*PushPlayerVoiceState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -959,7 +1527,7 @@ func file_protos_push_proto_init() {
 			}
 		}
 		file_protos_push_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushCancelMatch); i {
+			switch v := v.(*PushKick); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -971,7 +1539,7 @@ func file_protos_push_proto_init() {
 			}
 		}
 		file_protos_push_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushReady); i {
+			switch v := v.(*PushMatchInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -983,6 +1551,114 @@ func file_protos_push_proto_init() {
 			}
 		}
 		file_protos_push_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushReadyCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_push_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushAcceptMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_push_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushDeclineMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_push_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushSpectateEnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_push_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushCustomRoomInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_push_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushCustomRoomDissolve); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_push_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushGroupMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_push_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushCancelMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_push_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushReady); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_push_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PushUnready); i {
 			case 0:
 				return &v.state
@@ -1001,7 +1677,7 @@ func file_protos_push_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_push_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
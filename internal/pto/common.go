@@ -13,6 +13,9 @@ type PlayerInfo struct {
 	Star        int64             `json:"star"`
 	Rank        int64             `json:"rank"`
 
+	// RegionPings is the ping(ms) measured by the client to each game server region.
+	RegionPings map[string]int64 `json:"region_pings"`
+
 	Glicko2Info *Glicko2Info `json:"glicko2_info"`
 }
 
@@ -0,0 +1,32 @@
+package pto
+
+import (
+	"github.com/hedon954/go-matcher/internal/constant"
+)
+
+// RecentRelation is how a player met a recent player.
+type RecentRelation int
+
+const (
+	RecentRelationTeammate RecentRelation = 1 // played in the same team
+	RecentRelationOpponent RecentRelation = 2 // played in the opposite team
+)
+
+// JoinableGroup is a group found from the nearby or recent player list.
+type JoinableGroup struct {
+	GroupID     int64
+	Captain     string
+	GameMode    constant.GameMode
+	ModeVersion int64
+	PlayerCount int
+	PlayerLimit int
+
+	// UID is the nearby or recent player in the group.
+	UID string
+	// DistanceKm is the distance to the nearby player, only for the nearby list.
+	DistanceKm float64
+	// Relation is how the player met the recent player, only for the recent list.
+	Relation RecentRelation
+	// PlayedAtSec is the last time played with the recent player, only for the recent list.
+	PlayedAtSec int64
+}
@@ -4,4 +4,10 @@ type Glicko2Info struct {
 	MMR  float64 `json:"mmr"`
 	Star int64   `json:"star"`
 	Rank int64   `json:"rank"`
+	// RD is the rating deviation of the player
+	RD float64 `json:"rd"`
+	// AccountCreateSec is the unix time the player's account was created
+	AccountCreateSec int64 `json:"account_create_sec"`
+	// MatchCount is the total matches the player has played
+	MatchCount int64 `json:"match_count"`
 }
@@ -17,6 +17,8 @@ const (
 type EnterGroup struct {
 	PlayerInfo
 	Source EnterGroupSourceType
+	// ShareToken is the token from the share link, required if Source is EnterGroupSourceTypeShare
+	ShareToken string
 }
 
 // CreateGroup is the parameter of creating a group
@@ -24,6 +26,16 @@ type CreateGroup struct {
 	PlayerInfo
 }
 
+// CreateCustomRoom is the parameter of creating a custom room
+type CreateCustomRoom struct {
+	// TeamLimit is the number of team slots of the room.
+	TeamLimit int
+	// TeamPlayerLimit is the max players of each team slot.
+	TeamPlayerLimit int
+	// Password is required to join the room if not empty.
+	Password string
+}
+
 // UploadPlayerAttr is the parameter for uploading player attributes needed by game presentation.
 type UploadPlayerAttr struct {
 	// Attribute is the common information of a player for game presentation.
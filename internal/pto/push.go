@@ -16,6 +16,45 @@ type InviteMsg struct {
 	ModeVersion int64
 }
 
+// PendingInvite is a pending invitation listed to the inviter or the invitee.
+type PendingInvite struct {
+	GroupID     int64
+	InviterUID  string
+	InviteeUID  string
+	GameMode    constant.GameMode
+	ModeVersion int64
+	CreateSec   int64
+	ExpireSec   int64
+}
+
+// GroupMessageType is the type of the group message.
+type GroupMessageType int
+
+const (
+	GroupMessageTypeText  GroupMessageType = 1 // free text
+	GroupMessageTypeEmote GroupMessageType = 2 // emote id
+	GroupMessageTypeQuick GroupMessageType = 3 // preset quick chat id
+)
+
+// CancelMatchReason is the reason the match of a group is canceled.
+type CancelMatchReason int
+
+const (
+	CancelMatchReasonPlayer      CancelMatchReason = 0 // canceled by the player, or declined the ready check
+	CancelMatchReasonTimeout     CancelMatchReason = 1 // no room matched before the timeout
+	CancelMatchReasonOperator    CancelMatchReason = 2 // canceled by the operators
+	CancelMatchReasonMaintenance CancelMatchReason = 3 // the queue drained for maintenance
+)
+
+// GroupMessage is the message sent by a player to the group.
+type GroupMessage struct {
+	GroupID   int64
+	SenderUID string
+	Type      GroupMessageType
+	Content   string
+	SendSec   int64
+}
+
 // UserVoiceState is user voice state pushed to the client.
 type UserVoiceState struct {
 	UID   string
@@ -55,6 +94,9 @@ type MatchInfo struct {
 	MatchedTimeUnix int64
 	Teams           []MatchTeamInfo
 	GameServerInfo  GameServerInfo
+
+	// Spectators holds the spectators of the room, they watch the game on the same game server.
+	Spectators []MatchSpectatorInfo
 }
 type MatchTeamInfo struct {
 	TeamID  int
@@ -66,6 +108,30 @@ type MatchPlayerInfo struct {
 	Attr    Attribute
 }
 
+// ReadyCheck is the ready check of the matched room pushed to the client,
+// the players should accept the match before DeadlineSec.
+type ReadyCheck struct {
+	RoomID      int64
+	DeadlineSec int64
+}
+
+type MatchSpectatorInfo struct {
+	UID string
+}
+
+// CustomRoomInfo is the custom room info pushed to the clients in the room to sync the team slots.
+type CustomRoomInfo struct {
+	RoomID          int64
+	Code            string
+	Owner           string
+	GameMode        constant.GameMode
+	ModeVersion     int64
+	TeamPlayerLimit int
+
+	// Teams holds the players of each team slot, Teams[i] is the players of slot i+1.
+	Teams [][]string
+}
+
 // CancelMatch is the cancel match signal pushed to the client.
 type CancelMatch struct {
 	CancelUID    string
@@ -77,4 +143,5 @@ type GameServerInfo struct {
 	Host     string
 	Port     uint16
 	Protocol constant.NetProtocol
+	Region   string
 }
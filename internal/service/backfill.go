@@ -0,0 +1,14 @@
+package service
+
+import (
+	"github.com/hedon954/go-matcher/internal/entry"
+)
+
+// Backfill opens the slots of the teams in the in-progress rooms to the match system.
+type Backfill interface {
+	// OpenBackfill requests the match system to fill `slots` players into the team in the room.
+	OpenBackfill(r entry.Room, t entry.Team, slots int) error
+
+	// CloseBackfill cancels the backfill request of the team in the room.
+	CloseBackfill(r entry.Room, t entry.Team)
+}
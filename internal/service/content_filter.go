@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+)
+
+// ContentFilter checks the text sent by the players, e.g. the group messages.
+type ContentFilter interface {
+	// Filter returns the content to be delivered, with the profanity masked,
+	// an error is returned if the content should be rejected.
+	Filter(ctx context.Context, uid, content string) (string, error)
+}
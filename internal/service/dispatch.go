@@ -7,7 +7,8 @@ import (
 	"github.com/hedon954/go-matcher/internal/pto"
 )
 
-// GameServerDispatch dispatches game server info base on game mode and mode version.
+// GameServerDispatch dispatches game server info base on game mode, mode version and region.
+// Empty region means the game server can be in any region.
 type GameServerDispatch interface {
-	Dispatch(ctx context.Context, gameMode constant.GameMode, modeVersion int64) (info pto.GameServerInfo, err error)
+	Dispatch(ctx context.Context, gameMode constant.GameMode, modeVersion int64, region string) (info pto.GameServerInfo, err error)
 }
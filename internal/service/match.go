@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/hedon954/go-matcher/internal/entry"
 	"github.com/hedon954/go-matcher/internal/matcher/common"
@@ -30,6 +31,18 @@ type Match interface {
 	// RefuseInvite refuses the invite from the inviter
 	RefuseInvite(ctx context.Context, inviterUID, inviteeUID string, groupID int64, refuseMsg string)
 
+	// RevokeInvite revokes the pending invite sent by the inviter to the invitee
+	RevokeInvite(ctx context.Context, inviterUID, inviteeUID string) error
+
+	// ListReceivedInvites returns the pending invites received by the invitee, the latest first
+	ListReceivedInvites(ctx context.Context, inviteeUID string) ([]*pto.PendingInvite, error)
+
+	// ListSentInvites returns the pending invites sent by the inviter, the latest first
+	ListSentInvites(ctx context.Context, inviterUID string) ([]*pto.PendingInvite, error)
+
+	// RestoreInvites restores the pending invites of the groups reloaded when the server starts
+	RestoreInvites(groups []entry.Group)
+
 	// KickPlayer kicks the kicked player from the group
 	KickPlayer(ctx context.Context, captainUID, kickedUID string) error
 
@@ -42,9 +55,35 @@ type Match interface {
 	// SetRecentJoinGroup sets whether the group can be joined by recent players
 	SetRecentJoinGroup(ctx context.Context, captainUID string, allow bool) error
 
+	// SetFriendJoinGroup sets whether the group can be joined by the friends of the group players
+	SetFriendJoinGroup(ctx context.Context, captainUID string, allow bool) error
+
+	// SetWorldChannelJoinGroup sets whether the group can be joined by players from world channel
+	SetWorldChannelJoinGroup(ctx context.Context, captainUID string, allow bool) error
+
+	// SetClanChannelJoinGroup sets whether the group can be joined by clan members from clan channel
+	SetClanChannelJoinGroup(ctx context.Context, captainUID string, allow bool) error
+
+	// ShareGroup generates a signed share token of the group of the player,
+	// other players can enter the group with the token before it expires at `expireAt`
+	ShareGroup(ctx context.Context, uid string) (token string, expireAt int64, err error)
+
+	// ReportLocation reports the coordinates of the player to be found by nearby players
+	ReportLocation(ctx context.Context, uid string, lat, lng float64) error
+
+	// NearbyGroups returns the joinable groups of the nearby players, the nearest first
+	NearbyGroups(ctx context.Context, uid string) ([]*pto.JoinableGroup, error)
+
+	// RecentGroups returns the joinable groups of the recent teammates and opponents, the latest first
+	RecentGroups(ctx context.Context, uid string) ([]*pto.JoinableGroup, error)
+
 	// SetVoiceState sets whether the player can speak in the group
 	SetVoiceState(ctx context.Context, uid string, state entry.PlayerVoiceState) error
 
+	// SendGroupMessage sends a text, emote or quick chat message to the group of the player,
+	// it is available until the group starts the game
+	SendGroupMessage(ctx context.Context, uid string, msgType pto.GroupMessageType, content string) error
+
 	// Ready marks the player as ready
 	Ready(ctx context.Context, uid string) error
 
@@ -60,6 +99,41 @@ type Match interface {
 	// ExitGame exits the game (escape)
 	ExitGame(ctx context.Context, uid string, roomID int64) error
 
+	// AcceptMatch accepts the matched room in ready check,
+	// the game starts once all the players of the room accept
+	AcceptMatch(ctx context.Context, uid string, roomID int64) error
+
+	// DeclineMatch declines the matched room in ready check,
+	// the room is dissolved and the other groups go back to match
+	DeclineMatch(ctx context.Context, uid string, roomID int64) error
+
+	// Spectate makes the player watch the game of the matched room as a spectator
+	Spectate(ctx context.Context, uid string, roomID int64) error
+
+	// ExitSpectate makes the spectator leave the room
+	ExitSpectate(ctx context.Context, uid string, roomID int64) error
+
+	// CreateCustomRoom creates a custom room owned by the captain without matching,
+	// the group of the captain is placed in the first team slot
+	CreateCustomRoom(ctx context.Context, uid string, param *pto.CreateCustomRoom) (entry.Room, error)
+
+	// JoinCustomRoom makes the group of the captain join the custom room by the invite code
+	JoinCustomRoom(ctx context.Context, uid, code, password string) error
+
+	// LeaveCustomRoom makes the group of the captain leave the custom room,
+	// the room is dissolved if the owner leaves
+	LeaveCustomRoom(ctx context.Context, uid string, roomID int64) error
+
+	// MoveCustomRoomPlayer moves the group of the target player to the team slot of the custom room,
+	// only the owner can move other players
+	MoveCustomRoomPlayer(ctx context.Context, uid, targetUID string, roomID int64, slot int) error
+
+	// StartCustomRoom starts the game of the custom room once every team slot has players
+	StartCustomRoom(ctx context.Context, uid string, roomID int64) error
+
+	// OpenBackfill requests to fill `slots` players into the team of the in-progress room
+	OpenBackfill(ctx context.Context, roomID, teamID int64, slots int) error
+
 	// UploadPlayerAttr uploads player attributes
 	UploadPlayerAttr(ctx context.Context, uid string, attrs *pto.UploadPlayerAttr) error
 
@@ -68,4 +142,31 @@ type Match interface {
 
 	// HandleGameResult handles the game result
 	HandleGameResult(result *pto.GameResult) error
+
+	// ForceCancelMatch cancels the match of the group by the operators,
+	// the group goes back to `entry.GroupStateInvite` state
+	ForceCancelMatch(ctx context.Context, groupID int64) error
+
+	// ForceDissolveGroup dissolves the group by the operators,
+	// it is available until the group enters a room, clear the room first if needed
+	ForceDissolveGroup(ctx context.Context, groupID int64) error
+
+	// ClearRoom removes the matched room by the operators,
+	// the groups of the room go back to `entry.GroupStateInvite` state
+	ClearRoom(ctx context.Context, roomID int64) error
+
+	// Drain stops accepting StartMatch in the queue for maintenance, empty `queueKey` means all the queues,
+	// the groups already matching keep matching until `timeout`, then the rest are canceled.
+	// The queue keeps draining until Undrain, draining again resets the deadline
+	Drain(ctx context.Context, queueKey string, timeout time.Duration) error
+
+	// Undrain accepts StartMatch in the queue again, the pending cancellation is stopped
+	Undrain(ctx context.Context, queueKey string) error
+
+	// Drains returns the draining queue keys with their deadlines in unix seconds
+	Drains(ctx context.Context) map[string]int64
+
+	// ResumeMatchTimeouts restarts the match timeouts which expired while the queue was paused,
+	// empty `queueKey` means all the queues. It should be called after the queue is resumed
+	ResumeMatchTimeouts(ctx context.Context, queueKey string)
 }
@@ -0,0 +1,51 @@
+package matchimpl
+
+import (
+	"context"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/log"
+)
+
+// clearRoom removes the room by the operators and sends its groups back to the invite state,
+// the caller should hold the lock of the room.
+func (impl *Impl) clearRoom(ctx context.Context, r entry.Room) {
+	impl.removeClearRoomTimer(r.ID())
+	if r.Base().IsCustom() {
+		impl.removeCustomRoomTimer(r.ID())
+		impl.deleteCustomRoomCode(r.Base().Custom.Code)
+	}
+	impl.closeBackfills(r)
+	impl.releaseSpectators(ctx, r)
+	impl.releaseAI(r)
+	impl.rangeRoomGroups(r, func(g entry.Group) {
+		if g != nil {
+			impl.backToInvite(ctx, g)
+		}
+	})
+	for _, teamID := range r.Base().GetTeams() {
+		impl.teamMgr.Delete(teamID)
+	}
+	impl.roomMgr.Delete(r.ID())
+
+	log.Warn().
+		Int64("room_id", r.ID()).
+		Any("room_info", r).
+		Msg("room cleared by operators")
+}
+
+// backToInvite sends the group of a cleared room back to the invite state.
+func (impl *Impl) backToInvite(ctx context.Context, g entry.Group) {
+	g.Base().Lock()
+	defer g.Base().Unlock()
+	if err := impl.setGroupState(ctx, g, entry.GroupStateInvite); err != nil {
+		return
+	}
+	for _, uid := range g.Base().GetPlayers() {
+		p := impl.playerMgr.Get(uid)
+		if p != nil && p.Base().GetOnlineStateWithLock() == entry.PlayerOnlineStateInGame {
+			_ = impl.setPlayerStateWithLock(ctx, p, entry.PlayerOnlineStateInGroup)
+		}
+	}
+	impl.addInviteTimer(g.ID(), g.Base().GameMode)
+}
@@ -0,0 +1,150 @@
+package matchimpl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/pto"
+)
+
+// fillRoomWithAI fills the teams of the room matched with AI to the team player limit,
+// and creates the AI teams for the empty team slots.
+func (impl *Impl) fillRoomWithAI(ctx context.Context, r entry.Room) error {
+	if !r.NeedAI() {
+		return nil
+	}
+
+	args := impl.Configer.Get().GetGlicko2QueueArgs(r.Base().GameMode)
+	if args == nil {
+		return fmt.Errorf("glicko2 queue args not found for AI: %d", r.Base().GameMode)
+	}
+	info := impl.aiGlicko2Info(r)
+	seat := 0
+
+	for _, teamID := range r.Base().GetTeams() {
+		t := impl.teamMgr.Get(teamID)
+		count := impl.getTeamPlayerCount(t)
+		if count >= args.TeamPlayerLimit {
+			continue
+		}
+		g, err := impl.createAIGroup(ctx, r, args.TeamPlayerLimit-count, info, &seat)
+		if err != nil {
+			return err
+		}
+		t.Base().Lock()
+		t.Base().AddGroup(g)
+		t.Base().Unlock()
+	}
+
+	for i := len(r.Base().GetTeams()); i < r.Base().TeamLimit; i++ {
+		g, err := impl.createAIGroup(ctx, r, args.TeamPlayerLimit, info, &seat)
+		if err != nil {
+			return err
+		}
+		t, err := impl.mgrs.CreateAITeam(g)
+		if err != nil {
+			return err
+		}
+		impl.teamMgr.Add(t.ID(), t)
+		r.Base().AddTeam(t)
+	}
+	return nil
+}
+
+// createAIGroup creates a group of `size` AI players with the rating of info,
+// the uids of the AI players are unique by the room id and the seat.
+// The AI players and group go in game through the state machines, the same path as the matched ones.
+func (impl *Impl) createAIGroup(ctx context.Context, r entry.Room, size int, info pto.Glicko2Info, seat *int) (entry.Group, error) {
+	var g entry.Group
+	for i := 0; i < size; i++ {
+		*seat++
+		p, err := impl.mgrs.CreatePlayer(&pto.PlayerInfo{
+			UID:         fmt.Sprintf("ai-%d-%d", r.ID(), *seat),
+			GameMode:    r.Base().GameMode,
+			ModeVersion: r.Base().ModeVersion,
+			Glicko2Info: &info,
+		})
+		if err != nil {
+			return nil, err
+		}
+		p.Base().IsAI = true
+		p.Base().MatchStrategy = r.Base().MatchStrategy
+		for _, s := range []entry.PlayerOnlineState{entry.PlayerOnlineStateInGroup, entry.PlayerOnlineStateInGame} {
+			if err := impl.setPlayerStateWithLock(ctx, p, s); err != nil {
+				return nil, err
+			}
+		}
+		if g == nil {
+			if g, err = impl.mgrs.CreateGroup(size, p); err != nil {
+				return nil, err
+			}
+			g.Base().IsAI = true
+			g.Base().MatchStrategy = r.Base().MatchStrategy
+			continue
+		}
+		if err := g.Base().AddPlayer(p); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range []entry.GroupState{entry.GroupStateMatch, entry.GroupStateGame} {
+		if err := impl.setGroupStateWithLock(ctx, g, s); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// aiGlicko2Info returns the rating of the AI players, which is the average of the real teams in the room.
+func (impl *Impl) aiGlicko2Info(r entry.Room) pto.Glicko2Info {
+	var info pto.Glicko2Info
+	n := 0
+	for _, teamID := range r.Base().GetTeams() {
+		t, ok := impl.teamMgr.Get(teamID).(interface {
+			GetMMR() float64
+			GetStar() int
+		})
+		if !ok {
+			continue
+		}
+		info.MMR += t.GetMMR()
+		info.Star += int64(t.GetStar())
+		n++
+	}
+	if n > 0 {
+		info.MMR /= float64(n)
+		info.Star /= int64(n)
+	}
+	return info
+}
+
+// releaseAI deletes the AI players, groups and teams of the room when the room is released,
+// the caller should hold the lock of the room.
+func (impl *Impl) releaseAI(r entry.Room) {
+	if !r.NeedAI() {
+		return
+	}
+	for _, teamID := range r.Base().GetTeams() {
+		t := impl.teamMgr.Get(teamID)
+		if t == nil {
+			continue
+		}
+		t.Base().Lock()
+		for _, groupID := range t.Base().GetGroups() {
+			g := impl.groupMgr.Get(groupID)
+			if g == nil || !g.Base().IsAI {
+				continue
+			}
+			for _, uid := range g.Base().GetPlayers() {
+				impl.playerMgr.Delete(uid)
+			}
+			impl.groupMgr.Delete(groupID)
+			t.Base().RemoveGroup(groupID)
+		}
+		t.Base().Unlock()
+		if t.Base().IsAI {
+			impl.teamMgr.Delete(teamID)
+			r.Base().RemoveTeam(teamID)
+		}
+	}
+}
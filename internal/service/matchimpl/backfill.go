@@ -0,0 +1,80 @@
+package matchimpl
+
+import (
+	"context"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/log"
+	"github.com/hedon954/go-matcher/internal/matcher/common"
+	"github.com/hedon954/go-matcher/internal/merr"
+)
+
+func (impl *Impl) openBackfill(_ context.Context, r entry.Room, t entry.Team, slots int) error {
+	t.Base().Lock()
+	t.Base().OpenSlots = slots
+	t.Base().Unlock()
+	return impl.backfill.OpenBackfill(r, t, slots)
+}
+
+// escapeBackfill opens one more slot of the team for the escaped player.
+func (impl *Impl) escapeBackfill(ctx context.Context, r entry.Room, t entry.Team) {
+	t.Base().RLock()
+	slots := t.Base().OpenSlots + 1
+	t.Base().RUnlock()
+	if err := impl.openBackfill(ctx, r, t, slots); err != nil {
+		log.Error().
+			Int64("room_id", r.ID()).
+			Int64("team_id", t.ID()).
+			Err(err).
+			Msg("open backfill for escape error")
+	}
+}
+
+// closeBackfills cancels the backfill requests of all the teams in the room.
+func (impl *Impl) closeBackfills(r entry.Room) {
+	for _, teamID := range r.Base().GetTeams() {
+		t := impl.teamMgr.Get(teamID)
+		t.Base().Lock()
+		open := t.Base().OpenSlots > 0
+		t.Base().OpenSlots = 0
+		t.Base().Unlock()
+		if open {
+			impl.backfill.CloseBackfill(r, t)
+		}
+	}
+}
+
+func (impl *Impl) handleBackfillResult(ctx context.Context, result common.Result) error {
+	r := result.Room
+	t := result.Backfill.Team
+	groups := result.Backfill.Groups
+
+	// the room has been cleared, send the groups back to match
+	if impl.roomMgr.Get(r.ID()) == nil {
+		for _, g := range groups {
+			if err := impl.setGroupStateWithLock(ctx, g, entry.GroupStateMatch); err == nil {
+				impl.sendGroupToChannel(g)
+			}
+		}
+		return merr.ErrRoomNotExists
+	}
+
+	filled := 0
+	t.Base().Lock()
+	for _, g := range groups {
+		t.Base().AddGroup(g)
+		filled += len(g.Base().GetPlayers())
+	}
+	t.Base().OpenSlots = max(t.Base().OpenSlots-filled, 0)
+	t.Base().Unlock()
+
+	for _, g := range groups {
+		impl.removeWaitAttrTimer(g.ID())
+		impl.removeCancelMatchTimer(g.ID())
+		impl.updateGroupStateToGame(ctx, g)
+	}
+
+	// all the players of the room should know who joined which team
+	impl.pushService.PushMatchInfo(ctx, impl.getRoomUIDs(r), impl.getMatchInfo(r))
+	return nil
+}
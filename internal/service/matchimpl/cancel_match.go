@@ -4,24 +4,20 @@ import (
 	"context"
 
 	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/pto"
 )
 
-func (impl *Impl) cancelMatch(ctx context.Context, cancelUID string, g entry.Group) {
+func (impl *Impl) cancelMatch(ctx context.Context, cancelUID string, reason pto.CancelMatchReason, g entry.Group) {
 	base := g.Base()
-	base.SetState(entry.GroupStateInvite)
+	if err := impl.setGroupState(ctx, g, entry.GroupStateInvite); err != nil {
+		return
+	}
 	base.MatchID = ""
 
-	uids := base.UIDs()
-	for _, uid := range uids {
-		p := impl.playerMgr.Get(uid)
-		p.Base().Lock()
-		p.Base().SetOnlineState(entry.PlayerOnlineStateInGroup)
-		p.Base().Unlock()
+	for _, uid := range base.UIDs() {
+		_ = impl.setPlayerStateWithLock(ctx, impl.playerMgr.Get(uid), entry.PlayerOnlineStateInGroup)
 	}
-	impl.pushService.PushGroupState(ctx, uids, g.ID(), base.GetState())
-	impl.pushService.PushCancelMatch(ctx, base.UIDs(), cancelUID)
+	impl.pushService.PushCancelMatch(ctx, base.UIDs(), cancelUID, reason)
 
-	impl.removeCancelMatchTimer(g.ID())
-	impl.removeWaitAttrTimer(g.ID())
 	impl.addInviteTimer(g.ID(), base.GameMode)
 }
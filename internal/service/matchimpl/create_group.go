@@ -1,6 +1,8 @@
 package matchimpl
 
 import (
+	"context"
+
 	"github.com/hedon954/go-matcher/internal/entry"
 	"github.com/hedon954/go-matcher/internal/pto"
 )
@@ -19,14 +21,18 @@ func (impl *Impl) getPlayer(param *pto.PlayerInfo) (entry.Player, error) {
 
 // createGroup creates group, and adds the player to it,
 // current play would be the captain of the group.
-func (impl *Impl) createGroup(p entry.Player) (entry.Group, error) {
-	g, err := impl.mgrs.CreateGroup(impl.Configer.Get().GroupPlayerLimit, p)
+func (impl *Impl) createGroup(ctx context.Context, p entry.Player) (entry.Group, error) {
+	mc := impl.Configer.Get()
+	g, err := impl.mgrs.CreateGroup(mc.GetGroupPlayerLimit(p.Base().GameMode), p)
 	if err != nil {
 		return nil, err
 	}
+	if sec := mc.GetInviteExpireSec(p.Base().GameMode); sec > 0 {
+		g.Base().Configs.InviteExpireSec = sec
+	}
 
 	p.Base().GroupID = g.ID()
-	p.Base().SetOnlineState(entry.PlayerOnlineStateInGroup)
+	_ = impl.setPlayerState(ctx, p, entry.PlayerOnlineStateInGroup)
 
 	impl.addInviteTimer(g.ID(), g.Base().GameMode)
 	return g, nil
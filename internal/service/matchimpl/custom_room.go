@@ -0,0 +1,309 @@
+package matchimpl
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/merr"
+	"github.com/hedon954/go-matcher/internal/pto"
+)
+
+const (
+	// customRoomCodeMax bounds the 6 digits invite code of custom rooms.
+	customRoomCodeMax = 1000000
+	// customRoomCodeAttempts bounds the attempts to generate an unused invite code.
+	customRoomCodeAttempts = 100
+)
+
+func (impl *Impl) createCustomRoom(
+	ctx context.Context, uid string, g entry.Group, param *pto.CreateCustomRoom,
+) (entry.Room, error) {
+	t, err := impl.mgrs.CreateTeam(g)
+	if err != nil {
+		return nil, err
+	}
+	r, err := impl.mgrs.CreateRoom(param.TeamLimit, t)
+	if err != nil {
+		return nil, err
+	}
+	code, err := impl.genCustomRoomCode(r.ID())
+	if err != nil {
+		return nil, err
+	}
+	t.Base().TeamID = 1
+	r.Base().Custom = &entry.CustomRoom{
+		Owner:           uid,
+		Code:            code,
+		Password:        param.Password,
+		TeamPlayerLimit: param.TeamPlayerLimit,
+	}
+	impl.teamMgr.Add(t.ID(), t)
+	impl.roomMgr.Add(r.ID(), r)
+	impl.addCustomRoomTimer(r.ID(), r.Base().GameMode)
+
+	impl.enterCustomRoom(ctx, g)
+	impl.pushCustomRoomInfo(ctx, r)
+	return r, nil
+}
+
+// joinCustomRoom puts the group into the first team slot which has enough space.
+func (impl *Impl) joinCustomRoom(ctx context.Context, r entry.Room, g entry.Group) error {
+	teams := impl.getCustomRoomTeams(r)
+	size := len(g.Base().GetPlayers())
+	slot := 0
+	for i := 1; i <= r.Base().TeamLimit; i++ {
+		if impl.getTeamPlayerCount(teams[i])+size <= r.Base().Custom.TeamPlayerLimit {
+			slot = i
+			break
+		}
+	}
+	if slot == 0 {
+		return merr.ErrCustomRoomFull
+	}
+
+	if err := impl.putGroupToSlot(r, g, slot); err != nil {
+		return err
+	}
+	impl.enterCustomRoom(ctx, g)
+	impl.pushCustomRoomInfo(ctx, r)
+	return nil
+}
+
+// moveCustomRoomGroup moves the group from team t to the team slot.
+func (impl *Impl) moveCustomRoomGroup(ctx context.Context, r entry.Room, t entry.Team, g entry.Group, slot int) error {
+	if t.Base().TeamID == slot {
+		return nil
+	}
+	teams := impl.getCustomRoomTeams(r)
+	if impl.getTeamPlayerCount(teams[slot])+len(g.Base().GetPlayers()) > r.Base().Custom.TeamPlayerLimit {
+		return merr.ErrTeamSlotFull
+	}
+
+	impl.removeGroupFromSlot(r, t, g)
+	if err := impl.putGroupToSlot(r, g, slot); err != nil {
+		return err
+	}
+	impl.pushCustomRoomInfo(ctx, r)
+	return nil
+}
+
+// leaveCustomRoom removes the group from the room,
+// the room is dissolved if the group of the owner leaves.
+func (impl *Impl) leaveCustomRoom(ctx context.Context, r entry.Room, t entry.Team, g entry.Group) {
+	if g.Base().PlayerExists(r.Base().Custom.Owner) {
+		impl.dissolveCustomRoom(ctx, r)
+		return
+	}
+
+	impl.removeGroupFromSlot(r, t, g)
+	impl.exitCustomRoom(ctx, g)
+	impl.pushCustomRoomInfo(ctx, r)
+}
+
+// exitCustomRoomGroup removes the player exiting its group from the custom room,
+// the room is dissolved if the owner exits, and the team slot is freed if the group becomes empty.
+func (impl *Impl) exitCustomRoomGroup(ctx context.Context, r entry.Room, p entry.Player, g entry.Group) error {
+	var t entry.Team
+	if r != nil {
+		t = impl.getTeamOfGroup(r, g.ID())
+	}
+	if t == nil {
+		// the group joined the room after the room was looked up
+		return merr.ErrGroupInCustomRoom
+	}
+
+	if p.UID() == r.Base().Custom.Owner {
+		impl.dissolveCustomRoom(ctx, r)
+		impl.deletePlayer(p.UID())
+		return impl.exitGroup(ctx, p, g)
+	}
+
+	impl.deletePlayer(p.UID())
+	if err := impl.exitGroup(ctx, p, g); err != nil {
+		return err
+	}
+	if len(g.Base().GetPlayers()) == 0 {
+		impl.removeGroupFromSlot(r, t, g)
+	}
+	impl.pushCustomRoomInfo(ctx, r)
+	return nil
+}
+
+// dissolveCustomRoom sends all the groups of the room back to invite state and removes the room.
+func (impl *Impl) dissolveCustomRoom(ctx context.Context, r entry.Room) {
+	impl.removeCustomRoomTimer(r.ID())
+	uids := impl.getRoomUIDs(r)
+	impl.rangeRoomGroups(r, func(g entry.Group) {
+		impl.exitCustomRoom(ctx, g)
+	})
+	for _, teamID := range r.Base().GetTeams() {
+		impl.teamMgr.Delete(teamID)
+	}
+	impl.roomMgr.Delete(r.ID())
+	impl.deleteCustomRoomCode(r.Base().Custom.Code)
+	impl.pushService.PushCustomRoomDissolve(ctx, uids, r.ID())
+}
+
+// startCustomRoom starts the game of the custom room without matching,
+// the room goes through the same game server dispatching as the matched rooms.
+func (impl *Impl) startCustomRoom(ctx context.Context, r entry.Room) error {
+	r.Base().Custom.Started = true
+	r.Base().FinishMatchSec = impl.nowFunc()
+	impl.removeCustomRoomTimer(r.ID())
+	impl.deleteCustomRoomCode(r.Base().Custom.Code)
+	impl.rangeRoomGroups(r, func(g entry.Group) {
+		impl.removeInviteTimer(g.ID())
+	})
+	return impl.startGame(ctx, r)
+}
+
+func (impl *Impl) enterCustomRoom(ctx context.Context, g entry.Group) {
+	_ = impl.setGroupStateWithLock(ctx, g, entry.GroupStateCustomRoom)
+}
+
+func (impl *Impl) exitCustomRoom(ctx context.Context, g entry.Group) {
+	g.Base().Lock()
+	defer g.Base().Unlock()
+	if err := impl.setGroupState(ctx, g, entry.GroupStateInvite); err != nil {
+		return
+	}
+	impl.addInviteTimer(g.ID(), g.Base().GameMode)
+}
+
+// putGroupToSlot adds the group to the team of the slot, the team is created if the slot is empty.
+func (impl *Impl) putGroupToSlot(r entry.Room, g entry.Group, slot int) error {
+	if t := impl.getCustomRoomTeams(r)[slot]; t != nil {
+		t.Base().Lock()
+		t.Base().AddGroup(g)
+		t.Base().Unlock()
+		return nil
+	}
+
+	t, err := impl.mgrs.CreateTeam(g)
+	if err != nil {
+		return err
+	}
+	t.Base().TeamID = slot
+	impl.teamMgr.Add(t.ID(), t)
+	r.Base().AddTeam(t)
+	return nil
+}
+
+// removeGroupFromSlot removes the group from its team, the team is removed if it becomes empty.
+func (impl *Impl) removeGroupFromSlot(r entry.Room, t entry.Team, g entry.Group) {
+	t.Base().Lock()
+	t.Base().RemoveGroup(g.ID())
+	empty := len(t.Base().GetGroups()) == 0
+	t.Base().Unlock()
+	if empty {
+		r.Base().RemoveTeam(t.ID())
+		impl.teamMgr.Delete(t.ID())
+	}
+}
+
+// getCustomRoomTeams returns the teams of the custom room by their slots.
+func (impl *Impl) getCustomRoomTeams(r entry.Room) map[int]entry.Team {
+	res := make(map[int]entry.Team, r.Base().TeamLimit)
+	for _, teamID := range r.Base().GetTeams() {
+		t := impl.teamMgr.Get(teamID)
+		res[t.Base().TeamID] = t
+	}
+	return res
+}
+
+func (impl *Impl) getTeamPlayerCount(t entry.Team) int {
+	if t == nil {
+		return 0
+	}
+	t.Base().RLock()
+	groups := t.Base().GetGroups()
+	t.Base().RUnlock()
+	count := 0
+	for _, groupID := range groups {
+		count += len(impl.groupMgr.Get(groupID).Base().GetPlayers())
+	}
+	return count
+}
+
+func (impl *Impl) pushCustomRoomInfo(ctx context.Context, r entry.Room) {
+	base := r.Base()
+	info := &pto.CustomRoomInfo{
+		RoomID:          r.ID(),
+		Code:            base.Custom.Code,
+		Owner:           base.Custom.Owner,
+		GameMode:        base.GameMode,
+		ModeVersion:     base.ModeVersion,
+		TeamPlayerLimit: base.Custom.TeamPlayerLimit,
+		Teams:           make([][]string, base.TeamLimit),
+	}
+	for slot, t := range impl.getCustomRoomTeams(r) {
+		t.Base().RLock()
+		groups := t.Base().GetGroups()
+		t.Base().RUnlock()
+		for _, groupID := range groups {
+			info.Teams[slot-1] = append(info.Teams[slot-1], impl.groupMgr.Get(groupID).Base().UIDs()...)
+		}
+	}
+	impl.pushService.PushCustomRoomInfo(ctx, impl.getRoomUIDs(r), info)
+}
+
+// genCustomRoomCode generates a unique 6 digits invite code for the custom room,
+// merr.ErrCustomRoomCodeExhausted is returned if no unused code is found within the attempts.
+func (impl *Impl) genCustomRoomCode(roomID int64) (string, error) {
+	impl.customRoomLock.Lock()
+	defer impl.customRoomLock.Unlock()
+	for i := 0; i < customRoomCodeAttempts; i++ {
+		code := fmt.Sprintf("%06d", rand.IntN(customRoomCodeMax)) //nolint:gosec
+		if _, ok := impl.customRoomCodes[code]; !ok {
+			impl.customRoomCodes[code] = roomID
+			return code, nil
+		}
+	}
+	return "", merr.ErrCustomRoomCodeExhausted
+}
+
+// getCustomRoomByCode returns the custom room of the invite code, nil if not found.
+func (impl *Impl) getCustomRoomByCode(code string) entry.Room {
+	impl.customRoomLock.Lock()
+	roomID, ok := impl.customRoomCodes[code]
+	impl.customRoomLock.Unlock()
+	if !ok {
+		return nil
+	}
+	return impl.roomMgr.Get(roomID)
+}
+
+// getCustomRoomOfGroup returns the custom room waiting for players which the group is in, nil if not found.
+func (impl *Impl) getCustomRoomOfGroup(g entry.Group) entry.Room {
+	if g.Base().GetStateWithLock() != entry.GroupStateCustomRoom {
+		return nil
+	}
+	impl.customRoomLock.Lock()
+	roomIDs := make([]int64, 0, len(impl.customRoomCodes))
+	for _, roomID := range impl.customRoomCodes {
+		roomIDs = append(roomIDs, roomID)
+	}
+	impl.customRoomLock.Unlock()
+
+	for _, roomID := range roomIDs {
+		r := impl.roomMgr.Get(roomID)
+		if r == nil {
+			continue
+		}
+		r.Base().RLock()
+		t := impl.getTeamOfGroup(r, g.ID())
+		r.Base().RUnlock()
+		if t != nil {
+			return r
+		}
+	}
+	return nil
+}
+
+func (impl *Impl) deleteCustomRoomCode(code string) {
+	impl.customRoomLock.Lock()
+	defer impl.customRoomLock.Unlock()
+	delete(impl.customRoomCodes, code)
+}
@@ -2,11 +2,13 @@ package matchimpl
 
 import (
 	"context"
+	"time"
 
 	"github.com/rs/zerolog/log"
 
 	"github.com/hedon954/go-matcher/internal/constant"
 	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/pto"
 	"github.com/hedon954/go-matcher/pkg/timer"
 )
 
@@ -25,6 +27,18 @@ const (
 	// TimeOpTypeClearRoom used to clear room in some unexpected cases like client do not settle game.
 	// We use this optype to force clear the room info.
 	TimeOpTypeClearRoom timer.OpType = "match:timer_clear_room"
+
+	// TimerOpTypeRoomReadyCheck used to fail the ready check of the room
+	// if not all players accept the match before the deadline.
+	TimerOpTypeRoomReadyCheck timer.OpType = "match:timer_room_ready_check"
+
+	// TimerOpTypeInviteExpire used to delete the invitation and notify the inviter and the invitee
+	// if the invitee does not respond before it expires.
+	TimerOpTypeInviteExpire timer.OpType = "match:timer_invite_expire"
+
+	// TimerOpTypeCustomRoom used to dissolve the custom room if it does not start within the invite timeout,
+	// like the groups waiting in invite state.
+	TimerOpTypeCustomRoom timer.OpType = "match:timer_custom_room"
 )
 
 func (impl *Impl) initDelayTimer() {
@@ -32,6 +46,9 @@ func (impl *Impl) initDelayTimer() {
 	impl.delayTimer.Register(TimerOpTypeGroupMatch, impl.matchTimeoutHandler)
 	impl.delayTimer.Register(TimerOpTypeGroupWaitAttr, impl.waitAttrTimeoutHandler)
 	impl.delayTimer.Register(TimeOpTypeClearRoom, impl.clearRoomTimeoutHandler)
+	impl.delayTimer.Register(TimerOpTypeRoomReadyCheck, impl.readyCheckTimeoutHandler)
+	impl.delayTimer.Register(TimerOpTypeInviteExpire, impl.inviteExpireHandler)
+	impl.delayTimer.Register(TimerOpTypeCustomRoom, impl.customRoomTimeoutHandler)
 }
 
 func (impl *Impl) inviteTimeoutHandler(groupID int64) {
@@ -54,9 +71,14 @@ func (impl *Impl) matchTimeoutHandler(groupID int64) {
 	if g != nil {
 		g.Base().Lock()
 		defer g.Base().Unlock()
-		if g.Base().GetState() == entry.GroupStateMatch {
-			impl.cancelMatch(context.Background(), "", g)
+		if g.Base().GetState() != entry.GroupStateMatch {
+			return
+		}
+		// the groups are not matched while the queue is paused, so the timeout is restarted when resumed
+		if impl.paused(g) {
+			return
 		}
+		impl.cancelMatch(context.Background(), "", pto.CancelMatchReasonTimeout, g)
 	}
 }
 
@@ -75,6 +97,11 @@ func (impl *Impl) clearRoomTimeoutHandler(roomID int64) {
 	r := impl.roomMgr.Get(roomID)
 	if r != nil {
 		impl.roomMgr.Delete(roomID)
+		r.Base().Lock()
+		impl.closeBackfills(r)
+		impl.releaseSpectators(context.Background(), r)
+		impl.releaseAI(r)
+		r.Base().Unlock()
 		log.Warn().
 			Int64("room_id", roomID).
 			Any("room_info", r).
@@ -82,9 +109,37 @@ func (impl *Impl) clearRoomTimeoutHandler(roomID int64) {
 	}
 }
 
+func (impl *Impl) readyCheckTimeoutHandler(roomID int64) {
+	r := impl.roomMgr.Get(roomID)
+	if r != nil {
+		r.Base().Lock()
+		defer r.Base().Unlock()
+		if r.Base().InReadyCheck() {
+			impl.cancelReadyCheck(context.Background(), r, impl.getUnacceptedUIDs(r))
+		}
+	}
+}
+
+func (impl *Impl) inviteExpireHandler(inviteID int64) {
+	impl.expireInvite(context.Background(), inviteID)
+}
+
+func (impl *Impl) customRoomTimeoutHandler(roomID int64) {
+	r := impl.roomMgr.Get(roomID)
+	if r != nil && r.Base().IsCustom() {
+		r.Base().Lock()
+		defer r.Base().Unlock()
+		// the room may be dissolved or started before getting the lock
+		if impl.roomMgr.Get(roomID) != nil && !r.Base().Custom.Started {
+			impl.dissolveCustomRoom(context.Background(), r)
+			log.Warn().Int64("room_id", roomID).Msg("custom room timeout")
+		}
+	}
+}
+
 func (impl *Impl) addInviteTimer(groupID int64, mode constant.GameMode) {
 	err := impl.delayTimer.Add(TimerOpTypeGroupInvite, groupID,
-		impl.Configer.Get().DelayTimerConfig.InviteTimeout())
+		impl.Configer.Get().GetDelayTimerConfig(mode).InviteTimeout())
 	if err != nil {
 		log.Error().
 			Int64("group_id", groupID).
@@ -100,7 +155,7 @@ func (impl *Impl) removeInviteTimer(groupID int64) {
 
 func (impl *Impl) addCancelMatchTimer(groupID int64, mode constant.GameMode) {
 	err := impl.delayTimer.Add(TimerOpTypeGroupMatch, groupID,
-		impl.Configer.Get().DelayTimerConfig.MatchTimeout())
+		impl.Configer.Get().GetDelayTimerConfig(mode).MatchTimeout())
 	if err != nil {
 		log.Error().
 			Int64("group_id", groupID).
@@ -116,7 +171,7 @@ func (impl *Impl) removeCancelMatchTimer(groupID int64) {
 
 func (impl *Impl) addWaitAttrTimer(groupID int64, mode constant.GameMode) {
 	err := impl.delayTimer.Add(TimerOpTypeGroupWaitAttr, groupID,
-		impl.Configer.Get().DelayTimerConfig.WaitAttrTimeout())
+		impl.Configer.Get().GetDelayTimerConfig(mode).WaitAttrTimeout())
 	if err != nil {
 		log.Error().
 			Int64("group_id", groupID).
@@ -132,7 +187,7 @@ func (impl *Impl) removeWaitAttrTimer(groupID int64) {
 
 func (impl *Impl) addClearRoomTimer(roomID int64, mode constant.GameMode) {
 	err := impl.delayTimer.Add(TimeOpTypeClearRoom, roomID,
-		impl.Configer.Get().DelayTimerConfig.ClearRoomTimeout())
+		impl.Configer.Get().GetDelayTimerConfig(mode).ClearRoomTimeout())
 	if err != nil {
 		log.Error().
 			Int64("room_id", roomID).
@@ -145,3 +200,49 @@ func (impl *Impl) addClearRoomTimer(roomID int64, mode constant.GameMode) {
 func (impl *Impl) removeClearRoomTimer(roomID int64) {
 	_ = impl.delayTimer.Remove(TimeOpTypeClearRoom, roomID)
 }
+
+func (impl *Impl) addReadyCheckTimer(roomID int64, mode constant.GameMode, timeout time.Duration) {
+	err := impl.delayTimer.Add(TimerOpTypeRoomReadyCheck, roomID, timeout)
+	if err != nil {
+		log.Error().
+			Int64("room_id", roomID).
+			Int("mode", int(mode)).
+			Err(err).
+			Msg("add ready check timer error")
+	}
+}
+
+func (impl *Impl) removeReadyCheckTimer(roomID int64) {
+	_ = impl.delayTimer.Remove(TimerOpTypeRoomReadyCheck, roomID)
+}
+
+func (impl *Impl) addInviteExpireTimer(inviteID int64, mode constant.GameMode, expireSec int64) {
+	err := impl.delayTimer.Add(TimerOpTypeInviteExpire, inviteID, time.Second*time.Duration(expireSec))
+	if err != nil {
+		log.Error().
+			Int64("invite_id", inviteID).
+			Int("mode", int(mode)).
+			Err(err).
+			Msg("add invite expire timer error")
+	}
+}
+
+func (impl *Impl) removeInviteExpireTimer(inviteID int64) {
+	_ = impl.delayTimer.Remove(TimerOpTypeInviteExpire, inviteID)
+}
+
+func (impl *Impl) addCustomRoomTimer(roomID int64, mode constant.GameMode) {
+	err := impl.delayTimer.Add(TimerOpTypeCustomRoom, roomID,
+		impl.Configer.Get().GetDelayTimerConfig(mode).InviteTimeout())
+	if err != nil {
+		log.Error().
+			Int64("room_id", roomID).
+			Int("mode", int(mode)).
+			Err(err).
+			Msg("add custom room timer error")
+	}
+}
+
+func (impl *Impl) removeCustomRoomTimer(roomID int64) {
+	_ = impl.delayTimer.Remove(TimerOpTypeCustomRoom, roomID)
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
-	"github.com/hedon954/go-matcher/internal/config/mock"
 	"github.com/hedon954/go-matcher/internal/entry"
 )
 
@@ -18,9 +17,7 @@ const (
 )
 
 func TestImpl_inviteTimeoutHandler_shouldWork(t *testing.T) {
-	impl := defaultImpl(1,
-		WithMatchStrategyConfiger(new(mock.MatchStrategyMock)),
-	)
+	impl := defaultImpl(1)
 
 	g, err := impl.CreateGroup(ctx, newCreateGroupParam(UID))
 	assert.Nil(t, err)
@@ -53,3 +50,16 @@ func TestImpl_waitAttrTimeoutHandler_shouldWork(t *testing.T) {
 	time.Sleep(waitAttrTimeoutMs + 3*time.Millisecond)
 	assert.Nil(t, impl.delayTimer.Get(TimerOpTypeGroupWaitAttr, g.ID()))
 }
+
+func TestImpl_readyCheckTimeoutHandler_shouldWork(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	r, g1, g2 := createReadyCheckRoom(impl, 50, t)
+	assert.Nil(t, impl.AcceptMatch(ctx, UID, r.ID()))
+
+	// the player who did not accept in time is penalized
+	assert.Equal(t, g1, <-impl.groupChannel)
+	assert.Nil(t, impl.roomMgr.Get(r.ID()))
+	assert.Equal(t, entry.GroupStateInvite, g2.Base().GetStateWithLock())
+	assert.True(t, impl.playerMgr.Get(UID+"1").Base().PenaltyUntilSec > 0)
+	assert.Equal(t, int64(0), impl.playerMgr.Get(UID).Base().PenaltyUntilSec)
+}
@@ -0,0 +1,239 @@
+package matchimpl
+
+import (
+	"sync"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/pto"
+	"github.com/hedon954/go-matcher/pkg/geo"
+)
+
+// recentPlayer is a teammate or opponent a player met in a completed room.
+type recentPlayer struct {
+	UID         string
+	Relation    pto.RecentRelation
+	PlayedAtSec int64
+}
+
+// recentPlayers records the recent players of each player, the latest first.
+type recentPlayers struct {
+	sync.Mutex
+	players    map[string][]recentPlayer
+	sweptAtSec int64
+}
+
+func newRecentPlayers() *recentPlayers {
+	return &recentPlayers{players: make(map[string][]recentPlayer)}
+}
+
+// add puts the players in front of the recent list of uid,
+// the old records of the same players are replaced, and at most limit records are kept.
+// The records played before `nowSec-expireSec` are pruned, the lists of the other players
+// are swept at most once per `expireSec`, so the players never playing again are dropped.
+func (rp *recentPlayers) add(uid string, players []recentPlayer, limit int, nowSec, expireSec int64) {
+	rp.Lock()
+	defer rp.Unlock()
+
+	afterSec := nowSec - expireSec
+	if nowSec-rp.sweptAtSec >= expireSec {
+		for other, list := range rp.players {
+			rp.prune(other, list, afterSec)
+		}
+		rp.sweptAtSec = nowSec
+	}
+
+	added := make(map[string]struct{}, len(players))
+	for _, p := range players {
+		added[p.UID] = struct{}{}
+	}
+	list := make([]recentPlayer, 0, len(players)+len(rp.players[uid]))
+	list = append(list, players...)
+	for _, p := range rp.players[uid] {
+		if _, ok := added[p.UID]; !ok {
+			list = append(list, p)
+		}
+	}
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	rp.prune(uid, list, afterSec)
+}
+
+// list returns the recent players of uid played after `afterSec`, the expired ones are dropped.
+func (rp *recentPlayers) list(uid string, afterSec int64) []recentPlayer {
+	rp.Lock()
+	defer rp.Unlock()
+
+	return append([]recentPlayer(nil), rp.prune(uid, rp.players[uid], afterSec)...)
+}
+
+// prune drops the records of the list played before `afterSec` and stores it as the list of uid,
+// the empty list is deleted.
+func (rp *recentPlayers) prune(uid string, list []recentPlayer, afterSec int64) []recentPlayer {
+	for i, p := range list {
+		if p.PlayedAtSec <= afterSec {
+			list = list[:i]
+			break
+		}
+	}
+	if len(list) == 0 {
+		delete(rp.players, uid)
+		return nil
+	}
+	rp.players[uid] = list
+	return list
+}
+
+// recordRecentPlayers records the teammates and opponents of each real player in the completed room.
+func (impl *Impl) recordRecentPlayers(r entry.Room) {
+	teams := make(map[int64][]string)
+	for _, teamID := range r.Base().GetTeams() {
+		t := impl.teamMgr.Get(teamID)
+		if t == nil {
+			continue
+		}
+		t.Base().Lock()
+		groups := t.Base().GetGroups()
+		t.Base().Unlock()
+		for _, groupID := range groups {
+			g := impl.groupMgr.Get(groupID)
+			if g == nil {
+				continue
+			}
+			g.Base().Lock()
+			for _, uid := range g.Base().GetPlayers() {
+				if p := impl.playerMgr.Get(uid); p != nil && !p.Base().IsAI {
+					teams[teamID] = append(teams[teamID], uid)
+				}
+			}
+			g.Base().Unlock()
+		}
+	}
+
+	now := impl.nowFunc()
+	dc := impl.Configer.Get().Discovery
+	limit, expireSec := dc.GetRecentPlayerLimit(), dc.GetRecentExpireSec()
+	for teamID, uids := range teams {
+		for _, uid := range uids {
+			var players []recentPlayer
+			for otherTeamID, others := range teams {
+				relation := pto.RecentRelationOpponent
+				if otherTeamID == teamID {
+					relation = pto.RecentRelationTeammate
+				}
+				for _, other := range others {
+					if other != uid {
+						players = append(players, recentPlayer{UID: other, Relation: relation, PlayedAtSec: now})
+					}
+				}
+			}
+			impl.recentPlayers.add(uid, players, limit, now, expireSec)
+		}
+	}
+}
+
+func (impl *Impl) reportLocation(p entry.Player, point geo.Point) {
+	impl.geoIndex.Update(p.UID(), point, impl.nowFunc())
+}
+
+// nearbyGroups returns the joinable groups of the players near the point, the nearest first,
+// the stale locations found are removed from the geo index.
+func (impl *Impl) nearbyGroups(p entry.Player, point geo.Point) []*pto.JoinableGroup {
+	dc := impl.Configer.Get().Discovery
+	expireBefore := impl.nowFunc() - dc.GetLocationExpireSec()
+	var stale []string
+	neighbors := impl.geoIndex.Nearby(point, dc.GetNearbyRadiusKm(), 0, func(n geo.Neighbor) bool {
+		if n.UpdatedAtSec <= expireBefore {
+			stale = append(stale, n.ID)
+			return false
+		}
+		return n.ID != p.UID()
+	})
+	for _, uid := range stale {
+		impl.geoIndex.Remove(uid)
+	}
+
+	// only the players in the joinable groups count towards the nearby limit
+	res := make([]*pto.JoinableGroup, 0, min(len(neighbors), dc.GetListLimit()))
+	seen := make(map[int64]struct{}, len(neighbors))
+	joinable := 0
+	for _, n := range neighbors {
+		jg := impl.joinableGroup(p, n.ID, (*entry.GroupBase).AllowNearbyJoin)
+		if jg == nil {
+			continue
+		}
+		if joinable++; joinable > dc.GetNearbyLimit() {
+			break
+		}
+		if _, ok := seen[jg.GroupID]; ok {
+			continue
+		}
+		seen[jg.GroupID] = struct{}{}
+		jg.DistanceKm = n.DistanceKm
+		if res = append(res, jg); len(res) >= dc.GetListLimit() {
+			break
+		}
+	}
+	return res
+}
+
+func (impl *Impl) recentGroups(p entry.Player) []*pto.JoinableGroup {
+	dc := impl.Configer.Get().Discovery
+	players := impl.recentPlayers.list(p.UID(), impl.nowFunc()-dc.GetRecentExpireSec())
+
+	res := make([]*pto.JoinableGroup, 0, len(players))
+	seen := make(map[int64]struct{}, len(players))
+	for _, rp := range players {
+		jg := impl.joinableGroup(p, rp.UID, (*entry.GroupBase).AllowRecentJoin)
+		if jg == nil {
+			continue
+		}
+		if _, ok := seen[jg.GroupID]; ok {
+			continue
+		}
+		seen[jg.GroupID] = struct{}{}
+		jg.Relation, jg.PlayedAtSec = rp.Relation, rp.PlayedAtSec
+		if res = append(res, jg); len(res) >= dc.GetListLimit() {
+			break
+		}
+	}
+	return res
+}
+
+// joinableGroup returns the group of the player `uid` if p can join it,
+// the group should allow the source, be inviting, not full, not the group of p,
+// and in the same game mode and mode version as p.
+func (impl *Impl) joinableGroup(p entry.Player, uid string, allow func(g *entry.GroupBase) bool) *pto.JoinableGroup {
+	other := impl.playerMgr.Get(uid)
+	if other == nil {
+		return nil
+	}
+	other.Base().Lock()
+	groupID := other.Base().GroupID
+	other.Base().Unlock()
+
+	if groupID == 0 || groupID == p.Base().GroupID {
+		return nil
+	}
+	g := impl.groupMgr.Get(groupID)
+	if g == nil {
+		return nil
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+	if g.Base().GetState() != entry.GroupStateInvite || !allow(g.Base()) || g.Base().IsFull() ||
+		g.Base().GameMode != p.Base().GameMode || g.Base().ModeVersion != p.Base().ModeVersion {
+		return nil
+	}
+
+	return &pto.JoinableGroup{
+		GroupID:     groupID,
+		Captain:     g.GetCaptain(),
+		GameMode:    g.Base().GameMode,
+		ModeVersion: g.Base().ModeVersion,
+		PlayerCount: len(g.Base().GetPlayers()),
+		PlayerLimit: g.Base().PlayerLimit(),
+		UID:         uid,
+	}
+}
@@ -7,23 +7,20 @@ import (
 )
 
 func (impl *Impl) dissolveGroup(ctx context.Context, g entry.Group) error {
-	g.Base().SetState(entry.GroupStateDissolved)
+	// the timers of the group are removed on leaving its current state
+	if err := impl.setGroupState(ctx, g, entry.GroupStateDissolved); err != nil {
+		return err
+	}
 
 	uids := g.Base().UIDs()
 	for _, puid := range g.Base().GetPlayers() {
 		p := impl.playerMgr.Get(puid)
-		p.Base().SetOnlineStateWithLock(entry.PlayerOnlineStateOnline)
-		impl.playerMgr.Delete(p.UID())
+		_ = impl.setPlayerStateWithLock(ctx, p, entry.PlayerOnlineStateOnline)
+		impl.deletePlayer(p.UID())
 	}
 	g.Base().ClearPlayers()
 
-	impl.pushService.PushPlayerOnlineState(ctx, uids, entry.PlayerOnlineStateOnline)
-
 	impl.groupMgr.Delete(g.ID())
 	impl.pushService.PushGroupDissolve(ctx, uids, g.ID())
-
-	impl.removeInviteTimer(g.ID())
-	impl.removeWaitAttrTimer(g.ID())
-	impl.removeCancelMatchTimer(g.ID())
 	return nil
 }
@@ -0,0 +1,133 @@
+package matchimpl
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/log"
+	"github.com/hedon954/go-matcher/internal/pto"
+)
+
+// drainAll is the queue key of draining all the queues.
+const drainAll = ""
+
+// drains records the queues draining for maintenance.
+type drains struct {
+	sync.Mutex
+	queues map[string]*drain
+}
+
+type drain struct {
+	deadline int64
+	timer    *time.Timer
+}
+
+func newDrains() *drains {
+	return &drains{queues: make(map[string]*drain)}
+}
+
+// draining returns whether the queue or all the queues are draining.
+func (ds *drains) draining(queueKey string) bool {
+	ds.Lock()
+	defer ds.Unlock()
+	return ds.queues[queueKey] != nil || ds.queues[drainAll] != nil
+}
+
+// deadlines returns the draining queue keys with their deadlines.
+func (ds *drains) deadlines() map[string]int64 {
+	ds.Lock()
+	defer ds.Unlock()
+	res := make(map[string]int64, len(ds.queues))
+	for key, d := range ds.queues {
+		res[key] = d.deadline
+	}
+	return res
+}
+
+// queueKeyOf returns the key of the queue the group matches in,
+// the groups without queue keys are only drained with all the queues.
+func queueKeyOf(g entry.Group) string {
+	if qk, ok := g.(interface{ QueueKey() string }); ok {
+		return qk.QueueKey()
+	}
+	return drainAll
+}
+
+// paused returns whether the queue of the group is paused.
+func (impl *Impl) paused(g entry.Group) bool {
+	return impl.queuePaused != nil && impl.queuePaused(queueKeyOf(g))
+}
+
+// drain starts draining the queue, draining again resets the deadline.
+func (impl *Impl) drain(queueKey string, timeout time.Duration) {
+	impl.drains.Lock()
+	defer impl.drains.Unlock()
+
+	if old := impl.drains.queues[queueKey]; old != nil {
+		old.timer.Stop()
+	}
+	d := &drain{deadline: impl.nowFunc() + int64(timeout.Seconds())}
+	d.timer = time.AfterFunc(timeout, func() { impl.finishDrain(queueKey, d) })
+	impl.drains.queues[queueKey] = d
+	log.Warn().Str("queue_key", queueKey).Int64("deadline", d.deadline).Msg("start draining queue for maintenance")
+}
+
+// undrain stops draining the queue and returns whether it was draining.
+func (impl *Impl) undrain(queueKey string) bool {
+	impl.drains.Lock()
+	defer impl.drains.Unlock()
+
+	d := impl.drains.queues[queueKey]
+	if d == nil {
+		return false
+	}
+	d.timer.Stop()
+	delete(impl.drains.queues, queueKey)
+	log.Warn().Str("queue_key", queueKey).Msg("stop draining queue")
+	return true
+}
+
+// finishDrain cancels the groups still matching in the queue when the drain reaches the deadline,
+// the queue keeps draining until undrain.
+func (impl *Impl) finishDrain(queueKey string, d *drain) {
+	impl.drains.Lock()
+	current := impl.drains.queues[queueKey]
+	impl.drains.Unlock()
+	if current != d {
+		return
+	}
+
+	canceled := 0
+	for _, g := range impl.groupMgr.All() {
+		if queueKey != drainAll && queueKeyOf(g) != queueKey {
+			continue
+		}
+		g.Base().Lock()
+		if g.Base().GetState() == entry.GroupStateMatch {
+			impl.cancelMatch(context.Background(), "", pto.CancelMatchReasonMaintenance, g)
+			canceled++
+		}
+		g.Base().Unlock()
+	}
+	log.Warn().Str("queue_key", queueKey).Int("canceled", canceled).Msg("queue drained for maintenance")
+}
+
+// resumeMatchTimeouts restarts the match timeouts of the groups in the resumed queue which expired while it was paused.
+func (impl *Impl) resumeMatchTimeouts(queueKey string) {
+	restarted := 0
+	for _, g := range impl.groupMgr.All() {
+		if queueKey != drainAll && queueKeyOf(g) != queueKey {
+			continue
+		}
+		g.Base().Lock()
+		if g.Base().GetState() == entry.GroupStateMatch && !impl.paused(g) &&
+			impl.delayTimer.Get(TimerOpTypeGroupMatch, g.ID()) == nil {
+			impl.addCancelMatchTimer(g.ID(), g.Base().GameMode)
+			restarted++
+		}
+		g.Base().Unlock()
+	}
+	log.Info().Str("queue_key", queueKey).Int("restarted", restarted).Msg("match timeouts restarted")
+}
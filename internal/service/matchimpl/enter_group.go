@@ -8,8 +8,8 @@ import (
 	"github.com/hedon954/go-matcher/internal/pto"
 )
 
-func (impl *Impl) checkEnterSourceValidation(g entry.Group, source pto.EnterGroupSourceType) error {
-	switch source {
+func (impl *Impl) checkEnterSourceValidation(ctx context.Context, g entry.Group, info *pto.EnterGroup) error {
+	switch info.Source {
 	case pto.EnterGroupSourceTypeNearby:
 		if !g.Base().AllowNearbyJoin() {
 			return merr.ErrGroupDenyNearbyJoin
@@ -18,17 +18,53 @@ func (impl *Impl) checkEnterSourceValidation(g entry.Group, source pto.EnterGrou
 		if !g.Base().AllowRecentJoin() {
 			return merr.ErrGroupDenyRecentJoin
 		}
-		// TODO: check other
+	case pto.EnterGroupSourceTypeFriend:
+		if !g.Base().AllowFriendJoin() {
+			return merr.ErrGroupDenyFriendJoin
+		}
+		return impl.checkRelation(ctx, g, info.UID, impl.relation.IsFriend, merr.ErrNotFriend)
+	case pto.EnterGroupSourceTypeWorldChannel:
+		if !g.Base().AllowWorldChannelJoin() {
+			return merr.ErrGroupDenyWorldChannelJoin
+		}
+	case pto.EnterGroupSourceTypeClanChannel:
+		if !g.Base().AllowClanChannelJoin() {
+			return merr.ErrGroupDenyClanChannelJoin
+		}
+		return impl.checkRelation(ctx, g, info.UID, impl.relation.InSameClan, merr.ErrNotClanMember)
+	case pto.EnterGroupSourceTypeShare:
+		return impl.checkShareToken(g, info.ShareToken)
 	}
 
 	return nil
 }
 
+// checkRelation checks whether the player has the relation with any player in the group,
+// notRelatedErr is returned if not.
+func (impl *Impl) checkRelation(
+	ctx context.Context, g entry.Group, uid string,
+	related func(ctx context.Context, uid, otherUID string) (bool, error), notRelatedErr error,
+) error {
+	for _, other := range g.Base().UIDs() {
+		if other == uid {
+			return nil
+		}
+		ok, err := related(ctx, uid, other)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	return notRelatedErr
+}
+
 func (impl *Impl) enterGroup(ctx context.Context, p entry.Player, g entry.Group) error {
 	if err := g.Base().AddPlayer(p); err != nil {
 		return err
 	}
-	impl.pushService.PushPlayerOnlineState(ctx, []string{p.UID()}, entry.PlayerOnlineStateInGroup)
+	_ = impl.setPlayerState(ctx, p, entry.PlayerOnlineStateInGroup)
 	impl.pushService.PushGroupInfo(ctx, g.Base().UIDs(), g.GetGroupInfo())
 	return nil
 }
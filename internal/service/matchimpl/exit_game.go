@@ -6,11 +6,22 @@ import (
 	"github.com/hedon954/go-matcher/internal/entry"
 )
 
-func (impl *Impl) exitGame(ctx context.Context, p entry.Player, g entry.Group, r entry.Room) error {
+func (impl *Impl) exitGame(ctx context.Context, p entry.Player, g entry.Group, t entry.Team, r entry.Room) error {
 	if err := impl.exitGroup(ctx, p, g); err != nil {
 		return err
 	}
-	impl.playerMgr.Delete(p.UID())
+	impl.deletePlayer(p.UID())
 	r.Base().AddEscapePlayer(p.UID())
+
+	// the group is dissolved if the last player escapes, remove it from the team
+	if g.Base().GetState() == entry.GroupStateDissolved {
+		t.Base().Lock()
+		t.Base().RemoveGroup(g.ID())
+		t.Base().Unlock()
+	}
+
+	if impl.Configer.Get().BackfillOnEscape {
+		impl.escapeBackfill(ctx, r, t)
+	}
 	return nil
 }
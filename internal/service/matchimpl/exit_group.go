@@ -7,10 +7,11 @@ import (
 )
 
 func (impl *Impl) exitGroup(ctx context.Context, p entry.Player, g entry.Group) error {
-	p.Base().SetOnlineState(entry.PlayerOnlineStateOnline)
+	if err := impl.setPlayerState(ctx, p, entry.PlayerOnlineStateOnline); err != nil {
+		return err
+	}
 	p.Base().GroupID = 0
 	empty := g.Base().RemovePlayer(p)
-	impl.pushService.PushPlayerOnlineState(ctx, []string{p.UID()}, entry.PlayerOnlineStateOnline)
 	if empty {
 		return impl.dissolveGroup(ctx, g)
 	} else {
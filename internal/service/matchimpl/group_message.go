@@ -0,0 +1,80 @@
+package matchimpl
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/merr"
+	"github.com/hedon954/go-matcher/internal/pto"
+)
+
+func (impl *Impl) checkGroupMessage(msgType pto.GroupMessageType, content string) error {
+	switch msgType {
+	case pto.GroupMessageTypeText, pto.GroupMessageTypeEmote, pto.GroupMessageTypeQuick:
+	default:
+		return merr.ErrInvalidMessageType
+	}
+	if strings.TrimSpace(content) == "" {
+		return merr.ErrEmptyMessage
+	}
+	if utf8.RuneCountInString(content) > impl.Configer.Get().GroupChat.GetMaxLength() {
+		return merr.ErrMessageTooLong
+	}
+	if presets := impl.getPresetMessages(msgType); presets != nil && !slices.Contains(presets, content) {
+		return merr.ErrUnknownPresetMessage
+	}
+	return nil
+}
+
+// getPresetMessages returns the preset ids of the emotes or the quick chats,
+// nil means the content of the message type is not preset.
+func (impl *Impl) getPresetMessages(msgType pto.GroupMessageType) []string {
+	gcc := impl.Configer.Get().GroupChat
+	if gcc == nil {
+		return nil
+	}
+	var presets []string
+	switch msgType {
+	case pto.GroupMessageTypeEmote:
+		presets = gcc.Emotes
+	case pto.GroupMessageTypeQuick:
+		presets = gcc.QuickChats
+	}
+	if len(presets) == 0 {
+		return nil
+	}
+	return presets
+}
+
+// checkGroupMessageRate checks whether the player has sent too many messages recently.
+func (impl *Impl) checkGroupMessageRate(uid string) error {
+	gcc := impl.Configer.Get().GroupChat
+	if !impl.chatLimiter.allow(uid, impl.nowFunc(), gcc.GetRateLimit(), gcc.GetRateLimitWindowSec()) {
+		return merr.ErrSendMessageTooFrequent
+	}
+	return nil
+}
+
+func (impl *Impl) sendGroupMessage(
+	ctx context.Context, p entry.Player, g entry.Group, msgType pto.GroupMessageType, content string,
+) error {
+	// the preset emotes and quick chats are checked already, the others are filtered as free text
+	if impl.getPresetMessages(msgType) == nil {
+		var err error
+		if content, err = impl.contentFilter.Filter(ctx, p.UID(), content); err != nil {
+			return err
+		}
+	}
+
+	impl.pushService.PushGroupMessage(ctx, g.Base().UIDs(), &pto.GroupMessage{
+		GroupID:   g.ID(),
+		SenderUID: p.UID(),
+		Type:      msgType,
+		Content:   content,
+		SendSec:   impl.nowFunc(),
+	})
+	return nil
+}
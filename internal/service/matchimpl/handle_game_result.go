@@ -1,6 +1,7 @@
 package matchimpl
 
 import (
+	"context"
 	"slices"
 
 	"github.com/hedon954/go-matcher/internal/constant"
@@ -33,40 +34,46 @@ func (impl *Impl) handleGameResult(result *pto.GameResult) {
 	r.Base().Lock()
 	defer r.Base().Unlock()
 
+	impl.closeBackfills(r)
+	impl.releaseSpectators(context.Background(), r)
+	impl.recordRecentPlayers(r)
 	escapePlayers := r.Base().GetEscapePlayers()
-	impl.updateStateToSettle(r, escapePlayers)
+	impl.updateStateToSettle(context.Background(), r, escapePlayers)
 	impl.clearMatchStrategy(r, escapePlayers) // do not worry about performance, just make it readable
+	impl.releaseAI(r)
 
 	// ... do something to punish escape players
 	// ... do something to handle result
 }
 
-func (impl *Impl) updateStateToSettle(r entry.Room, escapePlayers []string) {
+func (impl *Impl) updateStateToSettle(ctx context.Context, r entry.Room, escapePlayers []string) {
 	for _, teamID := range r.Base().GetTeams() {
 		t := impl.teamMgr.Get(teamID)
-		impl.updateTeamStateToSettle(t, escapePlayers)
+		impl.updateTeamStateToSettle(ctx, t, escapePlayers)
 	}
 }
 
-func (impl *Impl) updateTeamStateToSettle(team entry.Team, escapePlayers []string) {
+func (impl *Impl) updateTeamStateToSettle(ctx context.Context, team entry.Team, escapePlayers []string) {
 	team.Base().Lock()
 	defer team.Base().Unlock()
 	for _, groupID := range team.Base().GetGroups() {
 		g := impl.groupMgr.Get(groupID)
-		impl.updateGroupStateToSettle(g, escapePlayers)
+		impl.updateGroupStateToSettle(ctx, g, escapePlayers)
 	}
 }
 
-func (impl *Impl) updateGroupStateToSettle(g entry.Group, escapePlayers []string) {
+func (impl *Impl) updateGroupStateToSettle(ctx context.Context, g entry.Group, escapePlayers []string) {
 	g.Base().Lock()
 	defer g.Base().Unlock()
-	g.Base().SetState(entry.GroupStateInvite)
+	if err := impl.setGroupState(ctx, g, entry.GroupStateInvite); err != nil {
+		return
+	}
 	for _, puid := range g.Base().GetPlayers() {
 		if slices.Index(escapePlayers, puid) < 0 {
 			continue
 		}
 		p := impl.playerMgr.Get(puid)
-		p.Base().SetOnlineStateWithLock(entry.PlayerOnlineStateInSettle)
+		_ = impl.setPlayerStateWithLock(ctx, p, entry.PlayerOnlineStateInSettle)
 		p.Base().SetMatchStrategyWithLock(constant.MatchStrategy(0))
 	}
 }
@@ -3,11 +3,20 @@ package matchimpl
 import (
 	"context"
 	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/hedon954/go-matcher/internal/entry"
 	"github.com/hedon954/go-matcher/internal/matcher/common"
+	"github.com/hedon954/go-matcher/internal/pto"
 )
 
+const tracerName = "go-matcher/service"
+
 func (impl *Impl) waitForMatchResult() {
 	for r := range impl.roomChannel {
 		fmt.Println("new room: ", r.Room.ID())
@@ -37,16 +46,27 @@ func (impl *Impl) handleMatchResult(ctx context.Context, result common.Result) (
 	// ---------------------------
 	r.Base().FinishMatchSec = impl.nowFunc()
 	impl.clearDelayTimer(r)
+
+	// the players should accept the match before the game starts
+	if rc := impl.Configer.Get().GetReadyCheckConfig(r.Base().GameMode); rc != nil {
+		impl.startReadyCheck(ctx, r, rc)
+		return nil
+	}
+
+	return impl.startGame(ctx, r)
+}
+
+// startGame updates the players of the room to game and pushes the match info to them.
+func (impl *Impl) startGame(ctx context.Context, r entry.Room) error {
 	impl.updateStateToGame(ctx, r)
 
 	// ----------------------------
 	// some operations may need AI
 	// ----------------------------
-	err = impl.fillRoomInfo(r)
-	if err != nil {
+	if err := impl.fillRoomInfo(ctx, r); err != nil {
 		return err
 	}
-	impl.pushService.PushMatchInfo(ctx, impl.getRoomUIDs(r), r.GetMatchInfo())
+	impl.pushService.PushMatchInfo(ctx, impl.getRoomUIDs(r), impl.getMatchInfo(r))
 	impl.addClearRoomTimer(r.ID(), r.Base().GameMode)
 	return nil
 }
@@ -56,55 +76,82 @@ func (impl *Impl) getRoomUIDs(r entry.Room) []string {
 	for _, teamID := range r.Base().GetTeams() {
 		t := impl.teamMgr.Get(teamID)
 		for _, groupID := range t.Base().GetGroups() {
-			res = append(res, impl.groupMgr.Get(groupID).Base().UIDs()...)
+			if g := impl.groupMgr.Get(groupID); !g.Base().IsAI {
+				res = append(res, g.Base().UIDs()...)
+			}
 		}
 	}
 	return res
 }
 
-func (impl *Impl) fillRoomInfo(r entry.Room) (err error) {
+// getMatchInfo returns the match info of the room filled with the teams and the players, AI players included.
+// The teams are ordered by their ids, and numbered by their order if they have no slot in the room.
+func (impl *Impl) getMatchInfo(r entry.Room) *pto.MatchInfo {
+	info := r.GetMatchInfo()
+	teamIDs := r.Base().GetTeams()
+	slices.Sort(teamIDs)
+	info.Teams = make([]pto.MatchTeamInfo, 0, len(teamIDs))
+	for i, teamID := range teamIDs {
+		t := impl.teamMgr.Get(teamID)
+		t.Base().RLock()
+		team := pto.MatchTeamInfo{TeamID: t.Base().TeamID}
+		groups := t.Base().GetGroups()
+		t.Base().RUnlock()
+		if team.TeamID == 0 {
+			team.TeamID = i + 1
+		}
+		slices.Sort(groups)
+		for _, groupID := range groups {
+			for _, uid := range impl.groupMgr.Get(groupID).Base().GetPlayers() {
+				player := pto.MatchPlayerInfo{UID: uid, GroupID: groupID}
+				if p := impl.playerMgr.Get(uid); p != nil {
+					player.Attr = p.Base().Attribute
+				}
+				team.Players = append(team.Players, player)
+			}
+		}
+		info.Teams = append(info.Teams, team)
+	}
+	return info
+}
+
+func (impl *Impl) fillRoomInfo(ctx context.Context, r entry.Room) (err error) {
 	// dispatch a game server address
-	r.Base().GameServerInfo, err = impl.gameServerDispatch.Dispatch(context.Background(), r.Base().GameMode, r.Base().ModeVersion)
-	if err != nil {
+	if err = impl.dispatchGameServer(ctx, r); err != nil {
 		return err
 	}
 
 	// fill room with AI
-	if err := impl.fillRoomWithAI(r); err != nil {
+	if err := impl.fillRoomWithAI(ctx, r); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (impl *Impl) fillRoomWithAI(r entry.Room) error {
-	if !r.NeedAI() {
-		return nil
-	}
-
-	for _, teamID := range r.Base().GetTeams() {
-		t := impl.teamMgr.Get(teamID)
-		if err := impl.fillTeamWithAI(t); err != nil {
-			return err
-		}
-	}
+func (impl *Impl) dispatchGameServer(ctx context.Context, r entry.Room) (err error) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, "GameServerDispatch", trace.WithAttributes(
+		attribute.Int64("match.room_id", r.ID()),
+		attribute.String("match.region", r.Base().Region),
+	))
+	defer span.End()
 
-	teamCount := len(r.Base().GetTeams())
-	for i := teamCount; i < r.Base().TeamLimit; i++ {
-		r.Base().AddTeam(impl.createAITeam())
+	r.Base().GameServerInfo, err = impl.gameServerDispatch.Dispatch(ctx,
+		r.Base().GameMode, r.Base().ModeVersion, r.Base().Region)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 	}
-
-	return nil
+	return err
 }
 
-func (impl *Impl) fillTeamWithAI(_ entry.Team) error {
-	// TODO implement AI generator
-	return nil
-}
-
-func (impl *Impl) createAITeam() entry.Team {
-	// TODO implement AI generator
-	return nil
+// startResultSpan starts the span of handling the match result as a child of the span forming it.
+func startResultSpan(r common.Result) (context.Context, trace.Span) {
+	ctx := trace.ContextWithSpanContext(context.Background(), r.SpanCtx)
+	return otel.Tracer(tracerName).Start(ctx, "HandleMatchResult", trace.WithAttributes(
+		attribute.Int64("match.room_id", r.Room.ID()),
+		attribute.Bool("match.backfill", r.Backfill != nil),
+	))
 }
 
 func (impl *Impl) clearDelayTimer(r entry.Room) {
@@ -138,10 +185,10 @@ func (impl *Impl) updateTeamStateToGame(ctx context.Context, t entry.Team) {
 func (impl *Impl) updateGroupStateToGame(ctx context.Context, g entry.Group) {
 	g.Base().Lock()
 	defer g.Base().Unlock()
-	g.Base().SetState(entry.GroupStateGame)
+	if err := impl.setGroupState(ctx, g, entry.GroupStateGame); err != nil {
+		return
+	}
 	for _, puid := range g.Base().GetPlayers() {
-		p := impl.playerMgr.Get(puid)
-		p.Base().SetOnlineStateWithLock(entry.PlayerOnlineStateInGame)
+		_ = impl.setPlayerStateWithLock(ctx, impl.playerMgr.Get(puid), entry.PlayerOnlineStateInGame)
 	}
-	impl.pushService.PushPlayerOnlineState(ctx, g.Base().UIDs(), entry.PlayerOnlineStateInGame)
 }
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/hedon954/goapm/apm"
+	"go.opentelemetry.io/otel/codes"
 
 	"github.com/hedon954/go-matcher/internal/config"
-	"github.com/hedon954/go-matcher/internal/config/mock"
 	"github.com/hedon954/go-matcher/internal/entry"
 	"github.com/hedon954/go-matcher/internal/log"
 	"github.com/hedon954/go-matcher/internal/matcher/common"
@@ -17,6 +19,7 @@ import (
 	"github.com/hedon954/go-matcher/internal/pto"
 	"github.com/hedon954/go-matcher/internal/service"
 	"github.com/hedon954/go-matcher/internal/service/servicemock"
+	"github.com/hedon954/go-matcher/pkg/geo"
 	"github.com/hedon954/go-matcher/pkg/timer"
 )
 
@@ -37,7 +40,6 @@ type Impl struct {
 	delayTimer timer.Operator[int64]
 
 	Configer config.Configer[config.MatchConfig]
-	MSConfig config.MatchStrategy
 
 	mgrs      *entry.Mgrs
 	playerMgr *entry.PlayerMgr
@@ -54,8 +56,32 @@ type Impl struct {
 
 	pushService        service.Push
 	gameServerDispatch service.GameServerDispatch
+	backfill           service.Backfill
+	relation           service.Relation
+	contentFilter      service.ContentFilter
 
 	result map[int64]*pto.GameResult // TODO: change
+
+	// customRoomCodes maps the invite codes to the custom rooms waiting for players.
+	customRoomLock  sync.Mutex
+	customRoomCodes map[string]int64
+
+	// recentPlayers and geoIndex back the recent and nearby player lists.
+	recentPlayers *recentPlayers
+	geoIndex      *geo.Index
+
+	// invites indexes the pending invitations of the groups.
+	invites       *inviteIndex
+	inviteLimiter *rateLimiter
+	chatLimiter   *rateLimiter
+
+	// drains records the queues draining for maintenance.
+	drains *drains
+	// queuePaused tells whether the match queue is paused, nil means the queues are never paused.
+	queuePaused func(queueKey string) bool
+
+	// spectators records the rooms watched by the spectators.
+	spectators *spectators
 }
 
 type Option func(*Impl)
@@ -66,9 +92,32 @@ func WithNowFunc(f func() int64) Option {
 	}
 }
 
-func WithMatchStrategyConfiger(c config.MatchStrategy) Option {
+// WithBackfill sets the backfill service to open the slots of in-progress rooms to the match system.
+func WithBackfill(b service.Backfill) Option {
+	return func(impl *Impl) {
+		impl.backfill = b
+	}
+}
+
+// WithQueuePaused sets the func telling whether a match queue is paused,
+// the groups in the paused queues are not canceled by the match timeout until resumed.
+func WithQueuePaused(f func(queueKey string) bool) Option {
 	return func(impl *Impl) {
-		impl.MSConfig = c
+		impl.queuePaused = f
+	}
+}
+
+// WithRelation sets the relation service to check the friends and clan members entering the groups.
+func WithRelation(r service.Relation) Option {
+	return func(impl *Impl) {
+		impl.relation = r
+	}
+}
+
+// WithContentFilter sets the content filter to check the group messages.
+func WithContentFilter(f service.ContentFilter) Option {
+	return func(impl *Impl) {
+		impl.contentFilter = f
 	}
 }
 
@@ -88,10 +137,20 @@ func NewDefault(
 		groupChannel:       groupChannel,
 		roomChannel:        roomChannel,
 		delayTimer:         delayTimer,
-		MSConfig:           new(mock.MatchStrategyMock),         // TODO: change
 		pushService:        new(servicemock.PushMock),           // TODO: change
 		gameServerDispatch: new(servicemock.GameServerDispatch), // TODO: change
 		result:             make(map[int64]*pto.GameResult),     // TODO: change
+		backfill:           new(servicemock.BackfillMock),
+		relation:           new(servicemock.RelationMock),
+		contentFilter:      new(servicemock.ContentFilterMock),
+		customRoomCodes:    make(map[string]int64),
+		recentPlayers:      newRecentPlayers(),
+		geoIndex:           geo.NewIndex(configer.Get().Discovery.GetNearbyCellDegree()),
+		invites:            newInviteIndex(),
+		inviteLimiter:      newRateLimiter(),
+		chatLimiter:        newRateLimiter(),
+		drains:             newDrains(),
+		spectators:         newSpectators(),
 	}
 
 	for _, opt := range options {
@@ -100,6 +159,7 @@ func NewDefault(
 
 	go impl.waitForMatchResult()
 	impl.initDelayTimer()
+	impl.initStateMachines()
 	return impl
 }
 
@@ -129,7 +189,7 @@ func (impl *Impl) CreateGroup(ctx context.Context, param *pto.CreateGroup) (entr
 	g := impl.groupMgr.Get(p.Base().GroupID)
 	if g == nil {
 		// create a group
-		g, err = impl.createGroup(p)
+		g, err = impl.createGroup(ctx, p)
 		if err != nil {
 			return nil, err
 		}
@@ -146,7 +206,7 @@ func (impl *Impl) CreateGroup(ctx context.Context, param *pto.CreateGroup) (entr
 			if groupEmpty := g.Base().RemovePlayer(p); groupEmpty {
 				impl.groupMgr.Delete(g.ID())
 			}
-			g, err = impl.createGroup(p)
+			g, err = impl.createGroup(ctx, p)
 			if err != nil {
 				return nil, err
 			}
@@ -180,7 +240,7 @@ func (impl *Impl) EnterGroup(ctx context.Context, info *pto.EnterGroup, groupID
 	}
 
 	// check source validation
-	if err := impl.checkEnterSourceValidation(g, info.Source); err != nil {
+	if err := impl.checkEnterSourceValidation(ctx, g, info); err != nil {
 		return err
 	}
 
@@ -235,6 +295,13 @@ func (impl *Impl) ExitGroup(ctx context.Context, uid string) error {
 		return err
 	}
 
+	// the custom room is locked before the group like the other custom room operations
+	r := impl.getCustomRoomOfGroup(g)
+	if r != nil {
+		r.Base().Lock()
+		defer r.Base().Unlock()
+	}
+
 	p.Base().Lock()
 	defer p.Base().Unlock()
 	if err := p.Base().CheckOnlineState(entry.PlayerOnlineStateInGroup); err != nil {
@@ -247,11 +314,14 @@ func (impl *Impl) ExitGroup(ctx context.Context, uid string) error {
 		return nil
 	}
 
-	if err := g.Base().CheckState(entry.GroupStateInvite); err != nil {
+	if err := g.Base().CheckState(entry.GroupStateInvite, entry.GroupStateCustomRoom); err != nil {
 		return err
 	}
 
-	impl.playerMgr.Delete(p.UID())
+	if g.Base().GetState() == entry.GroupStateCustomRoom {
+		return impl.exitCustomRoomGroup(ctx, r, p, g)
+	}
+	impl.deletePlayer(p.UID())
 	return impl.exitGroup(ctx, p, g)
 }
 
@@ -384,10 +454,119 @@ func (impl *Impl) SetRecentJoinGroup(_ context.Context, captainUID string, allow
 	return nil
 }
 
+func (impl *Impl) SetFriendJoinGroup(_ context.Context, captainUID string, allow bool) error {
+	_, g, err := impl.getPlayerAndGroup(captainUID)
+	if err != nil {
+		return err
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	if g.GetCaptain() != captainUID {
+		return merr.ErrPermissionDeny
+	}
+
+	g.Base().SetAllowFriendJoin(allow)
+	return nil
+}
+
+func (impl *Impl) SetWorldChannelJoinGroup(_ context.Context, captainUID string, allow bool) error {
+	_, g, err := impl.getPlayerAndGroup(captainUID)
+	if err != nil {
+		return err
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	if g.GetCaptain() != captainUID {
+		return merr.ErrPermissionDeny
+	}
+
+	g.Base().SetAllowWorldChannelJoin(allow)
+	return nil
+}
+
+func (impl *Impl) SetClanChannelJoinGroup(_ context.Context, captainUID string, allow bool) error {
+	_, g, err := impl.getPlayerAndGroup(captainUID)
+	if err != nil {
+		return err
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	if g.GetCaptain() != captainUID {
+		return merr.ErrPermissionDeny
+	}
+
+	g.Base().SetAllowClanChannelJoin(allow)
+	return nil
+}
+
+func (impl *Impl) ShareGroup(_ context.Context, uid string) (token string, expireAt int64, err error) {
+	_, g, err := impl.getPlayerAndGroup(uid)
+	if err != nil {
+		return "", 0, err
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	if err := g.Base().CheckState(entry.GroupStateInvite); err != nil {
+		return "", 0, err
+	}
+
+	return impl.shareGroup(g)
+}
+
+func (impl *Impl) ReportLocation(_ context.Context, uid string, lat, lng float64) error {
+	point := geo.Point{Lat: lat, Lng: lng}
+	if !point.Valid() {
+		return merr.ErrInvalidLocation
+	}
+
+	p := impl.playerMgr.Get(uid)
+	if p == nil {
+		return merr.ErrPlayerNotExists
+	}
+
+	impl.reportLocation(p, point)
+	return nil
+}
+
+func (impl *Impl) NearbyGroups(_ context.Context, uid string) ([]*pto.JoinableGroup, error) {
+	p := impl.playerMgr.Get(uid)
+	if p == nil {
+		return nil, merr.ErrPlayerNotExists
+	}
+	point, updatedAt, ok := impl.geoIndex.Get(uid)
+	if !ok {
+		return nil, merr.ErrLocationNotReported
+	}
+	if updatedAt <= impl.nowFunc()-impl.Configer.Get().Discovery.GetLocationExpireSec() {
+		impl.geoIndex.Remove(uid)
+		return nil, merr.ErrLocationExpired
+	}
+
+	return impl.nearbyGroups(p, point), nil
+}
+
+func (impl *Impl) RecentGroups(_ context.Context, uid string) ([]*pto.JoinableGroup, error) {
+	p := impl.playerMgr.Get(uid)
+	if p == nil {
+		return nil, merr.ErrPlayerNotExists
+	}
+
+	return impl.recentGroups(p), nil
+}
+
 func (impl *Impl) Invite(ctx context.Context, inviterUID, inviteeUID string) error {
 	if err := impl.checkInviteeState(inviteeUID); err != nil {
 		return err
 	}
+	inviteeInfo := impl.getInviteeInfo(inviteeUID)
 
 	inviter, g, err := impl.getPlayerAndGroup(inviterUID)
 	if err != nil {
@@ -405,11 +584,50 @@ func (impl *Impl) Invite(ctx context.Context, inviterUID, inviteeUID string) err
 		return merr.ErrGroupFull
 	}
 
-	// TODO: how to check if can play together?
+	if err := impl.checkInviteePlayTogether(inviteeInfo, g); err != nil {
+		return err
+	}
+
+	if err := impl.checkInviteRate(inviterUID); err != nil {
+		return err
+	}
+
 	impl.invite(ctx, inviter, inviteeUID, g)
 	return nil
 }
 
+func (impl *Impl) RevokeInvite(ctx context.Context, inviterUID, inviteeUID string) error {
+	_, g, err := impl.getPlayerAndGroup(inviterUID)
+	if err != nil {
+		return err
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	return impl.revokeInvite(ctx, inviterUID, inviteeUID, g)
+}
+
+func (impl *Impl) ListReceivedInvites(_ context.Context, inviteeUID string) ([]*pto.PendingInvite, error) {
+	return impl.listReceivedInvites(inviteeUID), nil
+}
+
+func (impl *Impl) ListSentInvites(_ context.Context, inviterUID string) ([]*pto.PendingInvite, error) {
+	_, g, err := impl.getPlayerAndGroup(inviterUID)
+	if err != nil {
+		return nil, err
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	return impl.listSentInvites(inviterUID, g), nil
+}
+
+func (impl *Impl) RestoreInvites(groups []entry.Group) {
+	impl.restoreInvites(groups)
+}
+
 func (impl *Impl) AcceptInvite(ctx context.Context, inviterUID string, inviteeInfo *pto.PlayerInfo, groupID int64) error {
 	g := impl.groupMgr.Get(groupID)
 	if g == nil {
@@ -430,7 +648,7 @@ func (impl *Impl) AcceptInvite(ctx context.Context, inviterUID string, inviteeIn
 	defer g.Base().Unlock()
 
 	// no matter what the result it is, delete the invitation record to the invitee
-	defer g.Base().DelInviteRecord(inviteeInfo.UID)
+	defer impl.delInviteRecord(g, inviteeInfo.UID)
 
 	if !g.Base().PlayerExists(inviterUID) {
 		return merr.ErrInvitationExpired
@@ -470,13 +688,38 @@ func (impl *Impl) RefuseInvite(ctx context.Context, inviterUID, inviteeUID strin
 	if g.Base().GetState() == entry.GroupStateDissolved {
 		return
 	}
-	g.Base().DelInviteRecord(inviteeUID)
+	impl.delInviteRecord(g, inviteeUID)
 	if refuseMsg == "" {
 		refuseMsg = defaultRefuseMsg
 	}
 	impl.pushService.PushRefuseInvite(ctx, inviterUID, inviteeUID, refuseMsg)
 }
 
+func (impl *Impl) SendGroupMessage(ctx context.Context, uid string, msgType pto.GroupMessageType, content string) error {
+	if err := impl.checkGroupMessage(msgType, content); err != nil {
+		return err
+	}
+
+	p, g, err := impl.getPlayerAndGroup(uid)
+	if err != nil {
+		return err
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	if err := g.Base().CheckState(entry.GroupStateInvite, entry.GroupStateMatch,
+		entry.GroupStateReadyCheck, entry.GroupStateCustomRoom); err != nil {
+		return err
+	}
+
+	if err := impl.checkGroupMessageRate(uid); err != nil {
+		return err
+	}
+
+	return impl.sendGroupMessage(ctx, p, g, msgType, content)
+}
+
 func (impl *Impl) Ready(ctx context.Context, uid string) error {
 	p, g, err := impl.getPlayerAndGroup(uid)
 	if err != nil {
@@ -529,7 +772,16 @@ func (impl *Impl) StartMatch(ctx context.Context, captainUID string) error {
 	if err := g.CanStartMatch(); err != nil {
 		return err
 	}
-	g.Base().MatchStrategy = impl.MSConfig.GetMatchStrategy(g.Base().GameMode)
+	if impl.Configer.Get().RequireAllReady(g.Base().GameMode) && len(g.Base().UnReadyPlayer) > 0 {
+		return merr.ErrNotAllReady
+	}
+	if impl.drains.draining(queueKeyOf(g)) {
+		return merr.ErrMatchDraining
+	}
+	if err := impl.checkGroupSpectating(g); err != nil {
+		return err
+	}
+	g.Base().MatchStrategy = impl.Configer.Get().GetMatchStrategy(g.Base().GameMode)
 	if !g.Base().IsMatchStrategySupported() {
 		return fmt.Errorf("unsupported match strategy: %v", g.Base().MatchStrategy)
 	}
@@ -551,7 +803,7 @@ func (impl *Impl) CancelMatch(ctx context.Context, uid string) error {
 		return err
 	}
 
-	impl.cancelMatch(ctx, uid, g)
+	impl.cancelMatch(ctx, uid, pto.CancelMatchReasonPlayer, g)
 	return nil
 }
 
@@ -569,25 +821,292 @@ func (impl *Impl) ExitGame(ctx context.Context, uid string, roomID int64) error
 	r.Base().Lock()
 	defer r.Base().Unlock()
 
-	exists := false
-	for _, teamID := range r.Base().GetTeams() {
-		t := impl.teamMgr.Get(teamID)
-		t.Base().Lock()
-		groups := t.Base().GetGroups()
-		t.Base().Unlock()
-		for _, groupID := range groups {
-			if groupID == g.ID() {
-				exists = true
-				goto OUT
-			}
+	if r.Base().InReadyCheck() {
+		return merr.ErrGroupInReadyCheck
+	}
+
+	team := impl.getTeamOfGroup(r, g.ID())
+	if team == nil {
+		return merr.ErrPlayerNotInRoom
+	}
+
+	return impl.exitGame(ctx, p, g, team, r)
+}
+
+func (impl *Impl) AcceptMatch(ctx context.Context, uid string, roomID int64) error {
+	_, g, err := impl.getPlayerAndGroup(uid)
+	if err != nil {
+		return err
+	}
+
+	r := impl.roomMgr.Get(roomID)
+	if r == nil {
+		return merr.ErrRoomNotExists
+	}
+
+	r.Base().Lock()
+	defer r.Base().Unlock()
+
+	if !r.Base().InReadyCheck() {
+		return merr.ErrRoomNotInReadyCheck
+	}
+	if impl.getTeamOfGroup(r, g.ID()) == nil {
+		return merr.ErrPlayerNotInRoom
+	}
+
+	return impl.acceptMatch(ctx, uid, r)
+}
+
+func (impl *Impl) DeclineMatch(ctx context.Context, uid string, roomID int64) error {
+	_, g, err := impl.getPlayerAndGroup(uid)
+	if err != nil {
+		return err
+	}
+
+	r := impl.roomMgr.Get(roomID)
+	if r == nil {
+		return merr.ErrRoomNotExists
+	}
+
+	r.Base().Lock()
+	defer r.Base().Unlock()
+
+	if !r.Base().InReadyCheck() {
+		return merr.ErrRoomNotInReadyCheck
+	}
+	if impl.getTeamOfGroup(r, g.ID()) == nil {
+		return merr.ErrPlayerNotInRoom
+	}
+
+	impl.cancelReadyCheck(ctx, r, []string{uid})
+	return nil
+}
+
+func (impl *Impl) Spectate(ctx context.Context, uid string, roomID int64) error {
+	r := impl.roomMgr.Get(roomID)
+	if r == nil {
+		return merr.ErrRoomNotExists
+	}
+
+	r.Base().Lock()
+	defer r.Base().Unlock()
+
+	limit := impl.Configer.Get().GetSpectatorLimit(r.Base().GameMode)
+	if limit <= 0 {
+		return merr.ErrSpectateDisabled
+	}
+	if r.Base().InReadyCheck() {
+		return merr.ErrRoomInReadyCheck
+	}
+	if slices.Contains(r.Base().GetSpectators(), uid) {
+		return nil
+	}
+	if len(r.Base().GetSpectators()) >= limit {
+		return merr.ErrSpectatorFull
+	}
+
+	// the players in group, matching or gaming can not spectate
+	if p := impl.playerMgr.Get(uid); p != nil {
+		p.Base().Lock()
+		err := p.Base().CheckOnlineState(entry.PlayerOnlineStateOnline)
+		p.Base().Unlock()
+		if err != nil {
+			return err
 		}
 	}
-OUT:
-	if !exists {
+	if impl.spectators.spectating(uid) {
+		return merr.ErrPlayerSpectating
+	}
+	if err := impl.checkSpectator(ctx, uid, r); err != nil {
+		return err
+	}
+
+	return impl.spectate(ctx, uid, r)
+}
+
+func (impl *Impl) ExitSpectate(_ context.Context, uid string, roomID int64) error {
+	r := impl.roomMgr.Get(roomID)
+	if r == nil {
+		return merr.ErrRoomNotExists
+	}
+
+	r.Base().Lock()
+	defer r.Base().Unlock()
+
+	return impl.exitSpectate(uid, r)
+}
+
+func (impl *Impl) CreateCustomRoom(ctx context.Context, uid string, param *pto.CreateCustomRoom) (entry.Room, error) {
+	if param.TeamLimit <= 0 || param.TeamPlayerLimit <= 0 {
+		return nil, merr.ErrInvalidTeamSlot
+	}
+
+	_, g, err := impl.getPlayerAndGroup(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	if err := g.Base().CheckState(entry.GroupStateInvite); err != nil {
+		return nil, err
+	}
+	if g.GetCaptain() != uid {
+		return nil, merr.ErrNotCaptain
+	}
+	if len(g.Base().GetPlayers()) > param.TeamPlayerLimit {
+		return nil, merr.ErrTeamSlotFull
+	}
+
+	return impl.createCustomRoom(ctx, uid, g, param)
+}
+
+func (impl *Impl) JoinCustomRoom(ctx context.Context, uid, code, password string) error {
+	_, g, err := impl.getPlayerAndGroup(uid)
+	if err != nil {
+		return err
+	}
+
+	r := impl.getCustomRoomByCode(code)
+	if r == nil {
+		return merr.ErrCustomRoomNotExists
+	}
+
+	r.Base().Lock()
+	defer r.Base().Unlock()
+
+	if r.Base().Custom.Started {
+		return merr.ErrCustomRoomStarted
+	}
+	if r.Base().Custom.Password != password {
+		return merr.ErrWrongPassword
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	if err := g.Base().CheckState(entry.GroupStateInvite); err != nil {
+		return err
+	}
+	if g.GetCaptain() != uid {
+		return merr.ErrNotCaptain
+	}
+	if g.Base().GameMode != r.Base().GameMode || g.Base().ModeVersion != r.Base().ModeVersion {
+		return merr.ErrGameModeNotMatch
+	}
+
+	return impl.joinCustomRoom(ctx, r, g)
+}
+
+func (impl *Impl) LeaveCustomRoom(ctx context.Context, uid string, roomID int64) error {
+	_, g, err := impl.getPlayerAndGroup(uid)
+	if err != nil {
+		return err
+	}
+
+	r, err := impl.getCustomRoom(roomID)
+	if err != nil {
+		return err
+	}
+
+	r.Base().Lock()
+	defer r.Base().Unlock()
+
+	if r.Base().Custom.Started {
+		return merr.ErrCustomRoomStarted
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	if g.GetCaptain() != uid {
+		return merr.ErrNotCaptain
+	}
+	t := impl.getTeamOfGroup(r, g.ID())
+	if t == nil {
 		return merr.ErrPlayerNotInRoom
 	}
 
-	return impl.exitGame(ctx, p, g, r)
+	impl.leaveCustomRoom(ctx, r, t, g)
+	return nil
+}
+
+func (impl *Impl) MoveCustomRoomPlayer(ctx context.Context, uid, targetUID string, roomID int64, slot int) error {
+	r, err := impl.getCustomRoom(roomID)
+	if err != nil {
+		return err
+	}
+
+	r.Base().Lock()
+	defer r.Base().Unlock()
+
+	if r.Base().Custom.Started {
+		return merr.ErrCustomRoomStarted
+	}
+	if uid != r.Base().Custom.Owner && uid != targetUID {
+		return merr.ErrNotCustomRoomOwner
+	}
+	if slot <= 0 || slot > r.Base().TeamLimit {
+		return merr.ErrInvalidTeamSlot
+	}
+
+	_, g, err := impl.getPlayerAndGroup(targetUID)
+	if err != nil {
+		return err
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	t := impl.getTeamOfGroup(r, g.ID())
+	if t == nil {
+		return merr.ErrPlayerNotInRoom
+	}
+
+	return impl.moveCustomRoomGroup(ctx, r, t, g, slot)
+}
+
+func (impl *Impl) StartCustomRoom(ctx context.Context, uid string, roomID int64) error {
+	r, err := impl.getCustomRoom(roomID)
+	if err != nil {
+		return err
+	}
+
+	r.Base().Lock()
+	defer r.Base().Unlock()
+
+	if r.Base().Custom.Started {
+		return merr.ErrCustomRoomStarted
+	}
+	if uid != r.Base().Custom.Owner {
+		return merr.ErrNotCustomRoomOwner
+	}
+	if len(r.Base().GetTeams()) < r.Base().TeamLimit {
+		return merr.ErrCustomRoomNotReady
+	}
+
+	return impl.startCustomRoom(ctx, r)
+}
+
+func (impl *Impl) OpenBackfill(ctx context.Context, roomID, teamID int64, slots int) error {
+	if slots <= 0 {
+		return merr.ErrInvalidBackfillSlots
+	}
+
+	r := impl.roomMgr.Get(roomID)
+	if r == nil {
+		return merr.ErrRoomNotExists
+	}
+
+	r.Base().Lock()
+	defer r.Base().Unlock()
+
+	if !slices.Contains(r.Base().GetTeams(), teamID) {
+		return merr.ErrTeamNotInRoom
+	}
+
+	return impl.openBackfill(ctx, r, impl.teamMgr.Get(teamID), slots)
 }
 
 func (impl *Impl) SetVoiceState(ctx context.Context, uid string, state entry.PlayerVoiceState) error {
@@ -633,9 +1152,18 @@ func (impl *Impl) UploadPlayerAttr(ctx context.Context, uid string, attr *pto.Up
 }
 
 func (impl *Impl) HandleMatchResult(r common.Result) {
+	ctx, span := startResultSpan(r)
+	defer span.End()
+
 	r.Room.Base().Lock()
 	defer r.Room.Base().Unlock()
-	if err := impl.handleMatchResult(context.Background(), r); err != nil {
+	handle := impl.handleMatchResult
+	if r.Backfill != nil {
+		handle = impl.handleBackfillResult
+	}
+	if err := handle(ctx, r); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		log.Error().
 			Any("room", r).
 			Err(err).
@@ -648,6 +1176,107 @@ func (impl *Impl) HandleGameResult(result *pto.GameResult) error {
 	return nil
 }
 
+func (impl *Impl) ForceCancelMatch(ctx context.Context, groupID int64) error {
+	g := impl.groupMgr.Get(groupID)
+	if g == nil {
+		return merr.ErrGroupNotExists
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	if err := g.Base().CheckState(entry.GroupStateMatch); err != nil {
+		return err
+	}
+
+	impl.cancelMatch(ctx, "", pto.CancelMatchReasonOperator, g)
+	return nil
+}
+
+func (impl *Impl) ForceDissolveGroup(ctx context.Context, groupID int64) error {
+	g := impl.groupMgr.Get(groupID)
+	if g == nil {
+		return merr.ErrGroupNotExists
+	}
+
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	if err := g.Base().CheckState(entry.GroupStateInvite, entry.GroupStateMatch); err != nil {
+		return err
+	}
+
+	return impl.dissolveGroup(ctx, g)
+}
+
+func (impl *Impl) ClearRoom(ctx context.Context, roomID int64) error {
+	r := impl.roomMgr.Get(roomID)
+	if r == nil {
+		return merr.ErrRoomNotExists
+	}
+
+	r.Base().Lock()
+	defer r.Base().Unlock()
+
+	if r.Base().InReadyCheck() {
+		return merr.ErrRoomInReadyCheck
+	}
+	if r.Base().IsCustom() && !r.Base().Custom.Started {
+		impl.dissolveCustomRoom(ctx, r)
+		return nil
+	}
+
+	impl.clearRoom(ctx, r)
+	return nil
+}
+
+// Drain starts draining the queue for maintenance, the negative timeout is rejected.
+func (impl *Impl) Drain(_ context.Context, queueKey string, timeout time.Duration) error {
+	if timeout < 0 {
+		return merr.ErrInvalidDrainTimeout
+	}
+	impl.drain(queueKey, timeout)
+	return nil
+}
+
+func (impl *Impl) Undrain(_ context.Context, queueKey string) error {
+	if !impl.undrain(queueKey) {
+		return merr.ErrQueueNotDraining
+	}
+	return nil
+}
+
+func (impl *Impl) Drains(_ context.Context) map[string]int64 {
+	return impl.drains.deadlines()
+}
+
+func (impl *Impl) ResumeMatchTimeouts(_ context.Context, queueKey string) {
+	impl.resumeMatchTimeouts(queueKey)
+}
+
+// getCustomRoom returns the custom room of the given roomID.
+func (impl *Impl) getCustomRoom(roomID int64) (entry.Room, error) {
+	r := impl.roomMgr.Get(roomID)
+	if r == nil || !r.Base().IsCustom() {
+		return nil, merr.ErrCustomRoomNotExists
+	}
+	return r, nil
+}
+
+// getTeamOfGroup returns the team of the room which the group belongs to, nil if not found.
+func (impl *Impl) getTeamOfGroup(r entry.Room, groupID int64) entry.Team {
+	for _, teamID := range r.Base().GetTeams() {
+		t := impl.teamMgr.Get(teamID)
+		t.Base().Lock()
+		groups := t.Base().GetGroups()
+		t.Base().Unlock()
+		if slices.Contains(groups, groupID) {
+			return t
+		}
+	}
+	return nil
+}
+
 // getPlayerAndGroup returns the player and group of the given uid.
 func (impl *Impl) getPlayerAndGroup(uid string) (entry.Player, entry.Group, error) {
 	p := impl.playerMgr.Get(uid)
@@ -660,3 +1289,9 @@ func (impl *Impl) getPlayerAndGroup(uid string) (entry.Player, entry.Group, erro
 	}
 	return p, g, nil
 }
+
+// deletePlayer removes the player from the service along with its reported location.
+func (impl *Impl) deletePlayer(uid string) {
+	impl.playerMgr.Delete(uid)
+	impl.geoIndex.Remove(uid)
+}
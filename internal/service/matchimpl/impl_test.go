@@ -3,7 +3,11 @@ package matchimpl
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"runtime"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -21,6 +25,8 @@ import (
 	"github.com/hedon954/go-matcher/internal/matcher/common"
 	"github.com/hedon954/go-matcher/internal/merr"
 	"github.com/hedon954/go-matcher/internal/pto"
+	"github.com/hedon954/go-matcher/internal/service/servicemock"
+	"github.com/hedon954/go-matcher/pkg/fsm"
 	"github.com/hedon954/go-matcher/pkg/timer/native"
 )
 
@@ -54,7 +60,8 @@ func defaultImpl(playerLimit int, opts ...Option) *Impl {
 		PlayerMgr: entry.NewPlayerMgr(),
 		GroupMgr:  entry.NewGroupMgr(0),
 		TeamMgr:   entry.NewTeamMgr(0),
-		RoomMgr:   entry.NewRoomMgr(0)},
+		RoomMgr:   entry.NewRoomMgr(0),
+		SM:        entry.NewStateMachines()},
 		gc, rc, native.NewTimer(), opts...,
 	)
 }
@@ -125,6 +132,7 @@ func createTempTeam(impl *Impl, g entry.Group, t *testing.T) entry.Team {
 
 func createTempRoom(uid string, impl *Impl, t *testing.T) (entry.Player, entry.Group, entry.Room) {
 	p, g := createTempGroup(uid, impl, t)
+	g.Base().SetState(entry.GroupStateMatch) // the groups of a room are matched from the queue
 	room, err := impl.mgrs.CreateRoom(1, createTempTeam(impl, g, t))
 	assert.Nil(t, err)
 	assert.Nil(t, impl.roomMgr.Get(room.ID()))
@@ -751,6 +759,152 @@ func TestImpl_RefuseInvite(t *testing.T) {
 	assert.Equal(t, 0, len(g.Base().GetInviteRecords()))
 }
 
+func TestImpl_InviteLifecycle(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, g := createTempGroup(UID, impl, t)
+	assert.Nil(t, impl.EnterGroup(ctx, newEnterGroupParam(UID+"1"), g.ID()))
+	inviteID := func() int64 { return g.Base().GetInviteRecord(UID + "2").ID }
+
+	// 1. list the pending invites from both sides, a new invite replaces the old one
+	assert.Nil(t, impl.Invite(ctx, UID, UID+"2"))
+	oldID := inviteID()
+	assert.Nil(t, impl.Invite(ctx, UID, UID+"2"))
+	assert.Nil(t, impl.delayTimer.Get(TimerOpTypeInviteExpire, oldID))
+	assert.NotNil(t, impl.delayTimer.Get(TimerOpTypeInviteExpire, inviteID()))
+	received, err := impl.ListReceivedInvites(ctx, UID+"2")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(received))
+	assert.Equal(t, g.ID(), received[0].GroupID)
+	assert.Equal(t, UID, received[0].InviterUID)
+	sent, err := impl.ListSentInvites(ctx, UID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(sent))
+	sent, _ = impl.ListSentInvites(ctx, UID+"1")
+	assert.Equal(t, 0, len(sent))
+	_, err = impl.ListSentInvites(ctx, UID+"9")
+	assert.Equal(t, merr.ErrPlayerNotExists, err)
+
+	// 2. only the inviter can revoke the invite
+	assert.Equal(t, merr.ErrInvitationNotExists, impl.RevokeInvite(ctx, UID+"1", UID+"2"))
+	assert.Equal(t, merr.ErrInvitationNotExists, impl.RevokeInvite(ctx, UID, UID+"3"))
+	id := inviteID()
+	assert.Nil(t, impl.RevokeInvite(ctx, UID, UID+"2"))
+	assert.Nil(t, g.Base().GetInviteRecord(UID+"2"))
+	assert.Nil(t, impl.delayTimer.Get(TimerOpTypeInviteExpire, id))
+	received, _ = impl.ListReceivedInvites(ctx, UID+"2")
+	assert.Equal(t, 0, len(received))
+
+	// 3. the invite is deleted once expired
+	assert.Nil(t, impl.Invite(ctx, UID, UID+"2"))
+	impl.inviteExpireHandler(inviteID())
+	assert.Equal(t, 0, len(g.Base().GetInviteRecords()))
+	assert.Equal(t, 0, len(impl.invites.keys))
+
+	// 4. the invites of the dissolved groups are dropped
+	assert.Nil(t, impl.Invite(ctx, UID+"1", UID+"2"))
+	impl.groupMgr.Delete(g.ID())
+	received, _ = impl.ListReceivedInvites(ctx, UID+"2")
+	assert.Equal(t, 0, len(received))
+	assert.Equal(t, 0, len(impl.invites.keys))
+	impl.groupMgr.Add(g.ID(), g)
+}
+
+func TestImpl_RestoreInvites(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, g := createTempGroup(UID, impl, t)
+	assert.Nil(t, impl.Invite(ctx, UID, UID+"1"))
+	assert.Nil(t, impl.Invite(ctx, UID, UID+"1"))
+	restoredID := g.Base().GetInviteRecord(UID + "1").ID
+	now := impl.nowFunc()
+	g.Base().InviteRecords[UID+"2"] = &entry.InviteRecord{InviteeUID: UID + "2", ExpireSec: now + 60}
+	g.Base().InviteRecords[UID+"3"] = &entry.InviteRecord{ID: restoredID + 1, InviteeUID: UID + "3", ExpireSec: now}
+
+	// 1. the invites are indexed again after the server restarts, the expired ones are dropped
+	impl.invites = newInviteIndex()
+	impl.removeInviteExpireTimer(restoredID)
+	impl.RestoreInvites([]entry.Group{g})
+	received, err := impl.ListReceivedInvites(ctx, UID+"1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(received))
+	assert.NotNil(t, impl.delayTimer.Get(TimerOpTypeInviteExpire, restoredID))
+	assert.Nil(t, g.Base().GetInviteRecord(UID+"3"))
+
+	// 2. the invites of the old backups are given new ids after the restored ones
+	legacyID := g.Base().GetInviteRecord(UID + "2").ID
+	assert.Greater(t, legacyID, restoredID+1)
+	received, _ = impl.ListReceivedInvites(ctx, UID+"2")
+	assert.Equal(t, 1, len(received))
+
+	// 3. the new invites never reuse the restored ids
+	assert.Nil(t, impl.Invite(ctx, UID, UID+"4"))
+	assert.Equal(t, legacyID+1, g.Base().GetInviteRecord(UID+"4").ID)
+	impl.inviteExpireHandler(restoredID)
+	assert.Nil(t, g.Base().GetInviteRecord(UID+"1"))
+	assert.NotNil(t, g.Base().GetInviteRecord(UID+"4"))
+}
+
+func TestImpl_Invite_checks(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, _ = createTempGroup(UID, impl, t)
+
+	// 1. check whether the invitee can play together by the last known mode version
+	param := newCreateGroupParam(UID + "1")
+	param.ModeVersion = ModeVersion + 1
+	_, err := impl.CreateGroup(ctx, param)
+	assert.Nil(t, err)
+	assert.Equal(t, merr.ErrGroupVersionTooLow, impl.Invite(ctx, UID, UID+"1"))
+
+	// 2. rate limit of the inviter
+	impl.Configer.Get().Invite = &config.InviteConfig{RateLimit: 2, RateLimitWindowSec: 60}
+	now := impl.nowFunc()
+	impl.nowFunc = func() int64 { return now }
+	assert.Nil(t, impl.Invite(ctx, UID, UID+"2"))
+	assert.Nil(t, impl.Invite(ctx, UID, UID+"3"))
+	assert.Equal(t, merr.ErrInviteTooFrequent, impl.Invite(ctx, UID, UID+"4"))
+	impl.nowFunc = func() int64 { return now + 60 }
+	assert.Nil(t, impl.Invite(ctx, UID, UID+"4"))
+}
+
+func TestImpl_Invite_concurrentAccept(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, g := createTempGroup(UID, impl, t)
+
+	// the invitee can not play with the group, so both sides lock the invitee and the group and then fail
+	param := newCreateGroupParam(UID + "1")
+	param.ModeVersion = ModeVersion + 1
+	_, err := impl.CreateGroup(ctx, param)
+	assert.Nil(t, err)
+	inviteeInfo := impl.playerMgr.Get(UID + "1").GetPlayerInfo()
+
+	// re-inviting while the invitee is accepting should not deadlock
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	const times = 100000
+	done := make(chan struct{}, 2)
+	go func() {
+		for i := 0; i < times; i++ {
+			_ = impl.Invite(ctx, UID, UID+"1")
+		}
+		done <- struct{}{}
+	}()
+	go func() {
+		for i := 0; i < times; i++ {
+			_ = impl.AcceptInvite(ctx, UID, inviteeInfo, g.ID())
+		}
+		done <- struct{}{}
+	}()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("invite and accept invite deadlocked")
+		}
+	}
+}
+
 func TestImpl_AcceptInvite(t *testing.T) {
 	impl := defaultImpl(PlayerLimit)
 
@@ -832,10 +986,10 @@ func TestImpl_AcceptInvite(t *testing.T) {
 		err = impl.AcceptInvite(ctx, inviter.UID(), invitee.GetPlayerInfo(), g.ID())
 		assert.Equal(t, merr.ErrInvitationExpired, err)
 		// 6.2 invitation expired
-		g.Base().AddInviteRecord(invitee.UID(), time.Now().Unix()-entry.InviteExpireSec-1)
+		g.Base().AddInviteRecord(0, inviter.UID(), invitee.UID(), time.Now().Unix()-entry.InviteExpireSec-1)
 		err = impl.AcceptInvite(ctx, inviter.UID(), invitee.GetPlayerInfo(), g.ID())
 		assert.Equal(t, merr.ErrInvitationExpired, err)
-		g.Base().AddInviteRecord(invitee.UID(), time.Now().Unix()) // set back
+		g.Base().AddInviteRecord(0, inviter.UID(), invitee.UID(), time.Now().Unix()) // set back
 	})
 
 	t.Run("7. if the group is full, should return err", func(t *testing.T) {
@@ -861,6 +1015,278 @@ func TestImpl_AcceptInvite(t *testing.T) {
 	})
 }
 
+type relationStub struct {
+	friends map[string]string
+	clans   map[string]string
+}
+
+func (r *relationStub) IsFriend(_ context.Context, uid, otherUID string) (bool, error) {
+	return r.friends[uid] == otherUID || r.friends[otherUID] == uid, nil
+}
+
+func (r *relationStub) InSameClan(_ context.Context, uid, otherUID string) (bool, error) {
+	return r.clans[uid] != "" && r.clans[uid] == r.clans[otherUID], nil
+}
+
+func TestImpl_EnterGroup_source(t *testing.T) {
+	relation := &relationStub{
+		friends: map[string]string{UID + "2": UID},
+		clans:   map[string]string{UID: "clan", UID + "3": "clan", UID + "4": "other"},
+	}
+	impl := defaultImpl(PlayerLimit, WithRelation(relation))
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, g := createTempGroup(UID, impl, t)
+
+	// friend
+	err := impl.EnterGroup(ctx, newEnterGroupParamWithSrc(UID+"2", pto.EnterGroupSourceTypeFriend), g.ID())
+	assert.Equal(t, merr.ErrGroupDenyFriendJoin, err)
+	assert.Nil(t, impl.SetFriendJoinGroup(ctx, UID, true))
+	err = impl.EnterGroup(ctx, newEnterGroupParamWithSrc(UID+"3", pto.EnterGroupSourceTypeFriend), g.ID())
+	assert.Equal(t, merr.ErrNotFriend, err)
+	err = impl.EnterGroup(ctx, newEnterGroupParamWithSrc(UID+"2", pto.EnterGroupSourceTypeFriend), g.ID())
+	assert.Nil(t, err)
+
+	// world channel
+	err = impl.EnterGroup(ctx, newEnterGroupParamWithSrc(UID+"5", pto.EnterGroupSourceTypeWorldChannel), g.ID())
+	assert.Equal(t, merr.ErrGroupDenyWorldChannelJoin, err)
+	assert.Equal(t, merr.ErrPermissionDeny, impl.SetWorldChannelJoinGroup(ctx, UID+"2", true))
+	assert.Nil(t, impl.SetWorldChannelJoinGroup(ctx, UID, true))
+	assert.True(t, g.Base().AllowWorldChannelJoin())
+
+	// clan channel
+	err = impl.EnterGroup(ctx, newEnterGroupParamWithSrc(UID+"3", pto.EnterGroupSourceTypeClanChannel), g.ID())
+	assert.Equal(t, merr.ErrGroupDenyClanChannelJoin, err)
+	assert.Nil(t, impl.SetClanChannelJoinGroup(ctx, UID, true))
+	err = impl.EnterGroup(ctx, newEnterGroupParamWithSrc(UID+"4", pto.EnterGroupSourceTypeClanChannel), g.ID())
+	assert.Equal(t, merr.ErrNotClanMember, err)
+	err = impl.EnterGroup(ctx, newEnterGroupParamWithSrc(UID+"3", pto.EnterGroupSourceTypeClanChannel), g.ID())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(g.Base().GetPlayers()))
+
+	// the default relation service treats no players as friends or clan members
+	impl = defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, g = createTempGroup(UID, impl, t)
+	assert.Nil(t, impl.SetFriendJoinGroup(ctx, UID, true))
+	assert.Nil(t, impl.SetClanChannelJoinGroup(ctx, UID, true))
+	err = impl.EnterGroup(ctx, newEnterGroupParamWithSrc(UID+"2", pto.EnterGroupSourceTypeFriend), g.ID())
+	assert.Equal(t, merr.ErrNotFriend, err)
+	err = impl.EnterGroup(ctx, newEnterGroupParamWithSrc(UID+"3", pto.EnterGroupSourceTypeClanChannel), g.ID())
+	assert.Equal(t, merr.ErrNotClanMember, err)
+}
+
+func TestImpl_ShareGroup(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, g := createTempGroup(UID, impl, t)
+	_, g2 := createTempGroup(UID+"9", impl, t)
+	enter := func(uid, token string, groupID int64) error {
+		info := newEnterGroupParamWithSrc(uid, pto.EnterGroupSourceTypeShare)
+		info.ShareToken = token
+		return impl.EnterGroup(ctx, info, groupID)
+	}
+
+	// 1. sharing is disabled if not configured
+	_, _, err := impl.ShareGroup(ctx, UID)
+	assert.Equal(t, merr.ErrShareDisabled, err)
+	assert.Equal(t, merr.ErrShareDisabled, enter(UID+"2", "token", g.ID()))
+
+	impl.Configer.Get().ShareToken = &config.ShareTokenConfig{Secret: "secret", ExpireSec: 60}
+	now := impl.nowFunc()
+	impl.nowFunc = func() int64 { return now }
+	token, expireAt, err := impl.ShareGroup(ctx, UID)
+	assert.Nil(t, err)
+	assert.Equal(t, now+60, expireAt)
+
+	// 2. the token is bound to the group and can not be tampered
+	assert.Equal(t, merr.ErrInvalidShareToken, enter(UID+"2", "", g.ID()))
+	assert.Equal(t, merr.ErrInvalidShareToken, enter(UID+"2", token, g2.ID()))
+	assert.Equal(t, merr.ErrInvalidShareToken, enter(UID+"2", token+"x", g.ID()))
+	forged := fmt.Sprintf("%d.%d.", g2.ID(), expireAt) + token[strings.LastIndex(token, ".")+1:]
+	assert.Equal(t, merr.ErrInvalidShareToken, enter(UID+"2", forged, g2.ID()))
+
+	// 3. valid token
+	assert.Nil(t, enter(UID+"2", token, g.ID()))
+	assert.True(t, g.Base().PlayerExists(UID+"2"))
+
+	// 4. expired token
+	impl.nowFunc = func() int64 { return expireAt }
+	assert.Equal(t, merr.ErrShareTokenExpired, enter(UID+"3", token, g.ID()))
+}
+
+func TestImpl_RecentGroups(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+
+	// UID and UID+"1" are teammates, UID+"2" is the opponent
+	_, g1, r := createTempRoom(UID, impl, t)
+	g1.Base().SetState(entry.GroupStateInvite) // the teammate joins before the match
+	assert.Nil(t, impl.EnterGroup(ctx, newEnterGroupParam(UID+"1"), g1.ID()))
+	g1.Base().SetState(entry.GroupStateGame)
+	_, g2 := createTempGroup(UID+"2", impl, t)
+	r.Base().AddTeam(createTempTeam(impl, g2, t))
+	assert.Nil(t, impl.HandleGameResult(&pto.GameResult{RoomID: r.ID(), GameMode: GameMode}))
+
+	// 1. player not exists
+	_, err := impl.RecentGroups(ctx, UID+"9")
+	assert.Equal(t, merr.ErrPlayerNotExists, err)
+
+	// 2. the group of the opponent denies recent join, the own group is never returned
+	groups, err := impl.RecentGroups(ctx, UID)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(groups))
+
+	// 3. the opponent group is joinable after allowing recent join
+	assert.Nil(t, impl.SetRecentJoinGroup(ctx, UID+"2", true))
+	groups, err = impl.RecentGroups(ctx, UID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, g2.ID(), groups[0].GroupID)
+	assert.Equal(t, UID+"2", groups[0].UID)
+	assert.Equal(t, pto.RecentRelationOpponent, groups[0].Relation)
+	assert.Equal(t, 1, groups[0].PlayerCount)
+
+	// 4. the teammates are recorded as well
+	assert.Nil(t, impl.ExitGroup(ctx, UID+"1"))
+	_, g3 := createTempGroup(UID+"1", impl, t)
+	g3.Base().SetAllowRecentJoin(true)
+	groups, err = impl.RecentGroups(ctx, UID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(groups))
+	relations := map[int64]pto.RecentRelation{}
+	for _, g := range groups {
+		relations[g.GroupID] = g.Relation
+	}
+	assert.Equal(t, pto.RecentRelationOpponent, relations[g2.ID()])
+	assert.Equal(t, pto.RecentRelationTeammate, relations[g3.ID()])
+	groups, err = impl.RecentGroups(ctx, UID+"2")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, g3.ID(), groups[0].GroupID)
+
+	// 5. filtered by the group state and mode version
+	g3.Base().SetStateWithLock(entry.GroupStateMatch)
+	groups, _ = impl.RecentGroups(ctx, UID)
+	assert.Equal(t, 1, len(groups))
+	g3.Base().SetStateWithLock(entry.GroupStateInvite)
+	g3.Base().ModeVersion++
+	groups, _ = impl.RecentGroups(ctx, UID)
+	assert.Equal(t, 1, len(groups))
+
+	// 6. expired records are dropped
+	impl.Configer.Get().Discovery = &config.DiscoveryConfig{RecentExpireSec: 1}
+	now := impl.nowFunc()
+	impl.nowFunc = func() int64 { return now + 1 }
+	groups, _ = impl.RecentGroups(ctx, UID)
+	assert.Equal(t, 0, len(groups))
+}
+
+func Test_recentPlayers(t *testing.T) {
+	rp := newRecentPlayers()
+	rp.add(UID, []recentPlayer{{UID: "a", PlayedAtSec: 1}, {UID: "b", PlayedAtSec: 1}}, 3, 1, 10)
+	rp.add(UID, []recentPlayer{{UID: "c", PlayedAtSec: 2}, {UID: "a", PlayedAtSec: 2}}, 3, 2, 10)
+
+	// the latest first, the old record of the same player is replaced and the limit is kept
+	assert.Equal(t, []recentPlayer{{UID: "c", PlayedAtSec: 2}, {UID: "a", PlayedAtSec: 2}, {UID: "b", PlayedAtSec: 1}},
+		rp.list(UID, 0))
+	assert.Equal(t, []recentPlayer{{UID: "c", PlayedAtSec: 2}, {UID: "a", PlayedAtSec: 2}}, rp.list(UID, 1))
+	assert.Equal(t, 0, len(rp.list(UID, 2)))
+	assert.Equal(t, 0, len(rp.players))
+
+	// the expired records are pruned on writing, and the other lists are swept once per expire window
+	rp.add(UID, []recentPlayer{{UID: "a", PlayedAtSec: 10}}, 3, 10, 10)
+	rp.add("other", []recentPlayer{{UID: "b", PlayedAtSec: 15}}, 3, 15, 10)
+	assert.Equal(t, 2, len(rp.players))
+	rp.add("other", []recentPlayer{{UID: "c", PlayedAtSec: 21}}, 3, 21, 10)
+	assert.Equal(t, map[string][]recentPlayer{"other": {{UID: "c", PlayedAtSec: 21}, {UID: "b", PlayedAtSec: 15}}}, rp.players)
+	rp.add("other", []recentPlayer{{UID: "d", PlayedAtSec: 26}}, 3, 26, 10)
+	assert.Equal(t, []recentPlayer{{UID: "d", PlayedAtSec: 26}, {UID: "c", PlayedAtSec: 21}}, rp.players["other"])
+}
+
+func Test_rateLimiter(t *testing.T) {
+	rl := newRateLimiter()
+	assert.True(t, rl.allow(UID, 10, 2, 10))
+	assert.True(t, rl.allow(UID, 11, 2, 10))
+	assert.False(t, rl.allow(UID, 12, 2, 10))
+	assert.Equal(t, []int64{10, 11}, rl.records[UID])
+
+	// the records out of the window are dropped
+	assert.True(t, rl.allow(UID, 20, 2, 10))
+	assert.Equal(t, []int64{11, 20}, rl.records[UID])
+
+	// the idle keys are swept once per window
+	assert.True(t, rl.allow("other", 25, 2, 10))
+	assert.Equal(t, 2, len(rl.records))
+	assert.True(t, rl.allow("other", 31, 2, 10))
+	assert.Equal(t, 1, len(rl.records))
+	assert.NotContains(t, rl.records, UID)
+}
+
+func TestImpl_NearbyGroups(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, _ = createTempGroup(UID, impl, t)
+	_, g1 := createTempGroup(UID+"1", impl, t)
+	_, g2 := createTempGroup(UID+"2", impl, t)
+	g1.Base().SetAllowNearbyJoin(true)
+	g2.Base().SetAllowNearbyJoin(true)
+
+	// 1. invalid location or player
+	assert.Equal(t, merr.ErrInvalidLocation, impl.ReportLocation(ctx, UID, 91, 0))
+	assert.Equal(t, merr.ErrPlayerNotExists, impl.ReportLocation(ctx, UID+"9", 31.23, 121.47))
+	_, err := impl.NearbyGroups(ctx, UID)
+	assert.Equal(t, merr.ErrLocationNotReported, err)
+
+	// 2. the nearest first, the players out of radius are skipped
+	assert.Nil(t, impl.ReportLocation(ctx, UID, 31.2304, 121.4737))
+	assert.Nil(t, impl.ReportLocation(ctx, UID+"1", 31.3000, 121.5000))
+	assert.Nil(t, impl.ReportLocation(ctx, UID+"2", 31.2310, 121.4740))
+	groups, err := impl.NearbyGroups(ctx, UID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(groups))
+	assert.Equal(t, g2.ID(), groups[0].GroupID)
+	assert.Equal(t, g1.ID(), groups[1].GroupID)
+	assert.Less(t, groups[0].DistanceKm, groups[1].DistanceKm)
+
+	assert.Nil(t, impl.ReportLocation(ctx, UID+"1", 39.9042, 116.4074))
+	groups, _ = impl.NearbyGroups(ctx, UID)
+	assert.Equal(t, 1, len(groups))
+
+	// 3. filtered by the group settings
+	g2.Base().SetAllowNearbyJoin(false)
+	groups, _ = impl.NearbyGroups(ctx, UID)
+	assert.Equal(t, 0, len(groups))
+
+	// 4. only the players in the joinable groups count towards the nearby limit
+	g2.Base().SetAllowNearbyJoin(true)
+	g1.Base().SetAllowNearbyJoin(false)
+	assert.Nil(t, impl.ReportLocation(ctx, UID+"1", 31.2305, 121.4738))
+	impl.Configer.Get().Discovery = &config.DiscoveryConfig{NearbyLimit: 1}
+	groups, _ = impl.NearbyGroups(ctx, UID)
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, g2.ID(), groups[0].GroupID)
+
+	// 5. the expired locations are skipped and removed, the expired caller location is rejected
+	impl.Configer.Get().Discovery = &config.DiscoveryConfig{LocationExpireSec: 1}
+	now := impl.nowFunc()
+	impl.nowFunc = func() int64 { return now + 1 }
+	_, err = impl.NearbyGroups(ctx, UID)
+	assert.Equal(t, merr.ErrLocationExpired, err)
+	_, err = impl.NearbyGroups(ctx, UID)
+	assert.Equal(t, merr.ErrLocationNotReported, err)
+	assert.Nil(t, impl.ReportLocation(ctx, UID, 31.2304, 121.4737))
+	groups, _ = impl.NearbyGroups(ctx, UID)
+	assert.Equal(t, 0, len(groups))
+	_, _, ok := impl.geoIndex.Get(UID + "2")
+	assert.False(t, ok)
+
+	// 6. the location is removed when the player exits
+	assert.Nil(t, impl.ReportLocation(ctx, UID+"2", 31.2310, 121.4740))
+	assert.Nil(t, impl.ExitGroup(ctx, UID+"2"))
+	_, _, ok = impl.geoIndex.Get(UID + "2")
+	assert.False(t, ok)
+}
+
 func TestImpl_SetVoiceState(t *testing.T) {
 	impl := defaultImpl(PlayerLimit)
 
@@ -892,6 +1318,85 @@ func TestImpl_SetVoiceState(t *testing.T) {
 	})
 }
 
+type contentFilterStub struct {
+	filtered []string
+}
+
+func (f *contentFilterStub) Filter(_ context.Context, _, content string) (string, error) {
+	if strings.Contains(content, "bad") {
+		return "", errors.New("content rejected")
+	}
+	f.filtered = append(f.filtered, content)
+	return content, nil
+}
+
+func TestImpl_SendGroupMessage(t *testing.T) {
+	filter := &contentFilterStub{}
+	impl := defaultImpl(PlayerLimit, WithContentFilter(filter))
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	impl.Configer.Get().GroupChat = &config.GroupChatConfig{
+		MaxLength: 5, RateLimit: 4, RateLimitWindowSec: 10, Emotes: []string{"smile"},
+	}
+	now := time.Now().Unix()
+	impl.nowFunc = func() int64 { return now }
+
+	t.Run("1. if message is invalid, should return err", func(t *testing.T) {
+		assert.Equal(t, merr.ErrInvalidMessageType, impl.SendGroupMessage(ctx, UID, 0, "hi"))
+		assert.Equal(t, merr.ErrEmptyMessage, impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeText, "  "))
+		assert.Equal(t, merr.ErrMessageTooLong, impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeText, "hello!"))
+		assert.Equal(t, merr.ErrPlayerNotExists, impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeText, "你好世界啊"))
+	})
+
+	_, g := createTempGroup(UID, impl, t)
+
+	t.Run("2. preset messages are checked by ids and the others are filtered", func(t *testing.T) {
+		assert.Equal(t, merr.ErrUnknownPresetMessage, impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeEmote, "bad"))
+		assert.Nil(t, impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeEmote, "smile"))
+		assert.Equal(t, "content rejected", impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeText, "bad").Error())
+		assert.Equal(t, "content rejected", impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeQuick, "bad").Error())
+		assert.Nil(t, impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeText, "hi"))
+		assert.Equal(t, []string{"hi"}, filter.filtered)
+	})
+
+	t.Run("3. if sent too frequently, should return err until the window passes", func(t *testing.T) {
+		assert.Equal(t, merr.ErrSendMessageTooFrequent, impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeQuick, "gg"))
+		impl.nowFunc = func() int64 { return now + 10 }
+		assert.Nil(t, impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeQuick, "gg"))
+	})
+
+	t.Run("4. can send while matching but not in game", func(t *testing.T) {
+		g.Base().SetState(entry.GroupStateMatch)
+		assert.Nil(t, impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeQuick, "gg"))
+		g.Base().SetState(entry.GroupStateGame)
+		assert.Equal(t, merr.ErrGroupInGame, impl.SendGroupMessage(ctx, UID, pto.GroupMessageTypeQuick, "gg"))
+	})
+}
+
+func TestImpl_stateMachines(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	impl.Configer.Get().DelayTimerConfig.MatchTimeoutMs = 60000
+	p, g := createTempGroup(UID, impl, t)
+	assert.NotNil(t, impl.delayTimer.Get(TimerOpTypeGroupInvite, g.ID()))
+
+	// entering match replaces the invite timer with the match timer
+	assert.Nil(t, impl.setGroupState(ctx, g, entry.GroupStateMatch))
+	assert.Nil(t, impl.delayTimer.Get(TimerOpTypeGroupInvite, g.ID()))
+	assert.NotNil(t, impl.delayTimer.Get(TimerOpTypeGroupMatch, g.ID()))
+
+	// undeclared transitions are rejected and the states are kept
+	err := impl.setGroupState(ctx, g, entry.GroupStateCustomRoom)
+	assert.True(t, errors.Is(err, fsm.ErrInvalidTransition))
+	assert.Equal(t, entry.GroupStateMatch, g.Base().GetState())
+	err = impl.setPlayerState(ctx, p, entry.PlayerOnlineStateInSettle)
+	assert.True(t, errors.Is(err, fsm.ErrInvalidTransition))
+	assert.Equal(t, entry.PlayerOnlineStateInGroup, p.Base().GetOnlineState())
+
+	// leaving match removes the match timer
+	assert.Nil(t, impl.setGroupState(ctx, g, entry.GroupStateInvite))
+	assert.Nil(t, impl.delayTimer.Get(TimerOpTypeGroupMatch, g.ID()))
+}
+
 func TestImpl_StartMatch(t *testing.T) {
 	impl := defaultImpl(PlayerLimit)
 
@@ -946,6 +1451,27 @@ func TestImpl_StartMatch(t *testing.T) {
 	})
 }
 
+func TestImpl_StartMatch_gameModeConfig(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	impl.Configer.Get().Modes = map[constant.GameMode]*config.GameModeConfig{
+		GameMode: {GroupPlayerLimit: 2, InviteExpireSec: 30, RequireAllReady: true},
+	}
+
+	_, g := createTempGroup(UID, impl, t)
+	assert.Equal(t, 2, g.Base().Configs.PlayerLimit)
+	assert.Equal(t, int64(30), g.Base().Configs.InviteExpireSec)
+	assert.Nil(t, impl.EnterGroup(ctx, newEnterGroupParam(UID+"1"), g.ID()))
+	assert.Equal(t, merr.ErrGroupFull, impl.EnterGroup(ctx, newEnterGroupParam(UID+"2"), g.ID()))
+
+	// all the players should be ready before starting to match
+	assert.Nil(t, impl.Unready(ctx, UID+"1"))
+	assert.Equal(t, merr.ErrNotAllReady, impl.StartMatch(ctx, UID))
+	assert.Nil(t, impl.Ready(ctx, UID+"1"))
+	assert.Nil(t, impl.StartMatch(ctx, UID))
+	assert.Equal(t, constant.MatchStrategyGlicko2, g.Base().MatchStrategy)
+}
+
 func TestImpl_CancelMatch(t *testing.T) {
 	impl := defaultImpl(PlayerLimit)
 
@@ -1067,6 +1593,7 @@ func TestImpl_HandleMatchResult(t *testing.T) {
 	impl := defaultImpl(PlayerLimit)
 
 	_, _, room := createTempRoom(UID, impl, t)
+	room.Base().Region = "sh"
 	teams := make([]entry.Team, 0)
 	for _, teamID := range room.Base().GetTeams() {
 		teams = append(teams, impl.teamMgr.Get(teamID))
@@ -1084,6 +1611,7 @@ func TestImpl_HandleMatchResult(t *testing.T) {
 		Host:     "127.0.0.1",
 		Port:     8080,
 		Protocol: constant.KCP,
+		Region:   "sh",
 	}, room.Base().GameServerInfo)
 
 	for _, teamID := range room.Base().GetTeams() {
@@ -1103,6 +1631,55 @@ func TestImpl_HandleMatchResult(t *testing.T) {
 	}
 }
 
+func TestImpl_HandleMatchResult_withAI(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+
+	_, g := createTempGroup(UID, impl, t)
+	g.Base().SetState(entry.GroupStateMatch)
+	team := createTempTeam(impl, g, t)
+	room, err := impl.mgrs.CreateRoom(2, team)
+	assert.Nil(t, err)
+	room.Base().WithAI = true
+	impl.HandleMatchResult(common.Result{Room: room, Teams: []entry.Team{team}})
+
+	// the team is filled to the limit with AI, and an AI team takes the empty slot
+	assert.Equal(t, 2, len(room.Base().GetTeams()))
+	aiUIDs := make([]string, 0)
+	for _, teamID := range room.Base().GetTeams() {
+		tt := impl.teamMgr.Get(teamID)
+		assert.Equal(t, PlayerLimit, impl.getTeamPlayerCount(tt))
+		assert.Equal(t, tt.ID() != team.ID(), tt.Base().IsAI)
+		for _, groupID := range tt.Base().GetGroups() {
+			ag := impl.groupMgr.Get(groupID)
+			assert.Equal(t, entry.GroupStateGame, ag.Base().GetStateWithLock())
+			for _, uid := range ag.Base().GetPlayers() {
+				if impl.playerMgr.Get(uid).Base().IsAI {
+					aiUIDs = append(aiUIDs, uid)
+				}
+				assert.Equal(t, entry.PlayerOnlineStateInGame, impl.playerMgr.Get(uid).Base().GetOnlineStateWithLock())
+			}
+		}
+	}
+	assert.Equal(t, 2*PlayerLimit-1, len(aiUIDs))
+	assert.Equal(t, []string{UID}, impl.getRoomUIDs(room))
+
+	// the match info carries the AI players in their teams
+	info := impl.getMatchInfo(room)
+	assert.Equal(t, 2, len(info.Teams))
+	for i, team := range info.Teams {
+		assert.Equal(t, i+1, team.TeamID)
+		assert.Equal(t, PlayerLimit, len(team.Players))
+	}
+
+	// the AI are released with the room
+	assert.Nil(t, impl.HandleGameResult(&pto.GameResult{RoomID: room.ID(), GameMode: GameMode}))
+	assert.Equal(t, []int64{team.ID()}, room.Base().GetTeams())
+	assert.Equal(t, 1, impl.getTeamPlayerCount(team))
+	for _, uid := range aiUIDs {
+		assert.Nil(t, impl.playerMgr.Get(uid))
+	}
+}
+
 func TestImpl_HandleGameResult(t *testing.T) {
 	impl := defaultImpl(PlayerLimit)
 
@@ -1143,5 +1720,603 @@ func TestImpl_ExitGame(t *testing.T) {
 		assert.Equal(t, entry.GroupStateDissolved, g.Base().GetState())
 		assert.Nil(t, impl.playerMgr.Get(p.UID()))
 		assert.Nil(t, impl.groupMgr.Get(g.ID()))
+		team := impl.teamMgr.Get(r.Base().GetTeams()[0])
+		assert.Equal(t, 0, len(team.Base().GetGroups()))
+	})
+}
+
+type backfillRecorder struct {
+	opened map[int64]int
+	closed []int64
+}
+
+func (b *backfillRecorder) OpenBackfill(_ entry.Room, t entry.Team, slots int) error {
+	b.opened[t.ID()] = slots
+	return nil
+}
+
+func (b *backfillRecorder) CloseBackfill(_ entry.Room, t entry.Team) {
+	b.closed = append(b.closed, t.ID())
+}
+
+func TestImpl_OpenBackfill(t *testing.T) {
+	recorder := &backfillRecorder{opened: make(map[int64]int)}
+	impl := defaultImpl(PlayerLimit, WithBackfill(recorder))
+
+	_, _, r := createTempRoom(UID, impl, t)
+	team := impl.teamMgr.Get(r.Base().GetTeams()[0])
+
+	assert.Equal(t, merr.ErrInvalidBackfillSlots, impl.OpenBackfill(ctx, r.ID(), team.ID(), 0))
+	assert.Equal(t, merr.ErrRoomNotExists, impl.OpenBackfill(ctx, -1, team.ID(), 1))
+	assert.Equal(t, merr.ErrTeamNotInRoom, impl.OpenBackfill(ctx, r.ID(), -1, 1))
+
+	assert.Nil(t, impl.OpenBackfill(ctx, r.ID(), team.ID(), 2))
+	assert.Equal(t, 2, recorder.opened[team.ID()])
+	assert.Equal(t, 2, team.Base().OpenSlots)
+
+	// escape opens one more slot if configured
+	impl.Configer.Get().BackfillOnEscape = true
+	assert.Nil(t, impl.ExitGame(ctx, UID, r.ID()))
+	assert.Equal(t, 3, recorder.opened[team.ID()])
+	assert.Equal(t, 3, team.Base().OpenSlots)
+
+	// game result closes the backfill requests
+	assert.Nil(t, impl.HandleGameResult(&pto.GameResult{RoomID: r.ID(), GameMode: GameMode}))
+	assert.Equal(t, []int64{team.ID()}, recorder.closed)
+	assert.Equal(t, 0, team.Base().OpenSlots)
+}
+
+type matchInfoRecorder struct {
+	servicemock.PushMock
+	uids []string
+	info *pto.MatchInfo
+}
+
+func (p *matchInfoRecorder) PushMatchInfo(_ context.Context, uids []string, info *pto.MatchInfo) {
+	p.uids, p.info = uids, info
+}
+
+func TestImpl_HandleMatchResult_Backfill(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	recorder := new(matchInfoRecorder)
+	impl.pushService = recorder
+
+	_, g0, r := createTempRoom(UID, impl, t)
+	team := impl.teamMgr.Get(r.Base().GetTeams()[0])
+	team.Base().OpenSlots = 2
+
+	p, g := createTempGroup(UID+"1", impl, t)
+	g.Base().SetStateWithLock(entry.GroupStateMatch)
+
+	impl.HandleMatchResult(common.Result{
+		Room:     r,
+		Backfill: &common.Backfill{Team: team, Groups: []entry.Group{g}},
+	})
+	assert.Contains(t, team.Base().GetGroups(), g.ID())
+	assert.Equal(t, 1, team.Base().OpenSlots)
+	assert.Equal(t, entry.GroupStateGame, g.Base().GetStateWithLock())
+	assert.Equal(t, entry.PlayerOnlineStateInGame, p.Base().GetOnlineStateWithLock())
+
+	// the updated match info with the teams is pushed to all the players of the room
+	assert.ElementsMatch(t, []string{UID, UID + "1"}, recorder.uids)
+	assert.Equal(t, r.ID(), recorder.info.RoomID)
+	assert.Equal(t, []pto.MatchTeamInfo{{TeamID: 1, Players: []pto.MatchPlayerInfo{
+		{UID: UID, GroupID: g0.ID()}, {UID: UID + "1", GroupID: g.ID()},
+	}}}, recorder.info.Teams)
+
+	// the room has been cleared, the groups should be sent back to match
+	_, g2 := createTempGroup(UID+"2", impl, t)
+	impl.roomMgr.Delete(r.ID())
+	impl.HandleMatchResult(common.Result{
+		Room:     r,
+		Backfill: &common.Backfill{Team: team, Groups: []entry.Group{g2}},
+	})
+	assert.NotContains(t, team.Base().GetGroups(), g2.ID())
+	assert.Equal(t, entry.GroupStateMatch, g2.Base().GetStateWithLock())
+	assert.Equal(t, g2, <-impl.groupChannel)
+}
+
+// createReadyCheckRoom creates a room with two groups which is waiting for the players to accept the match.
+func createReadyCheckRoom(impl *Impl, timeoutMs int64, t *testing.T) (entry.Room, entry.Group, entry.Group) {
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	impl.Configer.Get().DelayTimerConfig.MatchTimeoutMs = 60000
+	impl.Configer.Get().ReadyCheck = map[constant.GameMode]*config.ReadyCheckConfig{
+		GameMode: {TimeoutMs: timeoutMs, PenaltySec: 60},
+	}
+
+	_, g1, r := createTempRoom(UID, impl, t)
+	_, g2 := createTempGroup(UID+"1", impl, t)
+	r.Base().AddTeam(createTempTeam(impl, g2, t))
+	g1.Base().SetStartMatchTimeSec(100)
+	g2.Base().SetStartMatchTimeSec(200)
+	g2.Base().SetState(entry.GroupStateMatch)
+
+	impl.HandleMatchResult(common.Result{Room: r})
+	assert.True(t, r.Base().InReadyCheck())
+	assert.Equal(t, entry.GroupStateReadyCheck, g1.Base().GetStateWithLock())
+	assert.Equal(t, entry.GroupStateReadyCheck, g2.Base().GetStateWithLock())
+	assert.NotNil(t, impl.delayTimer.Get(TimerOpTypeRoomReadyCheck, r.ID()))
+	return r, g1, g2
+}
+
+func TestImpl_AcceptMatch(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+
+	t.Run("1. room not exists should return error", func(t *testing.T) {
+		_, _ = createTempGroup(UID, impl, t)
+		err := impl.AcceptMatch(ctx, UID, -1)
+		assert.Equal(t, merr.ErrRoomNotExists, err)
+		impl.playerMgr.Delete(UID)
+	})
+
+	r, g1, g2 := createReadyCheckRoom(impl, 60000, t)
+
+	t.Run("2. player not in room should return error", func(t *testing.T) {
+		_, _ = createTempGroup(UID+"2", impl, t)
+		err := impl.AcceptMatch(ctx, UID+"2", r.ID())
+		assert.Equal(t, merr.ErrPlayerNotInRoom, err)
+	})
+
+	t.Run("3. exit game in ready check should return error", func(t *testing.T) {
+		err := impl.ExitGame(ctx, UID, r.ID())
+		assert.Equal(t, merr.ErrGroupInReadyCheck, err)
+	})
+
+	t.Run("4. the game should not start until all players accept", func(t *testing.T) {
+		assert.Nil(t, impl.AcceptMatch(ctx, UID, r.ID()))
+		assert.True(t, r.Base().InReadyCheck())
+		assert.Equal(t, entry.GroupStateReadyCheck, g1.Base().GetStateWithLock())
+	})
+
+	t.Run("5. all players accept should start the game", func(t *testing.T) {
+		assert.Nil(t, impl.AcceptMatch(ctx, UID+"1", r.ID()))
+		assert.False(t, r.Base().InReadyCheck())
+		assert.Nil(t, impl.delayTimer.Get(TimerOpTypeRoomReadyCheck, r.ID()))
+		assert.Equal(t, entry.GroupStateGame, g1.Base().GetStateWithLock())
+		assert.Equal(t, entry.GroupStateGame, g2.Base().GetStateWithLock())
+		assert.Equal(t, entry.PlayerOnlineStateInGame, impl.playerMgr.Get(UID+"1").Base().GetOnlineStateWithLock())
+		assert.Equal(t, "127.0.0.1", r.Base().GameServerInfo.Host)
+	})
+
+	t.Run("6. accept a started room should return error", func(t *testing.T) {
+		err := impl.AcceptMatch(ctx, UID, r.ID())
+		assert.Equal(t, merr.ErrRoomNotInReadyCheck, err)
+	})
+}
+
+func TestImpl_DeclineMatch(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	r, g1, g2 := createReadyCheckRoom(impl, 60000, t)
+	p2 := impl.playerMgr.Get(UID + "1")
+
+	err := impl.DeclineMatch(ctx, UID+"1", r.ID())
+	assert.Nil(t, err)
+	assert.Nil(t, impl.roomMgr.Get(r.ID()))
+	for _, teamID := range r.Base().GetTeams() {
+		assert.Nil(t, impl.teamMgr.Get(teamID))
+	}
+	assert.Nil(t, impl.delayTimer.Get(TimerOpTypeRoomReadyCheck, r.ID()))
+
+	// the decliner is penalized and its group cancels match
+	assert.Equal(t, impl.nowFunc()+60, p2.Base().PenaltyUntilSec)
+	assert.Equal(t, entry.GroupStateInvite, g2.Base().GetStateWithLock())
+
+	// the other group goes back to match with its start match time kept
+	assert.Equal(t, g1, <-impl.groupChannel)
+	assert.Equal(t, entry.GroupStateMatch, g1.Base().GetStateWithLock())
+	assert.Equal(t, int64(100), g1.GetStartMatchTimeSec())
+	assert.Equal(t, int64(0), impl.playerMgr.Get(UID).Base().PenaltyUntilSec)
+
+	err = impl.DeclineMatch(ctx, UID, r.ID())
+	assert.Equal(t, merr.ErrRoomNotExists, err)
+}
+
+func TestImpl_Spectate(t *testing.T) {
+	relation := &relationStub{friends: map[string]string{
+		"spectator1": UID, "spectator2": UID, "spectator3": UID, "spectator4": UID + "1",
+	}}
+	impl := defaultImpl(PlayerLimit, WithRelation(relation))
+	_, _, r := createTempRoom(UID, impl, t)
+
+	t.Run("1. room not exists should return error", func(t *testing.T) {
+		err := impl.Spectate(ctx, "spectator", -1)
+		assert.Equal(t, merr.ErrRoomNotExists, err)
+	})
+
+	t.Run("2. spectate disabled should return error", func(t *testing.T) {
+		err := impl.Spectate(ctx, "spectator", r.ID())
+		assert.Equal(t, merr.ErrSpectateDisabled, err)
+	})
+
+	impl.Configer.Get().SpectatorLimit = map[constant.GameMode]int{GameMode: 2}
+
+	t.Run("3. player in group should return error", func(t *testing.T) {
+		err := impl.Spectate(ctx, UID, r.ID())
+		assert.Equal(t, merr.ErrPlayerInGroup, err)
+	})
+
+	t.Run("4. unknown player or not friend should return error", func(t *testing.T) {
+		assert.Equal(t, merr.ErrPlayerNotExists, impl.Spectate(ctx, "", r.ID()))
+		assert.Equal(t, merr.ErrNotRoomFriend, impl.Spectate(ctx, "stranger", r.ID()))
+		assert.Equal(t, 0, len(r.Base().GetSpectators()))
+	})
+
+	t.Run("5. spectate should be success until the limit", func(t *testing.T) {
+		assert.Nil(t, impl.Spectate(ctx, "spectator1", r.ID()))
+		assert.Nil(t, impl.Spectate(ctx, "spectator1", r.ID()))
+		assert.Nil(t, impl.Spectate(ctx, "spectator2", r.ID()))
+		assert.Equal(t, merr.ErrSpectatorFull, impl.Spectate(ctx, "spectator3", r.ID()))
+		assert.Equal(t, []string{"spectator1", "spectator2"}, r.Base().GetSpectators())
+		assert.Equal(t, []pto.MatchSpectatorInfo{{UID: "spectator1"}, {UID: "spectator2"}},
+			r.GetMatchInfo().Spectators)
+	})
+
+	_, _, r2 := createTempRoom(UID+"1", impl, t)
+
+	t.Run("6. spectator can not spectate another room or start match", func(t *testing.T) {
+		assert.Equal(t, merr.ErrPlayerSpectating, impl.Spectate(ctx, "spectator1", r2.ID()))
+		assert.Equal(t, 0, len(r2.Base().GetSpectators()))
+
+		impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+		createTempGroup("spectator1", impl, t)
+		assert.Equal(t, merr.ErrPlayerSpectating, impl.StartMatch(ctx, "spectator1"))
+		assert.Nil(t, impl.ExitGroup(ctx, "spectator1"))
+	})
+
+	t.Run("7. exit spectate should release the slot and the spectator", func(t *testing.T) {
+		assert.Nil(t, impl.ExitSpectate(ctx, "spectator1", r.ID()))
+		assert.Equal(t, merr.ErrNotSpectator, impl.ExitSpectate(ctx, "spectator1", r.ID()))
+		assert.Nil(t, impl.Spectate(ctx, "spectator3", r.ID()))
+		assert.Equal(t, []string{"spectator2", "spectator3"}, r.Base().GetSpectators())
+
+		assert.Equal(t, merr.ErrNotRoomFriend, impl.Spectate(ctx, "spectator1", r2.ID()))
+		assert.Nil(t, impl.Spectate(ctx, "spectator4", r2.ID()))
+		createTempGroup("spectator1", impl, t)
+		assert.Nil(t, impl.StartMatch(ctx, "spectator1"))
+	})
+
+	t.Run("8. game result should release all the spectators", func(t *testing.T) {
+		assert.Nil(t, impl.HandleGameResult(&pto.GameResult{RoomID: r.ID(), GameMode: GameMode}))
+		assert.Equal(t, 0, len(r.Base().GetSpectators()))
+		assert.False(t, impl.spectators.spectating("spectator2"))
+		assert.False(t, impl.spectators.spectating("spectator3"))
+		assert.True(t, impl.spectators.spectating("spectator4"))
+	})
+}
+
+func TestImpl_CustomRoom(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, g1 := createTempGroup(UID, impl, t)
+	_, g2 := createTempGroup(UID+"1", impl, t)
+	_, g3 := createTempGroup(UID+"2", impl, t)
+
+	var r entry.Room
+	t.Run("1. create custom room should place the group in the first slot", func(t *testing.T) {
+		_, err := impl.CreateCustomRoom(ctx, UID, &pto.CreateCustomRoom{})
+		assert.Equal(t, merr.ErrInvalidTeamSlot, err)
+
+		r, err = impl.CreateCustomRoom(ctx, UID, &pto.CreateCustomRoom{
+			TeamLimit: 2, TeamPlayerLimit: 1, Password: "pwd",
+		})
+		assert.Nil(t, err)
+		assert.True(t, r.Base().IsCustom())
+		assert.Equal(t, 6, len(r.Base().Custom.Code))
+		assert.Equal(t, r, impl.roomMgr.Get(r.ID()))
+		assert.Equal(t, 1, impl.getTeamOfGroup(r, g1.ID()).Base().TeamID)
+		assert.Equal(t, entry.GroupStateCustomRoom, g1.Base().GetStateWithLock())
+		assert.Nil(t, impl.delayTimer.Get(TimerOpTypeGroupInvite, g1.ID()))
+		assert.NotNil(t, impl.delayTimer.Get(TimerOpTypeCustomRoom, r.ID()))
+	})
+
+	t.Run("2. the group in custom room can not start match", func(t *testing.T) {
+		assert.Equal(t, merr.ErrGroupInCustomRoom, impl.StartMatch(ctx, UID))
+	})
+
+	t.Run("3. start with empty slot should return error", func(t *testing.T) {
+		assert.Equal(t, merr.ErrCustomRoomNotReady, impl.StartCustomRoom(ctx, UID, r.ID()))
+	})
+
+	t.Run("4. join custom room by code", func(t *testing.T) {
+		code := r.Base().Custom.Code
+		assert.Equal(t, merr.ErrCustomRoomNotExists, impl.JoinCustomRoom(ctx, UID+"1", "wrong", "pwd"))
+		assert.Equal(t, merr.ErrWrongPassword, impl.JoinCustomRoom(ctx, UID+"1", code, "wrong"))
+		assert.Nil(t, impl.JoinCustomRoom(ctx, UID+"1", code, "pwd"))
+		assert.Equal(t, 2, impl.getTeamOfGroup(r, g2.ID()).Base().TeamID)
+		assert.Equal(t, merr.ErrCustomRoomFull, impl.JoinCustomRoom(ctx, UID+"2", code, "pwd"))
+	})
+
+	t.Run("5. move players between slots", func(t *testing.T) {
+		assert.Equal(t, merr.ErrNotCustomRoomOwner, impl.MoveCustomRoomPlayer(ctx, UID+"1", UID, r.ID(), 2))
+		assert.Equal(t, merr.ErrInvalidTeamSlot, impl.MoveCustomRoomPlayer(ctx, UID, UID+"1", r.ID(), 3))
+		assert.Equal(t, merr.ErrTeamSlotFull, impl.MoveCustomRoomPlayer(ctx, UID, UID+"1", r.ID(), 1))
+
+		r.Base().Custom.TeamPlayerLimit = 2
+		assert.Nil(t, impl.MoveCustomRoomPlayer(ctx, UID, UID+"1", r.ID(), 1))
+		assert.Equal(t, impl.getTeamOfGroup(r, g1.ID()), impl.getTeamOfGroup(r, g2.ID()))
+		assert.Equal(t, 1, len(r.Base().GetTeams()))
+
+		assert.Nil(t, impl.MoveCustomRoomPlayer(ctx, UID+"1", UID+"1", r.ID(), 2))
+		assert.Equal(t, 2, impl.getTeamOfGroup(r, g2.ID()).Base().TeamID)
+	})
+
+	t.Run("6. leave custom room should send the group back to invite", func(t *testing.T) {
+		assert.Nil(t, impl.JoinCustomRoom(ctx, UID+"2", r.Base().Custom.Code, "pwd"))
+		assert.Nil(t, impl.LeaveCustomRoom(ctx, UID+"2", r.ID()))
+		assert.Nil(t, impl.getTeamOfGroup(r, g3.ID()))
+		assert.Equal(t, entry.GroupStateInvite, g3.Base().GetStateWithLock())
+	})
+
+	t.Run("7. start custom room should dispatch the game server", func(t *testing.T) {
+		assert.Equal(t, merr.ErrNotCustomRoomOwner, impl.StartCustomRoom(ctx, UID+"1", r.ID()))
+		assert.Nil(t, impl.StartCustomRoom(ctx, UID, r.ID()))
+		assert.True(t, r.Base().Custom.Started)
+		assert.Equal(t, "127.0.0.1", r.Base().GameServerInfo.Host)
+		assert.Equal(t, entry.GroupStateGame, g1.Base().GetStateWithLock())
+		assert.Equal(t, entry.GroupStateGame, g2.Base().GetStateWithLock())
+		assert.Nil(t, impl.getCustomRoomByCode(r.Base().Custom.Code))
+		assert.Nil(t, impl.delayTimer.Get(TimerOpTypeCustomRoom, r.ID()))
+		assert.Equal(t, merr.ErrCustomRoomStarted, impl.StartCustomRoom(ctx, UID, r.ID()))
+	})
+}
+
+func TestImpl_CustomRoom_timeout(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, g1 := createTempGroup(UID, impl, t)
+	_, g2 := createTempGroup(UID+"1", impl, t)
+
+	r, err := impl.CreateCustomRoom(ctx, UID, &pto.CreateCustomRoom{TeamLimit: 2, TeamPlayerLimit: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, impl.JoinCustomRoom(ctx, UID+"1", r.Base().Custom.Code, ""))
+
+	// the room not started in time is dissolved, the groups go back to invite
+	impl.customRoomTimeoutHandler(r.ID())
+	assert.Nil(t, impl.roomMgr.Get(r.ID()))
+	assert.Nil(t, impl.getCustomRoomByCode(r.Base().Custom.Code))
+	assert.Nil(t, impl.delayTimer.Get(TimerOpTypeCustomRoom, r.ID()))
+	assert.Equal(t, entry.GroupStateInvite, g1.Base().GetStateWithLock())
+	assert.Equal(t, entry.GroupStateInvite, g2.Base().GetStateWithLock())
+	assert.NotNil(t, impl.delayTimer.Get(TimerOpTypeGroupInvite, g1.ID()))
+
+	// the dissolved room is ignored
+	impl.customRoomTimeoutHandler(r.ID())
+}
+
+func TestImpl_CustomRoom_exitGroup(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, g1 := createTempGroup(UID, impl, t)
+	assert.Nil(t, impl.EnterGroup(ctx, newEnterGroupParam(UID+"3"), g1.ID()))
+	_, g2 := createTempGroup(UID+"1", impl, t)
+	assert.Nil(t, impl.EnterGroup(ctx, newEnterGroupParam(UID+"2"), g2.ID()))
+
+	r, err := impl.CreateCustomRoom(ctx, UID, &pto.CreateCustomRoom{TeamLimit: 2, TeamPlayerLimit: 2})
+	assert.Nil(t, err)
+	assert.Nil(t, impl.JoinCustomRoom(ctx, UID+"1", r.Base().Custom.Code, ""))
+
+	// 1. the member exits the group, the group stays in its team slot
+	assert.Nil(t, impl.ExitGroup(ctx, UID+"2"))
+	assert.Nil(t, impl.playerMgr.Get(UID+"2"))
+	assert.Equal(t, []string{UID + "1"}, g2.Base().GetPlayers())
+	assert.Equal(t, 2, impl.getTeamOfGroup(r, g2.ID()).Base().TeamID)
+	assert.Equal(t, entry.GroupStateCustomRoom, g2.Base().GetStateWithLock())
+
+	// 2. the last player exits the group, the group is dissolved and its team slot is freed
+	assert.Nil(t, impl.ExitGroup(ctx, UID+"1"))
+	assert.Nil(t, impl.groupMgr.Get(g2.ID()))
+	assert.Nil(t, impl.getTeamOfGroup(r, g2.ID()))
+	assert.Equal(t, 1, len(r.Base().GetTeams()))
+
+	// 3. the owner exits the group, the room is dissolved and the rest of the group goes back to invite
+	assert.Nil(t, impl.ExitGroup(ctx, UID))
+	assert.Nil(t, impl.roomMgr.Get(r.ID()))
+	assert.Nil(t, impl.getCustomRoomByCode(r.Base().Custom.Code))
+	assert.Equal(t, []string{UID + "3"}, g1.Base().GetPlayers())
+	assert.Equal(t, entry.GroupStateInvite, g1.Base().GetStateWithLock())
+}
+
+func TestImpl_genCustomRoomCode(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	code, err := impl.genCustomRoomCode(1)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), impl.customRoomCodes[code])
+
+	// no code is left
+	for i := 0; i < customRoomCodeMax; i++ {
+		impl.customRoomCodes[fmt.Sprintf("%06d", i)] = 1
+	}
+	_, err = impl.genCustomRoomCode(2)
+	assert.Equal(t, merr.ErrCustomRoomCodeExhausted, err)
+}
+
+func TestImpl_LeaveCustomRoom_owner(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	_, g1 := createTempGroup(UID, impl, t)
+	_, g2 := createTempGroup(UID+"1", impl, t)
+
+	r, err := impl.CreateCustomRoom(ctx, UID, &pto.CreateCustomRoom{TeamLimit: 2, TeamPlayerLimit: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, impl.JoinCustomRoom(ctx, UID+"1", r.Base().Custom.Code, ""))
+
+	// the owner leaves would dissolve the room
+	assert.Nil(t, impl.LeaveCustomRoom(ctx, UID, r.ID()))
+	assert.Nil(t, impl.roomMgr.Get(r.ID()))
+	for _, teamID := range r.Base().GetTeams() {
+		assert.Nil(t, impl.teamMgr.Get(teamID))
+	}
+	assert.Equal(t, entry.GroupStateInvite, g1.Base().GetStateWithLock())
+	assert.Equal(t, entry.GroupStateInvite, g2.Base().GetStateWithLock())
+	assert.Nil(t, impl.getCustomRoomByCode(r.Base().Custom.Code))
+	assert.Equal(t, merr.ErrCustomRoomNotExists, impl.LeaveCustomRoom(ctx, UID+"1", r.ID()))
+}
+
+func TestImpl_ForceCancelMatch(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	assert.Equal(t, merr.ErrGroupNotExists, impl.ForceCancelMatch(ctx, -1))
+
+	p, g := createTempGroup(UID, impl, t)
+	assert.Equal(t, merr.ErrGroupInInvite, impl.ForceCancelMatch(ctx, g.ID()))
+
+	assert.Nil(t, impl.StartMatch(ctx, p.UID()))
+	assert.Nil(t, impl.ForceCancelMatch(ctx, g.ID()))
+	assert.Equal(t, entry.GroupStateInvite, g.Base().GetStateWithLock())
+	assert.Equal(t, entry.PlayerOnlineStateInGroup, p.Base().GetOnlineStateWithLock())
+	assert.Nil(t, impl.delayTimer.Get(TimerOpTypeGroupMatch, g.ID()))
+	assert.NotNil(t, impl.delayTimer.Get(TimerOpTypeGroupInvite, g.ID()))
+}
+
+func TestImpl_ForceDissolveGroup(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	assert.Equal(t, merr.ErrGroupNotExists, impl.ForceDissolveGroup(ctx, -1))
+
+	// matching groups can be dissolved
+	p, g := createTempGroup(UID, impl, t)
+	assert.Nil(t, impl.StartMatch(ctx, p.UID()))
+	assert.Nil(t, impl.ForceDissolveGroup(ctx, g.ID()))
+	assert.Equal(t, entry.GroupStateDissolved, g.Base().GetStateWithLock())
+	assert.Nil(t, impl.groupMgr.Get(g.ID()))
+	assert.Nil(t, impl.playerMgr.Get(p.UID()))
+	assert.Nil(t, impl.delayTimer.Get(TimerOpTypeGroupMatch, g.ID()))
+
+	// the groups in rooms can not
+	_, g, r := createTempRoom(UID, impl, t)
+	impl.HandleMatchResult(common.Result{Room: r})
+	assert.Equal(t, merr.ErrGroupInGame, impl.ForceDissolveGroup(ctx, g.ID()))
+}
+
+func TestImpl_ClearRoom(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	assert.Equal(t, merr.ErrRoomNotExists, impl.ClearRoom(ctx, -1))
+
+	t.Run("1. the groups of a gaming room go back to invite", func(t *testing.T) {
+		p, g, r := createTempRoom(UID, impl, t)
+		impl.HandleMatchResult(common.Result{Room: r})
+		assert.Equal(t, entry.GroupStateGame, g.Base().GetStateWithLock())
+		assert.NotNil(t, impl.delayTimer.Get(TimeOpTypeClearRoom, r.ID()))
+
+		assert.Nil(t, impl.ClearRoom(ctx, r.ID()))
+		assert.Nil(t, impl.roomMgr.Get(r.ID()))
+		assert.Nil(t, impl.teamMgr.Get(r.Base().GetTeams()[0]))
+		assert.Nil(t, impl.delayTimer.Get(TimeOpTypeClearRoom, r.ID()))
+		assert.Equal(t, entry.GroupStateInvite, g.Base().GetStateWithLock())
+		assert.Equal(t, entry.PlayerOnlineStateInGroup, p.Base().GetOnlineStateWithLock())
+		assert.NotNil(t, impl.delayTimer.Get(TimerOpTypeGroupInvite, g.ID()))
+		assert.Nil(t, impl.ForceDissolveGroup(ctx, g.ID()))
+	})
+
+	t.Run("2. the room in ready check can not be cleared", func(t *testing.T) {
+		r, _, _ := createReadyCheckRoom(impl, 60000, t)
+		assert.Equal(t, merr.ErrRoomInReadyCheck, impl.ClearRoom(ctx, r.ID()))
+	})
+
+	t.Run("3. the custom room not started is dissolved", func(t *testing.T) {
+		_, g := createTempGroup(UID+"2", impl, t)
+		r, err := impl.CreateCustomRoom(ctx, UID+"2", &pto.CreateCustomRoom{TeamLimit: 2, TeamPlayerLimit: 1})
+		assert.Nil(t, err)
+		assert.Nil(t, impl.ClearRoom(ctx, r.ID()))
+		assert.Nil(t, impl.roomMgr.Get(r.ID()))
+		assert.Nil(t, impl.getCustomRoomByCode(r.Base().Custom.Code))
+		assert.Nil(t, impl.delayTimer.Get(TimerOpTypeCustomRoom, r.ID()))
+		assert.Equal(t, entry.GroupStateInvite, g.Base().GetStateWithLock())
+	})
+
+	t.Run("4. the code and timer of the custom room are removed on clearing", func(t *testing.T) {
+		_, g := createTempGroup(UID+"3", impl, t)
+		r, err := impl.CreateCustomRoom(ctx, UID+"3", &pto.CreateCustomRoom{TeamLimit: 2, TeamPlayerLimit: 1})
+		assert.Nil(t, err)
+		r.Base().Lock()
+		impl.clearRoom(ctx, r)
+		r.Base().Unlock()
+		assert.Nil(t, impl.roomMgr.Get(r.ID()))
+		assert.NotContains(t, impl.customRoomCodes, r.Base().Custom.Code)
+		assert.Nil(t, impl.delayTimer.Get(TimerOpTypeCustomRoom, r.ID()))
+		assert.Equal(t, entry.GroupStateInvite, g.Base().GetStateWithLock())
+	})
+}
+
+func TestImpl_Drain(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	impl.Configer.Get().DelayTimerConfig.MatchTimeoutMs = 60000
+	assert.Equal(t, merr.ErrInvalidDrainTimeout, impl.Drain(ctx, "", -time.Second))
+	assert.Equal(t, merr.ErrQueueNotDraining, impl.Undrain(ctx, ""))
+
+	p1, g1 := createTempGroup(UID, impl, t)
+	p2, g2 := createTempGroup(UID+"2", impl, t)
+	key := queueKeyOf(g1)
+	assert.NotEmpty(t, key)
+	assert.Nil(t, impl.StartMatch(ctx, p1.UID()))
+
+	t.Run("1. the queue rejects new groups and cancels the rest at the deadline", func(t *testing.T) {
+		assert.Nil(t, impl.Drain(ctx, key, 50*time.Millisecond))
+		assert.Contains(t, impl.Drains(ctx), key)
+		assert.Equal(t, merr.ErrMatchDraining, impl.StartMatch(ctx, p2.UID()))
+		assert.Equal(t, entry.GroupStateMatch, g1.Base().GetStateWithLock())
+
+		assert.Eventually(t, func() bool {
+			return g1.Base().GetStateWithLock() == entry.GroupStateInvite
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, entry.PlayerOnlineStateInGroup, p1.Base().GetOnlineStateWithLock())
+		assert.Equal(t, merr.ErrMatchDraining, impl.StartMatch(ctx, p1.UID()))
+	})
+
+	t.Run("2. undrain accepts the groups again", func(t *testing.T) {
+		assert.Nil(t, impl.Undrain(ctx, key))
+		assert.Empty(t, impl.Drains(ctx))
+		assert.Nil(t, impl.StartMatch(ctx, p2.UID()))
+		assert.Equal(t, entry.GroupStateMatch, g2.Base().GetStateWithLock())
+	})
+
+	t.Run("3. undrain stops the pending cancellation", func(t *testing.T) {
+		assert.Nil(t, impl.Drain(ctx, drainAll, 50*time.Millisecond))
+		assert.Equal(t, merr.ErrMatchDraining, impl.StartMatch(ctx, p1.UID()))
+		assert.Nil(t, impl.Undrain(ctx, drainAll))
+		time.Sleep(100 * time.Millisecond)
+		assert.Equal(t, entry.GroupStateMatch, g2.Base().GetStateWithLock())
+	})
+
+	t.Run("4. drain all the queues", func(t *testing.T) {
+		assert.Nil(t, impl.Drain(ctx, drainAll, 0))
+		assert.Eventually(t, func() bool {
+			return g2.Base().GetStateWithLock() == entry.GroupStateInvite
+		}, time.Second, 10*time.Millisecond)
+		assert.Nil(t, impl.Undrain(ctx, drainAll))
+	})
+}
+
+func TestImpl_PauseMatchTimeout(t *testing.T) {
+	impl := defaultImpl(PlayerLimit)
+	impl.Configer.Get().DelayTimerConfig.InviteTimeoutMs = 60000
+	impl.Configer.Get().DelayTimerConfig.MatchTimeoutMs = 50
+	var paused atomic.Bool
+	impl.queuePaused = func(string) bool { return paused.Load() }
+
+	p, g := createTempGroup(UID, impl, t)
+	key := queueKeyOf(g)
+	assert.Nil(t, impl.StartMatch(ctx, p.UID()))
+
+	t.Run("1. the groups keep matching past the timeout while the queue is paused", func(t *testing.T) {
+		paused.Store(true)
+		assert.Eventually(t, func() bool {
+			return impl.delayTimer.Get(TimerOpTypeGroupMatch, g.ID()) == nil
+		}, time.Second, 10*time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, entry.GroupStateMatch, g.Base().GetStateWithLock())
+
+		// still paused, or resuming another queue, the timeout does not restart
+		impl.ResumeMatchTimeouts(ctx, key)
+		impl.ResumeMatchTimeouts(ctx, key+"-other")
+		assert.Nil(t, impl.delayTimer.Get(TimerOpTypeGroupMatch, g.ID()))
+	})
+
+	t.Run("2. the timeout restarts when the queue is resumed", func(t *testing.T) {
+		paused.Store(false)
+		impl.ResumeMatchTimeouts(ctx, key)
+		assert.Eventually(t, func() bool {
+			return g.Base().GetStateWithLock() == entry.GroupStateInvite
+		}, time.Second, 10*time.Millisecond)
 	})
 }
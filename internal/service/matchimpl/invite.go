@@ -2,11 +2,92 @@ package matchimpl
 
 import (
 	"context"
+	"sort"
+	"sync"
 
 	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/merr"
 	"github.com/hedon954/go-matcher/internal/pto"
 )
 
+// inviteKey locates an invitation kept in a group.
+type inviteKey struct {
+	GroupID    int64
+	InviteeUID string
+}
+
+// inviteIndex indexes the pending invitations kept in the groups by id and by invitee,
+// so that they can be listed to the invitees and expired by the delay timer.
+// The entries of the dissolved groups are dropped lazily when visited.
+type inviteIndex struct {
+	sync.Mutex
+	idIter    int64
+	keys      map[int64]inviteKey
+	byInvitee map[string]map[int64]struct{}
+}
+
+func newInviteIndex() *inviteIndex {
+	return &inviteIndex{
+		keys:      make(map[int64]inviteKey),
+		byInvitee: make(map[string]map[int64]struct{}),
+	}
+}
+
+func (idx *inviteIndex) newID() int64 {
+	idx.Lock()
+	defer idx.Unlock()
+	idx.idIter++
+	return idx.idIter
+}
+
+// skip makes the new ids greater than id.
+func (idx *inviteIndex) skip(id int64) {
+	idx.Lock()
+	defer idx.Unlock()
+	idx.idIter = max(idx.idIter, id)
+}
+
+func (idx *inviteIndex) add(id int64, key inviteKey) {
+	idx.Lock()
+	defer idx.Unlock()
+	idx.keys[id] = key
+	if idx.byInvitee[key.InviteeUID] == nil {
+		idx.byInvitee[key.InviteeUID] = make(map[int64]struct{})
+	}
+	idx.byInvitee[key.InviteeUID][id] = struct{}{}
+}
+
+func (idx *inviteIndex) remove(id int64) {
+	idx.Lock()
+	defer idx.Unlock()
+	key, ok := idx.keys[id]
+	if !ok {
+		return
+	}
+	delete(idx.keys, id)
+	delete(idx.byInvitee[key.InviteeUID], id)
+	if len(idx.byInvitee[key.InviteeUID]) == 0 {
+		delete(idx.byInvitee, key.InviteeUID)
+	}
+}
+
+func (idx *inviteIndex) get(id int64) (inviteKey, bool) {
+	idx.Lock()
+	defer idx.Unlock()
+	key, ok := idx.keys[id]
+	return key, ok
+}
+
+func (idx *inviteIndex) listByInvitee(inviteeUID string) map[int64]inviteKey {
+	idx.Lock()
+	defer idx.Unlock()
+	res := make(map[int64]inviteKey, len(idx.byInvitee[inviteeUID]))
+	for id := range idx.byInvitee[inviteeUID] {
+		res[id] = idx.keys[id]
+	}
+	return res
+}
+
 func (impl *Impl) checkInviteeState(inviteeUID string) error {
 	invitee := impl.playerMgr.Get(inviteeUID)
 	if invitee == nil {
@@ -23,8 +104,49 @@ func (impl *Impl) checkInviteeState(inviteeUID string) error {
 	return nil
 }
 
+// getInviteeInfo returns a copy of the last known player info of the invitee, or nil if the invitee is unknown.
+// It should be called before locking the group, the player lock is always taken before the group lock.
+func (impl *Impl) getInviteeInfo(inviteeUID string) *pto.PlayerInfo {
+	invitee := impl.playerMgr.Get(inviteeUID)
+	if invitee == nil {
+		return nil
+	}
+	invitee.Base().Lock()
+	info := *invitee.GetPlayerInfo()
+	invitee.Base().Unlock()
+	return &info
+}
+
+// checkInviteePlayTogether checks whether the invitee can play with the group
+// by the last known game mode and mode version of the invitee, unknown invitees are allowed.
+func (impl *Impl) checkInviteePlayTogether(inviteeInfo *pto.PlayerInfo, g entry.Group) error {
+	if inviteeInfo == nil {
+		return nil
+	}
+	return g.CanPlayTogether(inviteeInfo)
+}
+
+// checkInviteRate checks whether the inviter has sent too many invitations recently.
+func (impl *Impl) checkInviteRate(inviterUID string) error {
+	ic := impl.Configer.Get().Invite
+	if ic == nil || ic.RateLimit <= 0 {
+		return nil
+	}
+	if !impl.inviteLimiter.allow(inviterUID, impl.nowFunc(), ic.RateLimit, ic.RateLimitWindowSec) {
+		return merr.ErrInviteTooFrequent
+	}
+	return nil
+}
+
 func (impl *Impl) invite(ctx context.Context, inviter entry.Player, inviteeUID string, g entry.Group) {
-	g.Base().AddInviteRecord(inviteeUID, impl.nowFunc())
+	id := impl.invites.newID()
+	if old := g.Base().AddInviteRecord(id, inviter.UID(), inviteeUID, impl.nowFunc()); old != nil {
+		impl.invites.remove(old.ID)
+		impl.removeInviteExpireTimer(old.ID)
+	}
+	impl.invites.add(id, inviteKey{GroupID: g.ID(), InviteeUID: inviteeUID})
+	impl.addInviteExpireTimer(id, g.Base().GameMode, g.Base().Configs.InviteExpireSec)
+
 	impl.pushService.PushInviteMsg(ctx, &pto.InviteMsg{
 		InviterUID:  inviter.UID(),
 		InviterName: inviter.Base().Nickname,
@@ -34,3 +156,136 @@ func (impl *Impl) invite(ctx context.Context, inviter entry.Player, inviteeUID s
 		ModeVersion: g.Base().ModeVersion,
 	})
 }
+
+// delInviteRecord deletes the invitation to the invitee from the group and stops tracking its expiry.
+func (impl *Impl) delInviteRecord(g entry.Group, inviteeUID string) *entry.InviteRecord {
+	r := g.Base().GetInviteRecord(inviteeUID)
+	if r == nil {
+		return nil
+	}
+	g.Base().DelInviteRecord(inviteeUID)
+	impl.invites.remove(r.ID)
+	impl.removeInviteExpireTimer(r.ID)
+	return r
+}
+
+func (impl *Impl) revokeInvite(ctx context.Context, inviterUID, inviteeUID string, g entry.Group) error {
+	r := g.Base().GetInviteRecord(inviteeUID)
+	if r == nil || r.InviterUID != inviterUID {
+		return merr.ErrInvitationNotExists
+	}
+	impl.delInviteRecord(g, inviteeUID)
+	impl.pushService.PushRevokeInvite(ctx, inviterUID, inviteeUID, g.ID())
+	return nil
+}
+
+// expireInvite deletes the expired invitation and notifies the inviter and the invitee.
+func (impl *Impl) expireInvite(ctx context.Context, id int64) {
+	key, ok := impl.invites.get(id)
+	if !ok {
+		return
+	}
+	impl.invites.remove(id)
+
+	g := impl.groupMgr.Get(key.GroupID)
+	if g == nil {
+		return
+	}
+	g.Base().Lock()
+	defer g.Base().Unlock()
+
+	r := g.Base().GetInviteRecord(key.InviteeUID)
+	if r == nil || r.ID != id {
+		return
+	}
+	g.Base().DelInviteRecord(key.InviteeUID)
+	impl.pushService.PushInviteExpired(ctx, r.InviterUID, r.InviteeUID, g.ID())
+}
+
+// restoreInvites indexes the pending invitations of the groups reloaded from the backup and tracks their expiry again.
+// The new ids start after the restored ones, and the invitations of the old backups without id are given new ones.
+func (impl *Impl) restoreInvites(groups []entry.Group) {
+	for _, g := range groups {
+		g.Base().Lock()
+		for _, r := range g.Base().GetInviteRecords() {
+			impl.invites.skip(r.ID)
+		}
+		g.Base().Unlock()
+	}
+
+	now := impl.nowFunc()
+	for _, g := range groups {
+		g.Base().Lock()
+		for uid, r := range g.Base().GetInviteRecords() {
+			if r.ExpireSec <= now {
+				g.Base().DelInviteRecord(uid)
+				continue
+			}
+			if r.ID == 0 {
+				r.ID = impl.invites.newID()
+			}
+			impl.invites.add(r.ID, inviteKey{GroupID: g.ID(), InviteeUID: uid})
+			impl.addInviteExpireTimer(r.ID, g.Base().GameMode, r.ExpireSec-now)
+		}
+		g.Base().Unlock()
+	}
+}
+
+func (impl *Impl) listReceivedInvites(inviteeUID string) []*pto.PendingInvite {
+	now := impl.nowFunc()
+	res := make([]*pto.PendingInvite, 0)
+	for id, key := range impl.invites.listByInvitee(inviteeUID) {
+		g := impl.groupMgr.Get(key.GroupID)
+		if g == nil {
+			impl.invites.remove(id)
+			continue
+		}
+		g.Base().Lock()
+		r := g.Base().GetInviteRecord(inviteeUID)
+		if r == nil || r.ID != id {
+			impl.invites.remove(id)
+		} else if r.ExpireSec > now && g.Base().GetState() == entry.GroupStateInvite {
+			res = append(res, newPendingInvite(g, r))
+		}
+		g.Base().Unlock()
+	}
+	sortPendingInvites(res)
+	return res
+}
+
+func (impl *Impl) listSentInvites(inviterUID string, g entry.Group) []*pto.PendingInvite {
+	now := impl.nowFunc()
+	res := make([]*pto.PendingInvite, 0)
+	for _, r := range g.Base().GetInviteRecords() {
+		if r.InviterUID == inviterUID && r.ExpireSec > now {
+			res = append(res, newPendingInvite(g, r))
+		}
+	}
+	sortPendingInvites(res)
+	return res
+}
+
+func newPendingInvite(g entry.Group, r *entry.InviteRecord) *pto.PendingInvite {
+	return &pto.PendingInvite{
+		GroupID:     g.ID(),
+		InviterUID:  r.InviterUID,
+		InviteeUID:  r.InviteeUID,
+		GameMode:    g.Base().GameMode,
+		ModeVersion: g.Base().ModeVersion,
+		CreateSec:   r.CreateSec,
+		ExpireSec:   r.ExpireSec,
+	}
+}
+
+// sortPendingInvites sorts the invitations by the latest first.
+func sortPendingInvites(invites []*pto.PendingInvite) {
+	sort.Slice(invites, func(i, j int) bool {
+		if invites[i].CreateSec != invites[j].CreateSec {
+			return invites[i].CreateSec > invites[j].CreateSec
+		}
+		if invites[i].GroupID != invites[j].GroupID {
+			return invites[i].GroupID < invites[j].GroupID
+		}
+		return invites[i].InviteeUID < invites[j].InviteeUID
+	})
+}
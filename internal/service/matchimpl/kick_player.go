@@ -7,10 +7,9 @@ import (
 )
 
 func (impl *Impl) kickPlayer(ctx context.Context, kicked entry.Player, g entry.Group) {
-	kicked.Base().SetOnlineState(entry.PlayerOnlineStateOnline)
-	impl.pushService.PushPlayerOnlineState(ctx, []string{kicked.UID()}, entry.PlayerOnlineStateOnline)
+	_ = impl.setPlayerState(ctx, kicked, entry.PlayerOnlineStateOnline)
 	impl.pushService.PushKick(ctx, kicked.UID(), g.ID())
-	impl.playerMgr.Delete(kicked.UID())
+	impl.deletePlayer(kicked.UID())
 
 	impl.removePlayerFromGroup(ctx, kicked, g)
 }
@@ -0,0 +1,44 @@
+package matchimpl
+
+import (
+	"sync"
+)
+
+// rateLimiter limits the actions of each key within a sliding window.
+type rateLimiter struct {
+	sync.Mutex
+	records    map[string][]int64
+	sweptAtSec int64
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{records: make(map[string][]int64)}
+}
+
+// allow records an action of the key at now
+// if the key has taken less than limit actions in the last windowSec.
+// The keys without actions in the window are swept at most once per windowSec.
+func (rl *rateLimiter) allow(key string, now int64, limit int, windowSec int64) bool {
+	rl.Lock()
+	defer rl.Unlock()
+
+	if now-rl.sweptAtSec >= windowSec {
+		for k, records := range rl.records {
+			if len(records) == 0 || records[len(records)-1] <= now-windowSec {
+				delete(rl.records, k)
+			}
+		}
+		rl.sweptAtSec = now
+	}
+
+	records := rl.records[key]
+	for len(records) > 0 && records[0] <= now-windowSec {
+		records = records[1:]
+	}
+	if len(records) >= limit {
+		rl.records[key] = records
+		return false
+	}
+	rl.records[key] = append(records, now)
+	return true
+}
@@ -0,0 +1,111 @@
+package matchimpl
+
+import (
+	"context"
+	"math"
+
+	"github.com/hedon954/go-matcher/internal/config"
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/pto"
+)
+
+// startReadyCheck asks the players of the matched room to accept the match before the deadline.
+func (impl *Impl) startReadyCheck(ctx context.Context, r entry.Room, rc *config.ReadyCheckConfig) {
+	base := r.Base()
+	base.ReadyCheckDeadlineSec = impl.nowFunc() + int64(math.Ceil(rc.Timeout().Seconds()))
+	base.AcceptedPlayers = make(map[string]struct{})
+
+	impl.rangeRoomGroups(r, func(g entry.Group) {
+		_ = impl.setGroupStateWithLock(ctx, g, entry.GroupStateReadyCheck)
+	})
+
+	impl.pushService.PushReadyCheck(ctx, impl.getRoomUIDs(r), &pto.ReadyCheck{
+		RoomID:      r.ID(),
+		DeadlineSec: base.ReadyCheckDeadlineSec,
+	})
+	impl.addReadyCheckTimer(r.ID(), base.GameMode, rc.Timeout())
+}
+
+// acceptMatch marks the player as accepted, and starts the game once all the players accept.
+func (impl *Impl) acceptMatch(ctx context.Context, uid string, r entry.Room) error {
+	base := r.Base()
+	base.AcceptedPlayers[uid] = struct{}{}
+
+	uids := impl.getRoomUIDs(r)
+	impl.pushService.PushAcceptMatch(ctx, uids, uid)
+	if len(base.AcceptedPlayers) < len(uids) {
+		return nil
+	}
+
+	impl.removeReadyCheckTimer(r.ID())
+	base.ReadyCheckDeadlineSec = 0
+	base.AcceptedPlayers = nil
+	return impl.startGame(ctx, r)
+}
+
+// cancelReadyCheck dissolves the room in ready check.
+// The groups of the decliners cancel match and the decliners are penalized,
+// the other groups go back to match with their start match time kept,
+// so they would be prioritized by the matcher.
+func (impl *Impl) cancelReadyCheck(ctx context.Context, r entry.Room, decliners []string) {
+	base := r.Base()
+	impl.removeReadyCheckTimer(r.ID())
+	base.ReadyCheckDeadlineSec = 0
+	base.AcceptedPlayers = nil
+	impl.roomMgr.Delete(r.ID())
+	impl.pushService.PushDeclineMatch(ctx, impl.getRoomUIDs(r), decliners)
+
+	penaltyUntil := impl.nowFunc()
+	if rc := impl.Configer.Get().GetReadyCheckConfig(base.GameMode); rc != nil {
+		penaltyUntil += rc.PenaltySec
+	}
+
+	impl.rangeRoomGroups(r, func(g entry.Group) {
+		g.Base().Lock()
+		defer g.Base().Unlock()
+
+		declineUID := ""
+		for _, uid := range decliners {
+			if g.Base().PlayerExists(uid) {
+				declineUID = uid
+				impl.playerMgr.Get(uid).Base().SetPenaltyUntilSecWithLock(penaltyUntil)
+			}
+		}
+
+		if declineUID != "" {
+			impl.cancelMatch(ctx, declineUID, pto.CancelMatchReasonPlayer, g)
+			return
+		}
+		if err := impl.setGroupState(ctx, g, entry.GroupStateMatch); err != nil {
+			return
+		}
+		impl.sendGroupToChannel(g)
+	})
+
+	for _, teamID := range base.GetTeams() {
+		impl.teamMgr.Delete(teamID)
+	}
+}
+
+// getUnacceptedUIDs returns the players of the room in ready check who have not accepted the match.
+func (impl *Impl) getUnacceptedUIDs(r entry.Room) []string {
+	res := make([]string, 0)
+	for _, uid := range impl.getRoomUIDs(r) {
+		if _, ok := r.Base().AcceptedPlayers[uid]; !ok {
+			res = append(res, uid)
+		}
+	}
+	return res
+}
+
+func (impl *Impl) rangeRoomGroups(r entry.Room, f func(g entry.Group)) {
+	for _, teamID := range r.Base().GetTeams() {
+		t := impl.teamMgr.Get(teamID)
+		t.Base().Lock()
+		groups := t.Base().GetGroups()
+		t.Base().Unlock()
+		for _, groupID := range groups {
+			f(impl.groupMgr.Get(groupID))
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package matchimpl
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hedon954/go-matcher/internal/config"
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/merr"
+)
+
+// The share token is in the format of `{groupID}.{expireAt}.{signature}`,
+// the signature is the base64 encoded HMAC-SHA256 of `{groupID}.{expireAt}` with the configured secret.
+const shareTokenParts = 3
+
+func (impl *Impl) getShareTokenConfig() (*config.ShareTokenConfig, error) {
+	stc := impl.Configer.Get().ShareToken
+	if stc == nil || stc.Secret == "" {
+		return nil, merr.ErrShareDisabled
+	}
+	return stc, nil
+}
+
+func (impl *Impl) shareGroup(g entry.Group) (token string, expireAt int64, err error) {
+	stc, err := impl.getShareTokenConfig()
+	if err != nil {
+		return "", 0, err
+	}
+	expireAt = impl.nowFunc() + stc.ExpireSec
+	payload := fmt.Sprintf("%d.%d", g.ID(), expireAt)
+	return payload + "." + signShareToken(stc.Secret, payload), expireAt, nil
+}
+
+// checkShareToken checks whether the token is signed for the group and not expired.
+func (impl *Impl) checkShareToken(g entry.Group, token string) error {
+	stc, err := impl.getShareTokenConfig()
+	if err != nil {
+		return err
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != shareTokenParts {
+		return merr.ErrInvalidShareToken
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(signShareToken(stc.Secret, payload))) {
+		return merr.ErrInvalidShareToken
+	}
+
+	groupID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || groupID != g.ID() {
+		return merr.ErrInvalidShareToken
+	}
+	expireAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return merr.ErrInvalidShareToken
+	}
+	if impl.nowFunc() >= expireAt {
+		return merr.ErrShareTokenExpired
+	}
+	return nil
+}
+
+func signShareToken(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,135 @@
+package matchimpl
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+	"github.com/hedon954/go-matcher/internal/merr"
+)
+
+// spectators records the rooms watched by the spectators, a player can spectate only one room at a time.
+type spectators struct {
+	sync.Mutex
+	rooms map[string]int64
+}
+
+func newSpectators() *spectators {
+	return &spectators{rooms: make(map[string]int64)}
+}
+
+// add records the player spectating the room, returns false if the player is spectating another room.
+func (s *spectators) add(uid string, roomID int64) bool {
+	s.Lock()
+	defer s.Unlock()
+	if id, ok := s.rooms[uid]; ok && id != roomID {
+		return false
+	}
+	s.rooms[uid] = roomID
+	return true
+}
+
+// remove removes the player spectating the room, it does nothing if the player is spectating another room.
+func (s *spectators) remove(uid string, roomID int64) {
+	s.Lock()
+	defer s.Unlock()
+	if s.rooms[uid] == roomID {
+		delete(s.rooms, uid)
+	}
+}
+
+// spectating returns whether the player is spectating any room.
+func (s *spectators) spectating(uid string) bool {
+	s.Lock()
+	defer s.Unlock()
+	_, ok := s.rooms[uid]
+	return ok
+}
+
+// checkSpectator checks whether the player is a friend of any real player in the room,
+// so the unknown players and the strangers can not spectate.
+func (impl *Impl) checkSpectator(ctx context.Context, uid string, r entry.Room) error {
+	if uid == "" {
+		return merr.ErrPlayerNotExists
+	}
+	for _, other := range impl.getRoomPlayers(r) {
+		ok, err := impl.relation.IsFriend(ctx, uid, other)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	return merr.ErrNotRoomFriend
+}
+
+// getRoomPlayers returns the real players in the room.
+func (impl *Impl) getRoomPlayers(r entry.Room) []string {
+	var uids []string
+	for _, teamID := range r.Base().GetTeams() {
+		t := impl.teamMgr.Get(teamID)
+		if t == nil {
+			continue
+		}
+		t.Base().Lock()
+		groups := t.Base().GetGroups()
+		t.Base().Unlock()
+		for _, groupID := range groups {
+			g := impl.groupMgr.Get(groupID)
+			if g == nil {
+				continue
+			}
+			g.Base().Lock()
+			for _, uid := range g.Base().GetPlayers() {
+				if p := impl.playerMgr.Get(uid); p != nil && !p.Base().IsAI {
+					uids = append(uids, uid)
+				}
+			}
+			g.Base().Unlock()
+		}
+	}
+	return uids
+}
+
+// spectate adds the spectator to the room and pushes the match info to it,
+// the spectator connects to the same game server as the players.
+func (impl *Impl) spectate(ctx context.Context, uid string, r entry.Room) error {
+	if !impl.spectators.add(uid, r.ID()) {
+		return merr.ErrPlayerSpectating
+	}
+	r.Base().AddSpectator(uid)
+	impl.pushService.PushMatchInfo(ctx, []string{uid}, impl.getMatchInfo(r))
+	return nil
+}
+
+// exitSpectate removes the spectator from the room.
+func (impl *Impl) exitSpectate(uid string, r entry.Room) error {
+	if !r.Base().RemoveSpectator(uid) {
+		return merr.ErrNotSpectator
+	}
+	impl.spectators.remove(uid, r.ID())
+	return nil
+}
+
+// releaseSpectators removes all the spectators from the room when it is cleared.
+func (impl *Impl) releaseSpectators(ctx context.Context, r entry.Room) {
+	uids := r.Base().ClearSpectators()
+	for _, uid := range uids {
+		impl.spectators.remove(uid, r.ID())
+	}
+	if len(uids) > 0 {
+		impl.pushService.PushSpectateEnd(ctx, uids, r.ID())
+	}
+}
+
+// checkGroupSpectating checks whether any player in the group is spectating,
+// the spectators should exit spectating before matching.
+func (impl *Impl) checkGroupSpectating(g entry.Group) error {
+	for _, uid := range g.Base().GetPlayers() {
+		if impl.spectators.spectating(uid) {
+			return merr.ErrPlayerSpectating
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/hedon954/go-matcher/internal/entry"
 	"github.com/hedon954/go-matcher/internal/log"
@@ -11,26 +12,24 @@ import (
 
 func (impl *Impl) startMatch(ctx context.Context, g entry.Group) {
 	base := g.Base()
-	uids := base.UIDs()
 
-	// update group state
-	base.SetState(entry.GroupStateMatch)
+	// update group state, the invite timer is replaced by the cancel match timer
+	if err := impl.setGroupState(ctx, g, entry.GroupStateMatch); err != nil {
+		return
+	}
 	base.MatchID = uuid.NewString()
-	impl.pushService.PushGroupState(ctx, uids, g.ID(), base.GetState())
+	base.SpanCtx = trace.SpanContextFromContext(ctx)
 
 	// update players state
 	for _, puid := range base.GetPlayers() {
 		p := impl.playerMgr.Get(puid)
 		p.Base().Lock()
-		p.Base().SetOnlineState(entry.PlayerOnlineStateInMatch)
+		_ = impl.setPlayerState(ctx, p, entry.PlayerOnlineStateInMatch)
 		p.Base().SetMatchStrategy(base.MatchStrategy)
 		p.Base().Unlock()
 	}
-	impl.pushService.PushPlayerOnlineState(ctx, uids, entry.PlayerOnlineStateInMatch)
 
-	impl.removeInviteTimer(g.ID())
 	impl.addWaitAttrTimer(g.ID(), g.Base().GameMode)
-	impl.addCancelMatchTimer(g.ID(), base.GameMode)
 }
 
 func (impl *Impl) sendGroupToChannel(g entry.Group) {
@@ -0,0 +1,72 @@
+package matchimpl
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/hedon954/go-matcher/internal/entry"
+)
+
+// initStateMachines registers the hooks of the player and group state machines,
+// the pushes and timers bound to a state are handled here rather than at each call site.
+// The AI players and groups are not connected and never wait in the queue, so they have no pushes and match timers.
+func (impl *Impl) initStateMachines() {
+	impl.mgrs.SM.Player.
+		OnTransit(func(ctx context.Context, p *entry.PlayerBase, from, to entry.PlayerOnlineState) {
+			log.Debug().Str("uid", p.UID()).Int8("from", int8(from)).Int8("to", int8(to)).Msg("player state changed")
+			if !p.IsAI {
+				impl.pushService.PushPlayerOnlineState(ctx, []string{p.UID()}, to)
+			}
+		})
+
+	impl.mgrs.SM.Group.
+		OnExit(entry.GroupStateInvite, func(_ context.Context, g *entry.GroupBase, _, _ entry.GroupState) {
+			impl.removeInviteTimer(g.ID())
+		}).
+		OnEnter(entry.GroupStateMatch, func(_ context.Context, g *entry.GroupBase, _, _ entry.GroupState) {
+			if !g.IsAI {
+				impl.addCancelMatchTimer(g.ID(), g.GameMode)
+			}
+		}).
+		OnExit(entry.GroupStateMatch, func(_ context.Context, g *entry.GroupBase, _, _ entry.GroupState) {
+			impl.removeCancelMatchTimer(g.ID())
+			impl.removeWaitAttrTimer(g.ID())
+		}).
+		OnTransit(func(ctx context.Context, g *entry.GroupBase, from, to entry.GroupState) {
+			log.Debug().Int64("group_id", g.ID()).Int8("from", int8(from)).Int8("to", int8(to)).Msg("group state changed")
+			if !g.IsAI {
+				impl.pushService.PushGroupState(ctx, g.UIDs(), g.ID(), to)
+			}
+		})
+}
+
+// setPlayerState transits the player to the state, the caller should hold the lock of the player.
+func (impl *Impl) setPlayerState(ctx context.Context, p entry.Player, s entry.PlayerOnlineState) error {
+	if err := impl.mgrs.SM.Player.Transit(ctx, p.Base(), s); err != nil {
+		log.Error().Str("uid", p.UID()).Err(err).Msg("failed to set player state")
+		return err
+	}
+	return nil
+}
+
+func (impl *Impl) setPlayerStateWithLock(ctx context.Context, p entry.Player, s entry.PlayerOnlineState) error {
+	p.Base().Lock()
+	defer p.Base().Unlock()
+	return impl.setPlayerState(ctx, p, s)
+}
+
+// setGroupState transits the group to the state, the caller should hold the lock of the group.
+func (impl *Impl) setGroupState(ctx context.Context, g entry.Group, s entry.GroupState) error {
+	if err := impl.mgrs.SM.Group.Transit(ctx, g.Base(), s); err != nil {
+		log.Error().Int64("group_id", g.ID()).Err(err).Msg("failed to set group state")
+		return err
+	}
+	return nil
+}
+
+func (impl *Impl) setGroupStateWithLock(ctx context.Context, g entry.Group, s entry.GroupState) error {
+	g.Base().Lock()
+	defer g.Base().Unlock()
+	return impl.setGroupState(ctx, g, s)
+}
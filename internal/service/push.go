@@ -24,12 +24,21 @@ type Push interface {
 	// PushRefuseInvite pushes the refuse invite message to the client.
 	PushRefuseInvite(ctx context.Context, inviter, invitee, refuseMsg string)
 
+	// PushInviteExpired pushes the invite expired message to the inviter and the invitee.
+	PushInviteExpired(ctx context.Context, inviter, invitee string, groupID int64)
+
+	// PushRevokeInvite pushes the invite revoked by the inviter to the invitee.
+	PushRevokeInvite(ctx context.Context, inviter, invitee string, groupID int64)
+
 	// PushGroupDissolve pushes the group dissolve message to the client.
 	PushGroupDissolve(ctx context.Context, uids []string, groupID int64)
 
 	// PushGroupState pushes the group state to the client.
 	PushGroupState(ctx context.Context, uids []string, groupID int64, state entry.GroupState)
 
+	// PushGroupMessage pushes the message sent by a player to the group players.
+	PushGroupMessage(ctx context.Context, uids []string, msg *pto.GroupMessage)
+
 	// PushVoiceState pushes the voice state to the client.
 	PushVoiceState(ctx context.Context, uids []string, states *pto.UserVoiceState)
 
@@ -39,8 +48,27 @@ type Push interface {
 	// PushMatchInfo pushes the match success info to the client.
 	PushMatchInfo(ctx context.Context, uids []string, info *pto.MatchInfo)
 
-	// PushCancelMatch pushes the cancel match message to the client.
-	PushCancelMatch(ctx context.Context, uids []string, cancelUID string)
+	// PushReadyCheck pushes the ready check of the matched room to the client.
+	PushReadyCheck(ctx context.Context, uids []string, info *pto.ReadyCheck)
+
+	// PushAcceptMatch pushes the accept match message of the ready check to the client.
+	PushAcceptMatch(ctx context.Context, uids []string, acceptUID string)
+
+	// PushDeclineMatch pushes the failed ready check with the players who declined or timed out to the client.
+	PushDeclineMatch(ctx context.Context, uids []string, declineUIDs []string)
+
+	// PushSpectateEnd pushes the spectate end message to the spectators when the room is cleared.
+	PushSpectateEnd(ctx context.Context, uids []string, roomID int64)
+
+	// PushCustomRoomInfo pushes the custom room info to the client.
+	PushCustomRoomInfo(ctx context.Context, uids []string, info *pto.CustomRoomInfo)
+
+	// PushCustomRoomDissolve pushes the custom room dissolve message to the client.
+	PushCustomRoomDissolve(ctx context.Context, uids []string, roomID int64)
+
+	// PushCancelMatch pushes the cancel match message with the reason to the client,
+	// `cancelUID` is empty if the match is not canceled by a player.
+	PushCancelMatch(ctx context.Context, uids []string, cancelUID string, reason pto.CancelMatchReason)
 
 	// PushReady pushes the ready message to the client.
 	PushReady(ctx context.Context, uids []string, readyUID string)
@@ -0,0 +1,15 @@
+package service
+
+import (
+	"context"
+)
+
+// Relation checks the social relationships between the players,
+// it is used to validate the players entering a group from friend list or clan channel.
+type Relation interface {
+	// IsFriend checks whether the two players are friends.
+	IsFriend(ctx context.Context, uid, otherUID string) (bool, error)
+
+	// InSameClan checks whether the two players are members of the same clan.
+	InSameClan(ctx context.Context, uid, otherUID string) (bool, error)
+}
@@ -0,0 +1,10 @@
+package servicemock
+
+import (
+	"github.com/hedon954/go-matcher/internal/entry"
+)
+
+type BackfillMock struct{}
+
+func (b *BackfillMock) OpenBackfill(entry.Room, entry.Team, int) error { return nil }
+func (b *BackfillMock) CloseBackfill(entry.Room, entry.Team)           {}
@@ -0,0 +1,11 @@
+package servicemock
+
+import (
+	"context"
+)
+
+type ContentFilterMock struct{}
+
+func (f *ContentFilterMock) Filter(_ context.Context, _, content string) (string, error) {
+	return content, nil
+}
@@ -9,10 +9,11 @@ import (
 
 type GameServerDispatch struct{}
 
-func (d *GameServerDispatch) Dispatch(_ context.Context, _ constant.GameMode, _ int64) (pto.GameServerInfo, error) {
+func (d *GameServerDispatch) Dispatch(_ context.Context, _ constant.GameMode, _ int64, region string) (pto.GameServerInfo, error) {
 	return pto.GameServerInfo{
 		Host:     "127.0.0.1",
 		Port:     8080, //nolint:mnd
 		Protocol: constant.KCP,
+		Region:   region,
 	}, nil
 }
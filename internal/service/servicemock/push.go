@@ -14,11 +14,20 @@ func (p *PushMock) PushGroupInfo(context.Context, []string, *pto.GroupInfo)
 func (p *PushMock) PushInviteMsg(context.Context, *pto.InviteMsg)                            {}
 func (p *PushMock) PushAcceptInvite(context.Context, string, string)                         {}
 func (p *PushMock) PushRefuseInvite(context.Context, string, string, string)                 {}
+func (p *PushMock) PushInviteExpired(context.Context, string, string, int64)                 {}
+func (p *PushMock) PushRevokeInvite(context.Context, string, string, int64)                  {}
 func (p *PushMock) PushGroupDissolve(context.Context, []string, int64)                       {}
 func (p *PushMock) PushGroupState(context.Context, []string, int64, entry.GroupState)        {}
+func (p *PushMock) PushGroupMessage(context.Context, []string, *pto.GroupMessage)            {}
 func (p *PushMock) PushVoiceState(context.Context, []string, *pto.UserVoiceState)            {}
 func (p *PushMock) PushKick(context.Context, string, int64)                                  {}
 func (p *PushMock) PushMatchInfo(context.Context, []string, *pto.MatchInfo)                  {}
-func (p *PushMock) PushCancelMatch(context.Context, []string, string)                        {}
+func (p *PushMock) PushReadyCheck(context.Context, []string, *pto.ReadyCheck)                {}
+func (p *PushMock) PushAcceptMatch(context.Context, []string, string)                        {}
+func (p *PushMock) PushDeclineMatch(context.Context, []string, []string)                     {}
+func (p *PushMock) PushSpectateEnd(context.Context, []string, int64)                         {}
+func (p *PushMock) PushCustomRoomInfo(context.Context, []string, *pto.CustomRoomInfo)        {}
+func (p *PushMock) PushCustomRoomDissolve(context.Context, []string, int64)                  {}
+func (p *PushMock) PushCancelMatch(context.Context, []string, string, pto.CancelMatchReason) {}
 func (p *PushMock) PushReady(context.Context, []string, string)                              {}
 func (p *PushMock) PushUnReady(context.Context, []string, string)                            {}
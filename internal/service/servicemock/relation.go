@@ -0,0 +1,12 @@
+package servicemock
+
+import (
+	"context"
+)
+
+// RelationMock treats no players as friends or clan members,
+// so the actions limited to the relations are denied until a real relation service is set.
+type RelationMock struct{}
+
+func (r *RelationMock) IsFriend(context.Context, string, string) (bool, error)   { return false, nil }
+func (r *RelationMock) InSameClan(context.Context, string, string) (bool, error) { return false, nil }
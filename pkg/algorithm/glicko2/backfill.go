@@ -0,0 +1,171 @@
+package glicko2
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrBackfillDisabled = errors.New("backfill is not enabled for the queue")
+
+// Backfill is a request to fill the open slots of a team in an in-progress room.
+type Backfill struct {
+	Room Room
+	Team Team
+
+	// MMR and star of the team when the request is opened
+	MMR  float64
+	Star int
+	// Region the room plays in, empty means any region
+	Region string
+	// Number of players wanted
+	Slots int
+	// Time the request is opened, the matching windows expand since then like a queuing group
+	StartMatchTimeSec int64
+}
+
+// BackfillResult is the groups matched to fill a backfill request.
+type BackfillResult struct {
+	Backfill *Backfill
+	Groups   []Group
+}
+
+// SetBackfillChan enables backfill for the queue, the filled results are sent to ch.
+// It should be called before the queue starts to match.
+func (q *Queue) SetBackfillChan(ch chan BackfillResult) {
+	q.Lock()
+	defer q.Unlock()
+	q.backfillChan = ch
+}
+
+// AddBackfill adds a backfill request to the queue,
+// the request replaces the existing one of the same team.
+func (q *Queue) AddBackfill(b *Backfill) error {
+	q.Lock()
+	defer q.Unlock()
+
+	if q.isClosed {
+		return ErrQueueClosed
+	}
+	if q.backfillChan == nil {
+		return ErrBackfillDisabled
+	}
+
+	if b.StartMatchTimeSec == 0 {
+		b.StartMatchTimeSec = q.nowUnixFunc()
+	}
+	q.removeBackfill(b.Team)
+	q.backfills = append(q.backfills, b)
+	return nil
+}
+
+// RemoveBackfill removes the backfill request of the team
+func (q *Queue) RemoveBackfill(team Team) {
+	q.Lock()
+	defer q.Unlock()
+	q.removeBackfill(team)
+}
+
+func (q *Queue) removeBackfill(team Team) {
+	for i, b := range q.backfills {
+		if b.Team == team {
+			q.backfills = append(q.backfills[:i], q.backfills[i+1:]...)
+			return
+		}
+	}
+}
+
+// fillBackfills offers the groups to the open backfill requests ahead of forming new teams,
+// the oldest request first, and returns the groups not used.
+// Requests opened for longer than MatchTimeoutSec are dropped.
+func (q *Queue) fillBackfills(groups []Group) []Group {
+	if len(q.backfills) == 0 {
+		return groups
+	}
+
+	now := q.nowUnixFunc()
+	open := make([]*Backfill, 0, len(q.backfills))
+	for _, b := range q.backfills {
+		if q.MatchTimeoutSec != 0 && now-b.StartMatchTimeSec >= q.MatchTimeoutSec {
+			continue
+		}
+		var filled []Group
+		groups, filled = q.fillBackfill(b, groups)
+		if len(filled) > 0 {
+			q.backfillSuccess(b, filled)
+		}
+		if b.Slots > 0 {
+			open = append(open, b)
+		}
+	}
+	q.backfills = open
+	return groups
+}
+
+// fillBackfill picks the groups for the backfill request until its slots are filled,
+// the biggest group first and then the one with the closest MMR.
+func (q *Queue) fillBackfill(b *Backfill, groups []Group) (rest, filled []Group) {
+	for b.Slots > 0 {
+		best := -1
+		for i, g := range groups {
+			if g.GetState() != GroupStateQueuing || g.PlayerCount() > b.Slots || !q.canBackfill(b, g) {
+				continue
+			}
+			if best == -1 || g.PlayerCount() > groups[best].PlayerCount() ||
+				(g.PlayerCount() == groups[best].PlayerCount() &&
+					math.Abs(g.GetMMR()-b.MMR) < math.Abs(groups[best].GetMMR()-b.MMR)) {
+				best = i
+			}
+		}
+		if best == -1 {
+			break
+		}
+		g := groups[best]
+		filled = append(filled, g)
+		b.Slots -= g.PlayerCount()
+		groups = append(groups[:best], groups[best+1:]...)
+	}
+	return groups, filled
+}
+
+// canBackfill determines whether the group can fill the backfill request,
+// the group is admitted by the same rules as joining a team in the regular matching:
+// the groups due for AI are left to be filled with AI, the newcomers only fill the newcomer teams
+// if NewerWithNewer, and the MMR, star and region should match the request as if it is a queuing group.
+func (q *Queue) canBackfill(b *Backfill, g Group) bool {
+	now := q.nowUnixFunc()
+	if g.CanFillAi(now) {
+		return false
+	}
+	if q.NewerWithNewer && b.Team != nil && g.IsNewer(now) != b.Team.IsNewer(now) {
+		return false
+	}
+
+	mst := g.GetStartMatchTimeSec()
+	if !q.mmrMatched(g.GetMMR(), mst, b.MMR, b.StartMatchTimeSec) {
+		return false
+	}
+	if !q.starMatched(g.GetStar(), mst, b.Star, b.StartMatchTimeSec) {
+		return false
+	}
+	if b.Region != "" {
+		regions := acceptableRegions(g.GetRegionPings(), q.getMatchRange(mst, b.StartMatchTimeSec).MaxPingMs)
+		if _, ok := regions[b.Region]; regions != nil && !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// backfillSuccess sends the filled groups of the backfill request out
+func (q *Queue) backfillSuccess(b *Backfill, groups []Group) {
+	now := q.nowUnixFunc()
+	for _, g := range groups {
+		q.metrics.ObserveMatchWait(q.Name, now-g.GetStartMatchTimeSec())
+	}
+	go func() {
+		for _, g := range groups {
+			g.SetState(GroupStateMatched)
+		}
+		q.backfillChan <- BackfillResult{Backfill: b, Groups: groups}
+	}()
+}
@@ -0,0 +1,122 @@
+package glicko2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueue_AddBackfill(t *testing.T) {
+	q := newQueue()
+	team := NewTeam(newGroupWithMMR(1, 4, 100))
+
+	// 未开启回填
+	assert.Equal(t, ErrBackfillDisabled, q.AddBackfill(&Backfill{Team: team, Slots: 1}))
+
+	q.SetBackfillChan(make(chan BackfillResult, 8))
+	assert.Nil(t, q.AddBackfill(&Backfill{Team: team, Slots: 1}))
+	assert.Equal(t, q.nowUnixFunc(), q.backfills[0].StartMatchTimeSec)
+
+	// 同一个阵营的请求会覆盖之前的请求
+	assert.Nil(t, q.AddBackfill(&Backfill{Team: team, Slots: 2}))
+	assert.Equal(t, 1, len(q.backfills))
+	assert.Equal(t, 2, q.backfills[0].Slots)
+
+	q.RemoveBackfill(team)
+	assert.Equal(t, 0, len(q.backfills))
+
+	q.StopMatch()
+	assert.Equal(t, ErrQueueClosed, q.AddBackfill(&Backfill{Team: team, Slots: 1}))
+}
+
+func TestQueue_Match_Backfill(t *testing.T) {
+	q := newQueue()
+	ch := make(chan BackfillResult, 8)
+	q.SetBackfillChan(ch)
+
+	team := NewTeam(newGroupWithMMR(1, 2, 100))
+	assert.Nil(t, q.AddBackfill(&Backfill{Team: team, MMR: 100, Slots: 3}))
+
+	g2 := newGroupWithSize(2, 2, 102)
+	g3 := newGroupWithSize(3, 1, 105)
+	g4 := newGroupWithSize(4, 1, 101)
+	g5 := newGroupWithSize(5, 3, 200)
+	rest := q.Match([]Group{g2, g3, g4, g5})
+
+	// 优先填充大的车队，然后是 mmr 最接近的，mmr 差距过大的不能回填
+	res := <-ch
+	assert.Equal(t, team, res.Backfill.Team)
+	assert.Equal(t, []Group{g2, g4}, res.Groups)
+	assert.ElementsMatch(t, []Group{g3, g5}, rest)
+	assert.Equal(t, 0, len(q.backfills))
+	assert.Equal(t, GroupStateMatched, g2.GetState())
+
+	// 部分回填时，请求保留剩余的空位
+	assert.Nil(t, q.AddBackfill(&Backfill{Team: team, MMR: 100, Slots: 2}))
+	rest = q.Match(rest)
+	res = <-ch
+	assert.Equal(t, []Group{g3}, res.Groups)
+	assert.Equal(t, []Group{g5}, rest)
+	assert.Equal(t, 1, q.backfills[0].Slots)
+
+	// 超时的请求会被丢弃
+	q.MatchTimeoutSec = 10
+	q.backfills[0].StartMatchTimeSec = q.nowUnixFunc() - 10
+	q.Match(rest)
+	assert.Equal(t, 0, len(q.backfills))
+}
+
+func TestQueue_canBackfill_region(t *testing.T) {
+	q := newQueue()
+	q.QueueArgs = GetQueueArgs()
+	q.MatchRanges[0].MaxPingMs = 100
+	b := &Backfill{MMR: 100, Region: "sh", StartMatchTimeSec: q.nowUnixFunc()}
+
+	g := newGroupWithMMR(1, 1, 100).(*GroupMock)
+	assert.True(t, q.canBackfill(b, g))
+	g.RegionPings = map[string]int64{"sh": 50, "gz": 30}
+	assert.True(t, q.canBackfill(b, g))
+	g.RegionPings = map[string]int64{"sh": 150, "gz": 30}
+	assert.False(t, q.canBackfill(b, g))
+}
+
+func TestQueue_canBackfill_newcomer(t *testing.T) {
+	q := newQueue()
+	q.QueueArgs = GetQueueArgs()
+	b := &Backfill{Team: NewTeam(newGroupWithMMR(1, 1, 100)), MMR: 100, StartMatchTimeSec: q.nowUnixFunc()}
+	g := newGroupWithMMR(2, 1, 100).(*GroupMock)
+	assert.True(t, q.canBackfill(b, g))
+
+	// 新手只回填新手阵营
+	g.Newer = true
+	q.NewerWithNewer = false
+	assert.True(t, q.canBackfill(b, g))
+	q.NewerWithNewer = true
+	assert.False(t, q.canBackfill(b, g))
+	b.Team.GetGroups()[0].(*GroupMock).Newer = true
+	assert.True(t, q.canBackfill(b, g))
+
+	// 可以填充 AI 的车队留给 AI 填充
+	g.SetStartMatchTimeSec(q.nowUnixFunc() - 61)
+	assert.False(t, q.canBackfill(b, g))
+}
+
+func TestMatcher_Backfill_normalQueueOnly(t *testing.T) {
+	qm, _ := NewMatcher(make(chan error, 8), make(chan Room, 8), GetQueueArgs, NewTeam, NewRoom, NewRoomWithAi)
+	ch := make(chan BackfillResult, 8)
+	qm.EnableBackfill(ch)
+
+	team := NewTeam(newGroupWithMMR(1, 1, 100))
+	assert.Nil(t, qm.AddBackfill(&Backfill{Team: team, MMR: 100, Slots: 2}))
+	assert.Equal(t, ErrBackfillDisabled, qm.TeamQueue.AddBackfill(&Backfill{Team: team, Slots: 2}))
+
+	// 组队队列中的车队不参与回填，移入普通队列后才可以
+	premade := newGroupWithSize(2, 2, 100)
+	premade.SetStartMatchTimeSec(time.Now().Unix())
+	assert.Equal(t, []Group{premade}, qm.TeamQueue.Match([]Group{premade}))
+	assert.Equal(t, 0, len(ch))
+	assert.Equal(t, 0, len(qm.NormalQueue.Match([]Group{premade})))
+	res := <-ch
+	assert.Equal(t, []Group{premade}, res.Groups)
+}
@@ -0,0 +1,252 @@
+package glicko2
+
+import (
+	"math"
+	"sort"
+)
+
+// defaultBalanceSwapBudget is the default max swap attempts when balancing the teams of a room.
+const defaultBalanceSwapBudget = 100
+
+// teamAssignment holds the groups of a room and the team each group is assigned to.
+type teamAssignment struct {
+	groups  []Group
+	teamOf  []int     // index of the team each group is assigned to
+	sums    []float64 // sum of the players' MMR of each team
+	counts  []int     // player count of each team
+	mmrs    []float64 // MMR of each group
+	players []int     // player count of each group
+}
+
+func newTeamAssignment(teams []Team) *teamAssignment {
+	a := &teamAssignment{
+		sums:   make([]float64, len(teams)),
+		counts: make([]int, len(teams)),
+	}
+	for i, t := range teams {
+		for _, g := range t.GetGroups() {
+			a.groups = append(a.groups, g)
+			a.teamOf = append(a.teamOf, i)
+			a.mmrs = append(a.mmrs, g.GetMMR())
+			a.players = append(a.players, g.PlayerCount())
+		}
+	}
+	a.recount()
+	return a
+}
+
+func (a *teamAssignment) clone() *teamAssignment {
+	c := *a
+	c.teamOf = append([]int(nil), a.teamOf...)
+	c.sums = append([]float64(nil), a.sums...)
+	c.counts = append([]int(nil), a.counts...)
+	return &c
+}
+
+func (a *teamAssignment) recount() {
+	for i := range a.sums {
+		a.sums[i], a.counts[i] = 0, 0
+	}
+	for gi, ti := range a.teamOf {
+		a.sums[ti] += a.mmrs[gi] * float64(a.players[gi])
+		a.counts[ti] += a.players[gi]
+	}
+}
+
+// spread returns the difference between the biggest and the smallest team MMR,
+// the team MMR here is the average MMR of its players.
+func (a *teamAssignment) spread() float64 {
+	maxMMR, minMMR := -math.MaxFloat64, math.MaxFloat64
+	for i := range a.sums {
+		if a.counts[i] == 0 {
+			continue
+		}
+		mmr := a.sums[i] / float64(a.counts[i])
+		maxMMR = math.Max(maxMMR, mmr)
+		minMMR = math.Min(minMMR, mmr)
+	}
+	if maxMMR < minMMR {
+		return 0
+	}
+	return maxMMR - minMMR
+}
+
+// swap swaps the teams of group i and group j.
+func (a *teamAssignment) swap(i, j int) {
+	ti, tj := a.teamOf[i], a.teamOf[j]
+	a.sums[ti] += a.mmrs[j]*float64(a.players[j]) - a.mmrs[i]*float64(a.players[i])
+	a.sums[tj] += a.mmrs[i]*float64(a.players[i]) - a.mmrs[j]*float64(a.players[j])
+	a.counts[ti] += a.players[j] - a.players[i]
+	a.counts[tj] += a.players[i] - a.players[j]
+	a.teamOf[i], a.teamOf[j] = tj, ti
+}
+
+// teamGroups returns the groups assigned to team ti.
+func (a *teamAssignment) teamGroups(ti int) []Group {
+	var groups []Group
+	for gi, t := range a.teamOf {
+		if t == ti {
+			groups = append(groups, a.groups[gi])
+		}
+	}
+	return groups
+}
+
+// greedy assigns the biggest groups first, each to the team with the lowest MMR sum
+// which still has room for it and whose groups can still team up with it.
+// Returns false if the groups can not be packed into the teams or the teams can not form a room.
+func (a *teamAssignment) greedy(q *Queue) bool {
+	order := make([]int, len(a.groups))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		gi, gj := order[i], order[j]
+		if a.players[gi] != a.players[gj] {
+			return a.players[gi] > a.players[gj]
+		}
+		return a.mmrs[gi] > a.mmrs[gj]
+	})
+
+	for i := range a.sums {
+		a.sums[i], a.counts[i] = 0, 0
+	}
+	for gi := range a.teamOf {
+		a.teamOf[gi] = -1
+	}
+	for _, gi := range order {
+		best := -1
+		for ti := range a.sums {
+			if a.counts[ti]+a.players[gi] > q.TeamPlayerLimit || !q.canGroupJoin(a.teamGroups(ti), a.groups[gi]) {
+				continue
+			}
+			if best == -1 || a.sums[ti] < a.sums[best] {
+				best = ti
+			}
+		}
+		if best == -1 {
+			return false
+		}
+		a.teamOf[gi] = best
+		a.sums[best] += a.mmrs[gi] * float64(a.players[gi])
+		a.counts[best] += a.players[gi]
+	}
+	return q.canAssign(a)
+}
+
+// localSwap swaps groups with the same player count between teams while it reduces the spread
+// and the teams still pass the checks of the queue, at most `budget` swaps would be tried.
+func (a *teamAssignment) localSwap(q *Queue, budget int) {
+	for improved := true; improved && budget > 0; {
+		improved = false
+		for i := 0; i < len(a.groups) && budget > 0; i++ {
+			for j := i + 1; j < len(a.groups) && budget > 0; j++ {
+				if a.teamOf[i] == a.teamOf[j] || a.players[i] != a.players[j] {
+					continue
+				}
+				budget--
+				before := a.spread()
+				a.swap(i, j)
+				if a.spread() < before && q.canAssign(a) {
+					improved = true
+					continue
+				}
+				a.swap(i, j)
+			}
+		}
+	}
+}
+
+// balanceRoomTeams redistributes the groups of the room across its teams to minimize the team MMR spread,
+// a group would never be split. It returns the MMR spread of the room after balancing.
+func (q *Queue) balanceRoomTeams(room Room) float64 {
+	teams := room.GetTeams()
+	origin := newTeamAssignment(teams)
+	if !q.BalanceRoomTeams || len(teams) < 2 {
+		return origin.spread()
+	}
+
+	best := origin.clone()
+	if greedy := origin.clone(); greedy.greedy(q) && greedy.spread() < best.spread() {
+		best = greedy
+	}
+	budget := q.BalanceSwapBudget
+	if budget <= 0 {
+		budget = defaultBalanceSwapBudget
+	}
+	best.localSwap(q, budget)
+
+	if best.spread() >= origin.spread() {
+		return origin.spread()
+	}
+	for gi, g := range best.groups {
+		from, to := origin.teamOf[gi], best.teamOf[gi]
+		if from == to {
+			continue
+		}
+		teams[from].RemoveGroup(g)
+		teams[to].AddGroup(g)
+	}
+	return best.spread()
+}
+
+// canAssign checks whether the assigned teams still pass the checks used to form the teams and the room,
+// the groups of each team must be able to team up, and each two teams must be able to join each other.
+// The MMR gap between teams is not checked since balancing only shrinks it,
+// neither the newcomer and region checks of the whole room since the groups of the room are unchanged.
+func (q *Queue) canAssign(a *teamAssignment) bool {
+	teams := make([][]Group, len(a.sums))
+	for ti := range teams {
+		groups := a.teamGroups(ti)
+		for i := 1; i < len(groups); i++ {
+			if !q.canGroupJoin(groups[:i], groups[i]) {
+				return false
+			}
+		}
+		teams[ti] = groups
+	}
+
+	for i := 0; i < len(teams); i++ {
+		for j := i + 1; j < len(teams); j++ {
+			if !q.canTeamGroupsJoin(teams[i], teams[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// canTeamGroupsJoin checks whether the teams composed of groups1 and groups2 can join each other,
+// which is the same as canTeamJoin except that the order of the two teams does not matter.
+func (q *Queue) canTeamGroupsJoin(groups1, groups2 []Group) bool {
+	if len(groups1) == 0 || len(groups2) == 0 {
+		return true
+	}
+	mst1, mst2 := earliestStartMatchTimeSec(groups1), earliestStartMatchTimeSec(groups2)
+	mr := q.getMatchRange(mst1, mst2)
+
+	// Check if joining full teams is allowed
+	if !mr.CanJoinTeam && (len(groups1) > 1 && len(groups2) == 1 || len(groups1) == 1 && len(groups2) > 1) {
+		return false
+	}
+
+	// Check if premade parties are of similar size
+	if mr.PremadeSizeGap != 0 && int(math.Abs(float64(premadeSize(groups1)-premadeSize(groups2)))) > mr.PremadeSizeGap {
+		return false
+	}
+
+	// Check if rank matches
+	return q.starMatched(groupsStar(groups1), mst1, groupsStar(groups2), mst2)
+}
+
+// groupsStar returns the average star of the groups, the same as the star of a team
+func groupsStar(groups []Group) int {
+	if len(groups) == 0 {
+		return 0
+	}
+	star := 0
+	for _, g := range groups {
+		star += g.GetStar()
+	}
+	return star / len(groups)
+}
@@ -0,0 +1,124 @@
+package glicko2
+
+import (
+	"math"
+	"sort"
+)
+
+// CurveType is the type of the expansion curve.
+type CurveType string
+
+const (
+	// CurveStep uses the step table QueueArgs.MatchRanges, it is the default curve type.
+	CurveStep CurveType = "step"
+	// CurveLinear expands the window linearly: Initial + Rate * waitSec.
+	CurveLinear CurveType = "linear"
+	// CurveExponential expands the window exponentially: Initial * (1 + Rate) ^ waitSec.
+	CurveExponential CurveType = "exponential"
+	// CurvePiecewise linearly interpolates the window between Points,
+	// and keeps the first (last) value before (after) the first (last) point.
+	CurvePiecewise CurveType = "piecewise"
+)
+
+// ExpansionCurve describes how a matching window expands with the wait time of a group.
+// Unlike the step table, a zero window of a curve is the tightest window instead of no restriction.
+type ExpansionCurve struct {
+	Type CurveType `json:"type" yaml:"type"`
+
+	// Window at the beginning of matching, used by linear and exponential curves
+	Initial float64 `json:"initial" yaml:"initial"`
+	// Growth rate per second, used by linear and exponential curves
+	Rate float64 `json:"rate" yaml:"rate"`
+	// Points of the piecewise curve
+	Points []CurvePoint `json:"points" yaml:"points"`
+	// Upper bound of the window, 0 means no upper bound
+	Max float64 `json:"max" yaml:"max"`
+}
+
+// CurvePoint is a point of the piecewise curve.
+type CurvePoint struct {
+	WaitSec int64   `json:"wait_sec" yaml:"wait_sec"`
+	Value   float64 `json:"value" yaml:"value"`
+}
+
+// Value returns the window after waiting waitSec seconds.
+// It should not be called with CurveStep, which is evaluated by the queue with MatchRanges.
+func (c *ExpansionCurve) Value(waitSec int64) float64 {
+	var v float64
+	switch c.Type {
+	case CurveLinear:
+		v = c.Initial + c.Rate*float64(waitSec)
+	case CurveExponential:
+		v = c.Initial * math.Pow(1+c.Rate, float64(waitSec))
+	case CurvePiecewise:
+		v = c.piecewise(waitSec)
+	}
+	if c.Max > 0 && v > c.Max {
+		v = c.Max
+	}
+	return v
+}
+
+func (c *ExpansionCurve) piecewise(waitSec int64) float64 {
+	if len(c.Points) == 0 {
+		return 0
+	}
+	i := sort.Search(len(c.Points), func(i int) bool {
+		return c.Points[i].WaitSec >= waitSec
+	})
+	if i == 0 {
+		return c.Points[0].Value
+	}
+	if i == len(c.Points) {
+		return c.Points[len(c.Points)-1].Value
+	}
+	p1, p2 := c.Points[i-1], c.Points[i]
+	return p1.Value + (p2.Value-p1.Value)*float64(waitSec-p1.WaitSec)/float64(p2.WaitSec-p1.WaitSec)
+}
+
+// isStep reports whether the curve is evaluated by the step table MatchRanges.
+func (c *ExpansionCurve) isStep() bool {
+	return c == nil || c.Type == "" || c.Type == CurveStep
+}
+
+// mmrGapPercent returns the allowed MMR gap percent of a group which has waited waitSec seconds,
+// 0 means no restriction with the step table.
+func (q *Queue) mmrGapPercent(waitSec int64) float64 {
+	if q.MMRGapCurve.isStep() {
+		return float64(q.matchRangeOf(waitSec).MMRGapPercent)
+	}
+	return q.MMRGapCurve.Value(waitSec)
+}
+
+// starGap returns the allowed star gap of a group which has waited waitSec seconds,
+// 0 means no restriction with the step table.
+func (q *Queue) starGap(waitSec int64) float64 {
+	if q.StarGapCurve.isStep() {
+		return float64(q.matchRangeOf(waitSec).StarGap)
+	}
+	return q.StarGapCurve.Value(waitSec)
+}
+
+// mmrMatched checks whether the MMR of two sides are close enough.
+// Each side has its own window computed from its own wait time,
+// and they match if the gap is within the average of both windows,
+// so a long waiter can meet a fresh joiner with a wider gap than two fresh joiners.
+func (q *Queue) mmrMatched(mmr1 float64, mst1 int64, mmr2 float64, mst2 int64) bool {
+	now := q.nowUnixFunc()
+	p1, p2 := q.mmrGapPercent(now-mst1), q.mmrGapPercent(now-mst2)
+	if q.MMRGapCurve.isStep() && (p1 == 0 || p2 == 0) {
+		return true
+	}
+	window := (mmr1*p1 + mmr2*p2) / 100 / 2 //nolint:mnd
+	return math.Abs(mmr1-mmr2) <= window
+}
+
+// starMatched checks whether the star of two sides are close enough, the same as mmrMatched.
+func (q *Queue) starMatched(star1 int, mst1 int64, star2 int, mst2 int64) bool {
+	now := q.nowUnixFunc()
+	g1, g2 := q.starGap(now-mst1), q.starGap(now-mst2)
+	if q.StarGapCurve.isStep() && (g1 == 0 || g2 == 0) {
+		return true
+	}
+	return math.Abs(float64(star1-star2)) <= (g1+g2)/2 //nolint:mnd
+}
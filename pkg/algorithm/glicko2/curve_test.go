@@ -0,0 +1,86 @@
+package glicko2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpansionCurve_Value(t *testing.T) {
+	linear := &ExpansionCurve{Type: CurveLinear, Initial: 5, Rate: 0.5, Max: 20}
+	assert.Equal(t, 5.0, linear.Value(0))
+	assert.Equal(t, 10.0, linear.Value(10))
+	assert.Equal(t, 20.0, linear.Value(100))
+
+	exponential := &ExpansionCurve{Type: CurveExponential, Initial: 4, Rate: 1}
+	assert.Equal(t, 4.0, exponential.Value(0))
+	assert.Equal(t, 32.0, exponential.Value(3))
+
+	piecewise := &ExpansionCurve{Type: CurvePiecewise, Points: []CurvePoint{
+		{WaitSec: 10, Value: 10},
+		{WaitSec: 20, Value: 30},
+		{WaitSec: 60, Value: 50},
+	}}
+	assert.Equal(t, 10.0, piecewise.Value(0))
+	assert.Equal(t, 10.0, piecewise.Value(10))
+	assert.Equal(t, 20.0, piecewise.Value(15))
+	assert.Equal(t, 40.0, piecewise.Value(40))
+	assert.Equal(t, 50.0, piecewise.Value(100))
+	assert.Equal(t, 0.0, (&ExpansionCurve{Type: CurvePiecewise}).Value(10))
+}
+
+func TestQueue_mmrMatched(t *testing.T) {
+	q := newQueue()
+	now := q.nowUnixFunc()
+
+	// 默认使用 MatchRanges 阶梯表，每个 group 按自己的等待时长计算窗口
+	assert.Equal(t, 10.0, q.mmrGapPercent(0))
+	assert.Equal(t, 20.0, q.mmrGapPercent(20))
+	assert.True(t, q.mmrMatched(100, now, 110, now))
+	assert.False(t, q.mmrMatched(100, now, 114, now))
+	// 等待时间长的一方会放宽窗口，不再受等待时间短的一方限制
+	assert.True(t, q.mmrMatched(100, now-20, 114, now))
+	assert.False(t, q.mmrMatched(100, now-20, 120, now))
+	// 任意一方不限制 mmr，则可以匹配
+	assert.True(t, q.mmrMatched(100, now-1000, 1000, now))
+
+	// 使用连续曲线
+	q.MMRGapCurve = &ExpansionCurve{Type: CurveLinear, Initial: 10, Rate: 1}
+	assert.Equal(t, 15.0, q.mmrGapPercent(5))
+	assert.True(t, q.mmrMatched(100, now-5, 112, now))
+	assert.False(t, q.mmrMatched(100, now-1, 112, now))
+
+	// 从 0 开始的曲线是最严格的窗口，而不是不限制
+	q.MMRGapCurve = &ExpansionCurve{Type: CurveLinear, Initial: 0, Rate: 1}
+	assert.True(t, q.mmrMatched(100, now, 100, now))
+	assert.False(t, q.mmrMatched(100, now, 101, now))
+	assert.True(t, q.mmrMatched(100, now-2, 101, now-2))
+	q.MMRGapCurve = &ExpansionCurve{Type: CurveExponential, Initial: 0, Rate: 1}
+	assert.False(t, q.mmrMatched(100, now-1000, 101, now-1000))
+}
+
+func TestQueue_starMatched(t *testing.T) {
+	q := newQueue()
+	now := q.nowUnixFunc()
+
+	// 阶梯表中没有限制段位
+	assert.True(t, q.starMatched(1, now, 100, now))
+
+	q.StarGapCurve = &ExpansionCurve{Type: CurvePiecewise, Points: []CurvePoint{
+		{WaitSec: 0, Value: 2},
+		{WaitSec: 10, Value: 6},
+	}}
+	assert.True(t, q.starMatched(10, now, 12, now))
+	assert.False(t, q.starMatched(10, now, 13, now))
+	assert.True(t, q.starMatched(10, now-10, 14, now))
+	assert.False(t, q.starMatched(10, now-10, 15, now))
+
+	// 从 0 开始的分段曲线，刚开始匹配时只能匹配相同段位
+	q.StarGapCurve = &ExpansionCurve{Type: CurvePiecewise, Points: []CurvePoint{
+		{WaitSec: 0, Value: 0},
+		{WaitSec: 10, Value: 4},
+	}}
+	assert.True(t, q.starMatched(10, now, 10, now))
+	assert.False(t, q.starMatched(10, now, 11, now))
+	assert.True(t, q.starMatched(10, now-10, 14, now-10))
+}
@@ -1,5 +1,7 @@
 package glicko2
 
+import "math"
+
 type GroupState uint8
 
 const (
@@ -22,6 +24,21 @@ const (
 	GroupTypeMaliciousTeam
 )
 
+func (t GroupType) String() string {
+	switch t {
+	case GroupTypeNotTeam:
+		return "not_team"
+	case GroupTypeNormalTeam:
+		return "normal_team"
+	case GroupTypeUnfriendlyTeam:
+		return "unfriendly_team"
+	case GroupTypeMaliciousTeam:
+		return "malicious_team"
+	default:
+		return "unknown"
+	}
+}
+
 // Group represents a team,
 // players can form teams on their own or a single player will be assigned a team when they start matching,
 // the team before and after the match will not be broken up.
@@ -59,12 +76,60 @@ type Group interface {
 	// Type returns the team type
 	Type() GroupType
 
-	// CanFillAi returns true if the team will be filled with AI and the second return value is the AI team to be filled
-	CanFillAi() bool
+	// CanFillAi returns true if the team will be filled with AI at now, the unix seconds of the queue clock
+	CanFillAi(now int64) bool
 
 	// ForceCancelMatch is the logic for handling player cancellation when forced to exit
 	ForceCancelMatch(reason string, waitSec int64)
 
-	// IsNewer checks if the team is identified as a newcomer
-	IsNewer() bool
+	// IsNewer checks if the team is identified as a newcomer at now, the unix seconds of the queue clock
+	IsNewer(now int64) bool
+
+	// IsPenalized checks if the team is under penalty at now, the unix seconds of the queue clock,
+	// e.g. declined a matched room recently
+	IsPenalized(now int64) bool
+
+	// GetRegionPings returns the ping(ms) of the team to each region,
+	// empty means the team has no region preference
+	GetRegionPings() map[string]int64
+}
+
+// ClassifyGroup classifies the group type by the MMR variance of its players.
+// A group with only one player is GroupTypeNotTeam,
+// otherwise it is GroupTypeMaliciousTeam or GroupTypeUnfriendlyTeam if the variance reaches
+// MaliciousTeamMMRVarianceMin or UnfriendlyTeamMMRVarianceMin (0 means disabled), or GroupTypeNormalTeam.
+func ClassifyGroup(players []Player, args *QueueArgs) GroupType {
+	if len(players) <= 1 {
+		return GroupTypeNotTeam
+	}
+	if args == nil {
+		return GroupTypeNormalTeam
+	}
+
+	variance := mmrVariance(players)
+	switch {
+	case args.MaliciousTeamMMRVarianceMin > 0 && variance >= float64(args.MaliciousTeamMMRVarianceMin):
+		return GroupTypeMaliciousTeam
+	case args.UnfriendlyTeamMMRVarianceMin > 0 && variance >= float64(args.UnfriendlyTeamMMRVarianceMin):
+		return GroupTypeUnfriendlyTeam
+	default:
+		return GroupTypeNormalTeam
+	}
+}
+
+// mmrVariance returns the population variance of the players' MMR.
+func mmrVariance(players []Player) float64 {
+	if len(players) == 0 {
+		return 0
+	}
+	mean := 0.0
+	for _, p := range players {
+		mean += p.GetMMR()
+	}
+	mean /= float64(len(players))
+	variance := 0.0
+	for _, p := range players {
+		variance += math.Pow(p.GetMMR()-mean, 2) //nolint:mnd
+	}
+	return variance / float64(len(players))
 }
@@ -3,6 +3,7 @@ package glicko2
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -17,6 +18,18 @@ type Matcher struct {
 	errChan  chan error
 	quitChan chan struct{}
 
+	// paused skips the match ticks, the groups keep waiting in the queues
+	paused atomic.Bool
+
+	// tickLock is held during a match tick, when the groups are taken out of the queues
+	tickLock sync.Mutex
+
+	// metrics records the statistics of the ticks, it is set by SetMetrics
+	metrics Metrics
+
+	// tracer traces the ticks, it is set by SetTracer
+	tracer Tracer
+
 	NormalQueue *Queue
 	TeamQueue   *Queue
 }
@@ -45,6 +58,8 @@ func NewMatcher(
 	return &Matcher{
 		errChan:     errChan,
 		quitChan:    make(chan struct{}),
+		metrics:     nopMetrics{},
+		tracer:      nopTracer{},
 		NormalQueue: nq,
 		TeamQueue:   tq,
 	}, nil
@@ -80,15 +95,25 @@ func (qm *Matcher) Match(interval time.Duration) {
 			log.Info().Msg("stop glicko2 matcher")
 			return
 		case <-ticker:
+			if qm.paused.Load() {
+				continue
+			}
 			func() {
+				qm.tickLock.Lock()
+				defer qm.tickLock.Unlock()
 				defer func() {
 					if err := recover(); err != nil {
 						qm.errChan <- fmt.Errorf("glicko2 matcher occurs panic: %v", err)
 					}
 				}()
+				start := time.Now()
+
 				// Get the groups to be matched in this round.
 				nGs := qm.NormalQueue.GetAndClearGroups()
 				tGs := qm.TeamQueue.GetAndClearGroups()
+				qm.recordQueueDepth(NormalQueue, nGs)
+				qm.recordQueueDepth(TeamQueue, tGs)
+				endTick := qm.tracer.StartTick(len(nGs) + len(tGs))
 
 				wg := sync.WaitGroup{}
 				wg.Add(2)
@@ -124,6 +149,7 @@ func (qm *Matcher) Match(interval time.Duration) {
 						needMove = false
 					}
 					if needMove {
+						qm.metrics.IncTeamToNormal(g.Type())
 						_ = qm.NormalQueue.AddGroups(g)
 					} else {
 						_ = qm.TeamQueue.AddGroups(g)
@@ -132,11 +158,97 @@ func (qm *Matcher) Match(interval time.Duration) {
 
 				// Add the normal groups back to the normal queue
 				_ = qm.NormalQueue.AddGroups(nGs...)
+
+				rooms, carriedOver := qm.NormalQueue.roomsFormed+qm.TeamQueue.roomsFormed, len(nGs)+len(tGs)
+				qm.metrics.ObserveTick(time.Since(start), rooms, carriedOver)
+				endTick(rooms, carriedOver)
 			}()
 		}
 	}
 }
 
+// EnableBackfill enables backfill for the matcher, the filled results are sent to ch.
+// Backfill requests are served by the normal queue only,
+// since the groups there are the most flexible to join an in-progress room.
+// The premade groups in the team queue are kept to match each other first,
+// they can backfill only after being moved to the normal queue when their team queue wait time is up.
+func (qm *Matcher) EnableBackfill(ch chan BackfillResult) {
+	qm.NormalQueue.SetBackfillChan(ch)
+}
+
+// AddBackfill adds a backfill request to the matcher.
+func (qm *Matcher) AddBackfill(b *Backfill) error {
+	return qm.NormalQueue.AddBackfill(b)
+}
+
+// RemoveBackfill removes the backfill request of the team.
+func (qm *Matcher) RemoveBackfill(team Team) {
+	qm.NormalQueue.RemoveBackfill(team)
+}
+
+// WaitStats returns the wait time percentiles of the normal queue and the team queue in the last tick.
+func (qm *Matcher) WaitStats() (normal, team WaitStats) {
+	return qm.NormalQueue.WaitStats(), qm.TeamQueue.WaitStats()
+}
+
+// SetMetrics sets the metrics to record the statistics of the matcher,
+// it should be called before the matcher starts to match.
+func (qm *Matcher) SetMetrics(m Metrics) {
+	qm.metrics = m
+	qm.NormalQueue.metrics = m
+	qm.TeamQueue.metrics = m
+}
+
+// SetTracer sets the tracer to trace the ticks of the matcher,
+// it should be called before the matcher starts to match.
+func (qm *Matcher) SetTracer(t Tracer) {
+	qm.tracer = t
+}
+
+// recordQueueDepth records the number of the groups of each type in the queue.
+func (qm *Matcher) recordQueueDepth(queue string, groups []Group) {
+	counts := make(map[GroupType]int, 4) //nolint:mnd
+	for _, t := range []GroupType{GroupTypeNotTeam, GroupTypeNormalTeam, GroupTypeUnfriendlyTeam, GroupTypeMaliciousTeam} {
+		counts[t] = 0
+	}
+	for _, g := range groups {
+		counts[g.Type()]++
+	}
+	for t, count := range counts {
+		qm.metrics.SetQueueDepth(queue, t, count)
+	}
+}
+
+// Groups returns the groups waiting in the queues,
+// it waits for the running match tick so that no group is missing.
+func (qm *Matcher) Groups() []Group {
+	qm.tickLock.Lock()
+	defer qm.tickLock.Unlock()
+	groups := append(qm.NormalQueue.AllGroups(), qm.TeamQueue.AllGroups()...)
+	res := make([]Group, 0, len(groups))
+	for _, g := range groups {
+		if g.GetState() == GroupStateQueuing {
+			res = append(res, g)
+		}
+	}
+	return res
+}
+
+// Pause stops matching the groups until Resume, the groups can still join or leave the queues.
+func (qm *Matcher) Pause() {
+	qm.paused.Store(true)
+}
+
+// Resume resumes matching the groups.
+func (qm *Matcher) Resume() {
+	qm.paused.Store(false)
+}
+
+// Paused returns whether the matcher is paused.
+func (qm *Matcher) Paused() bool {
+	return qm.paused.Load()
+}
+
 func (qm *Matcher) Stop() ([]Group, []Group) {
 	gs1 := qm.NormalQueue.StopMatch()
 	gs2 := qm.TeamQueue.StopMatch()
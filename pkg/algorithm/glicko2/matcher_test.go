@@ -65,3 +65,48 @@ func Test_Matcher(t *testing.T) {
 		}
 	}
 }
+
+func Test_Matcher_PauseResume(t *testing.T) {
+	errChan := make(chan error, 128)
+	roomChan := make(chan Room, 128)
+	qm, _ := NewMatcher(errChan, roomChan, GetQueueArgs, NewTeam, NewRoom, NewRoomWithAi)
+
+	const count = TeamPlayerLimit * RoomTeamLimit
+	for i := 0; i < count; i++ {
+		_ = qm.AddGroups(NewGroup(fmt.Sprintf("Group%d", i+1), []*PlayerMock{newPlayerWithMMR(fmt.Sprint(i), 1000)}))
+	}
+
+	qm.Pause()
+	assert.True(t, qm.Paused())
+	go qm.Match(10 * time.Millisecond)
+	defer func() { _, _ = qm.Stop() }()
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 0, len(roomChan))
+	assert.Equal(t, count, len(qm.NormalQueue.AllGroups()))
+
+	qm.Resume()
+	assert.False(t, qm.Paused())
+	assert.Eventually(t, func() bool { return len(roomChan) > 0 }, time.Second, 10*time.Millisecond)
+}
+
+func Test_Matcher_Groups(t *testing.T) {
+	errChan := make(chan error, 128)
+	roomChan := make(chan Room, 128)
+	qm, _ := NewMatcher(errChan, roomChan, GetQueueArgs, NewTeam, NewRoom, NewRoomWithAi)
+
+	// too few groups to match, they are taken out of the queues and added back every tick
+	for i := 0; i < 2; i++ {
+		_ = qm.AddGroups(NewGroup(fmt.Sprintf("Group%d", i+1), []*PlayerMock{newPlayerWithMMR(fmt.Sprint(i), 1000)}))
+	}
+	go qm.Match(time.Millisecond)
+	defer func() { _, _ = qm.Stop() }()
+
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, 2, len(qm.Groups()))
+	}
+
+	// the groups left the queues are not returned
+	qm.Groups()[0].SetState(GroupStateUnready)
+	assert.Equal(t, 1, len(qm.Groups()))
+}
@@ -0,0 +1,54 @@
+package glicko2
+
+import (
+	"time"
+)
+
+// Metrics records the statistics of a matcher, it should be safe for concurrent use.
+// It is injected by Matcher.SetMetrics, so that this package does not depend on any metrics library.
+type Metrics interface {
+	// SetQueueDepth records the number of the groups of the type waiting in the queue at the start of a tick.
+	SetQueueDepth(queue string, groupType GroupType, count int)
+
+	// ObserveTick records the duration of a tick, the rooms formed and the groups carried over to the next tick.
+	ObserveTick(duration time.Duration, rooms, carriedOver int)
+
+	// IncTimeout records a group removed from the queue for timeout.
+	IncTimeout(queue string)
+
+	// IncTeamToNormal records a group moved from the team queue to the normal queue.
+	IncTeamToNormal(groupType GroupType)
+
+	// ObserveMatchWait records the wait time of a group when it is matched into a room or a backfill.
+	ObserveMatchWait(queue string, waitSec int64)
+
+	// ObserveRoomSpread records the difference between the biggest and the smallest team MMR and star of a room.
+	ObserveRoomSpread(queue string, mmrSpread float64, starSpread int)
+}
+
+// nopMetrics is the default metrics which records nothing.
+type nopMetrics struct{}
+
+func (nopMetrics) SetQueueDepth(string, GroupType, int)   {}
+func (nopMetrics) ObserveTick(time.Duration, int, int)    {}
+func (nopMetrics) IncTimeout(string)                      {}
+func (nopMetrics) IncTeamToNormal(GroupType)              {}
+func (nopMetrics) ObserveMatchWait(string, int64)         {}
+func (nopMetrics) ObserveRoomSpread(string, float64, int) {}
+
+// recordRoom records the wait time of the groups and the spread of the room formed.
+func (q *Queue) recordRoom(room Room) {
+	now := q.nowUnixFunc()
+	teams := room.GetTeams()
+	minMMR, maxMMR := teams[0].GetMMR(), teams[0].GetMMR()
+	minStar, maxStar := teams[0].GetStar(), teams[0].GetStar()
+	for _, t := range teams {
+		minMMR, maxMMR = min(minMMR, t.GetMMR()), max(maxMMR, t.GetMMR())
+		minStar, maxStar = min(minStar, t.GetStar()), max(maxStar, t.GetStar())
+		for _, g := range t.GetGroups() {
+			q.metrics.ObserveMatchWait(q.Name, now-g.GetStartMatchTimeSec())
+		}
+	}
+	q.metrics.ObserveRoomSpread(q.Name, maxMMR-minMMR, maxStar-minStar)
+	q.roomsFormed++
+}
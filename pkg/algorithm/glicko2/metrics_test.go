@@ -0,0 +1,108 @@
+package glicko2
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type metricsMock struct {
+	sync.Mutex
+	depths      map[string][]int
+	rooms       int
+	carriedOver int
+	timeouts    int
+	moves       int
+	waits       []int64
+	mmrSpreads  []float64
+}
+
+func newMetricsMock() *metricsMock {
+	return &metricsMock{depths: make(map[string][]int)}
+}
+
+func (m *metricsMock) SetQueueDepth(queue string, groupType GroupType, count int) {
+	m.Lock()
+	defer m.Unlock()
+	key := queue + "/" + groupType.String()
+	m.depths[key] = append(m.depths[key], count)
+}
+
+func (m *metricsMock) ObserveTick(_ time.Duration, rooms, carriedOver int) {
+	m.Lock()
+	defer m.Unlock()
+	m.rooms += rooms
+	m.carriedOver = carriedOver
+}
+
+func (m *metricsMock) IncTimeout(string) {
+	m.Lock()
+	defer m.Unlock()
+	m.timeouts++
+}
+
+func (m *metricsMock) IncTeamToNormal(GroupType) {
+	m.Lock()
+	defer m.Unlock()
+	m.moves++
+}
+
+func (m *metricsMock) ObserveMatchWait(_ string, waitSec int64) {
+	m.Lock()
+	defer m.Unlock()
+	m.waits = append(m.waits, waitSec)
+}
+
+func (m *metricsMock) ObserveRoomSpread(_ string, mmrSpread float64, _ int) {
+	m.Lock()
+	defer m.Unlock()
+	m.mmrSpreads = append(m.mmrSpreads, mmrSpread)
+}
+
+func Test_Matcher_Metrics(t *testing.T) {
+	errChan := make(chan error, 128)
+	roomChan := make(chan Room, 128)
+	qm, _ := NewMatcher(errChan, roomChan, GetQueueArgs, NewTeam, NewRoom, NewRoomWithAi)
+	m := newMetricsMock()
+	qm.SetMetrics(m)
+
+	// one more group than a room needs, it is carried over to the next tick
+	const count = TeamPlayerLimit*RoomTeamLimit + 1
+	for i := 0; i < count; i++ {
+		_ = qm.AddGroups(NewGroup(fmt.Sprintf("Group%d", i+1), []*PlayerMock{newPlayerWithMMR(fmt.Sprint(i), 1000)}))
+	}
+	go qm.Match(10 * time.Millisecond)
+	defer func() { _, _ = qm.Stop() }()
+
+	assert.Eventually(t, func() bool {
+		m.Lock()
+		defer m.Unlock()
+		return m.rooms == 1
+	}, time.Second, 10*time.Millisecond)
+
+	m.Lock()
+	defer m.Unlock()
+	assert.Equal(t, count, m.depths[NormalQueue+"/not_team"][0])
+	assert.Equal(t, 0, m.depths[TeamQueue+"/normal_team"][0])
+	assert.Equal(t, 1, m.carriedOver)
+	assert.Equal(t, count-1, len(m.waits))
+	assert.Equal(t, []float64{0}, m.mmrSpreads)
+}
+
+func Test_Queue_Metrics_Timeout(t *testing.T) {
+	q := newQueue()
+	m := newMetricsMock()
+	q.metrics = m
+	q.MatchTimeoutSec = 10
+
+	g1 := newGroupWithMMR(1, 1, 1000)
+	g1.SetStartMatchTimeSec(-10)
+	g2 := newGroupWithMMR(2, 1, 1000)
+	assert.Nil(t, q.AddGroups(g1, g2))
+
+	assert.Equal(t, []Group{g2}, q.GetAndClearGroups())
+	assert.Equal(t, 1, m.timeouts)
+}
@@ -0,0 +1,77 @@
+package glicko2
+
+// NewcomerGroupRule decides whether a group of players with mixed experience is a newcomer group.
+type NewcomerGroupRule string
+
+const (
+	// NewcomerGroupAll treats the group as a newcomer group only if all the players are newcomers,
+	// it is the default rule.
+	NewcomerGroupAll NewcomerGroupRule = "all"
+	// NewcomerGroupAny treats the group as a newcomer group if any player is a newcomer.
+	NewcomerGroupAny NewcomerGroupRule = "any"
+	// NewcomerGroupMajority treats the group as a newcomer group if more than half of the players are newcomers.
+	NewcomerGroupMajority NewcomerGroupRule = "majority"
+)
+
+// NewcomerArgs is the newcomer definition of a game mode.
+// A player is a newcomer if any of the enabled conditions is met.
+type NewcomerArgs struct {
+	// Players who played fewer matches than this are newcomers, 0 means disabled
+	MaxMatchCount int64 `json:"max_match_count" yaml:"max_match_count"`
+	// Players whose account is younger than this are newcomers, 0 means disabled
+	MaxAccountAgeSec int64 `json:"max_account_age_sec" yaml:"max_account_age_sec"`
+	// Players whose rating deviation is not less than this are newcomers, 0 means disabled
+	MinRD float64 `json:"min_rd" yaml:"min_rd"`
+	// Rule to classify a group of players with mixed experience, empty means NewcomerGroupAll
+	GroupRule NewcomerGroupRule `json:"group_rule" yaml:"group_rule"`
+	// Newcomer groups which have waited this long can be matched with AI, 0 means never
+	AiFillAfterSec int64 `json:"ai_fill_after_sec" yaml:"ai_fill_after_sec"`
+}
+
+// IsNewcomer checks whether the player is a newcomer at now.
+func (a *NewcomerArgs) IsNewcomer(p Player, now int64) bool {
+	if a == nil {
+		return false
+	}
+	if a.MaxMatchCount > 0 && p.GetMatchCount() < a.MaxMatchCount {
+		return true
+	}
+	if a.MaxAccountAgeSec > 0 && p.GetAccountCreateTimeSec() > 0 &&
+		now-p.GetAccountCreateTimeSec() < a.MaxAccountAgeSec {
+		return true
+	}
+	if a.MinRD > 0 && p.GetRD() >= a.MinRD {
+		return true
+	}
+	return false
+}
+
+// IsNewcomerGroup checks whether the players form a newcomer group at now by GroupRule.
+func (a *NewcomerArgs) IsNewcomerGroup(players []Player, now int64) bool {
+	if a == nil || len(players) == 0 {
+		return false
+	}
+	count := 0
+	for _, p := range players {
+		if a.IsNewcomer(p, now) {
+			count++
+		}
+	}
+	switch a.GroupRule {
+	case NewcomerGroupAny:
+		return count > 0
+	case NewcomerGroupMajority:
+		return count*2 > len(players) //nolint:mnd
+	default:
+		return count == len(players)
+	}
+}
+
+// CanFillAi checks whether a group can be matched with AI,
+// only the newcomer groups which have waited AiFillAfterSec can.
+func (a *NewcomerArgs) CanFillAi(isNewer bool, waitSec int64) bool {
+	if a == nil || a.AiFillAfterSec <= 0 {
+		return false
+	}
+	return isNewer && waitSec >= a.AiFillAfterSec
+}
@@ -0,0 +1,91 @@
+package glicko2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newPlayerWithExperience(id string, matchCount, accountCreateTimeSec int64, rd float64) *PlayerMock {
+	p := NewPlayer(id, false, 0, Args{RD: rd})
+	p.MatchCount = matchCount
+	p.AccountCreateTimeSec = accountCreateTimeSec
+	return p
+}
+
+func TestNewcomerArgs_IsNewcomer(t *testing.T) {
+	now := time.Now().Unix()
+	veteran := newPlayerWithExperience("1", 100, now-86400*30, 50)
+
+	// 没有配置时，没有新手
+	var args *NewcomerArgs
+	assert.False(t, args.IsNewcomer(newPlayerWithExperience("2", 0, now, 350), now))
+
+	// 满足任意一个条件即为新手
+	args = &NewcomerArgs{MaxMatchCount: 10, MaxAccountAgeSec: 86400 * 7, MinRD: 300}
+	assert.False(t, args.IsNewcomer(veteran, now))
+	assert.True(t, args.IsNewcomer(newPlayerWithExperience("2", 5, now-86400*30, 50), now))
+	assert.True(t, args.IsNewcomer(newPlayerWithExperience("3", 100, now-86400, 50), now))
+	assert.True(t, args.IsNewcomer(newPlayerWithExperience("4", 100, now-86400*30, 350), now))
+	// 账号创建时间未知时，不按账号年龄判断
+	assert.False(t, args.IsNewcomer(newPlayerWithExperience("5", 100, 0, 50), now))
+}
+
+func TestNewcomerArgs_IsNewcomerGroup(t *testing.T) {
+	now := zeroNowTime()
+	args := &NewcomerArgs{MaxMatchCount: 10}
+	newer := func(id string) Player { return newPlayerWithExperience(id, 1, 0, 0) }
+	veteran := func(id string) Player { return newPlayerWithExperience(id, 100, 0, 0) }
+
+	assert.False(t, args.IsNewcomerGroup(nil, now))
+	assert.True(t, args.IsNewcomerGroup([]Player{newer("1"), newer("2")}, now))
+
+	// 默认所有玩家都是新手才是新手车队
+	mixed := []Player{newer("1"), newer("2"), veteran("3")}
+	assert.False(t, args.IsNewcomerGroup(mixed, now))
+
+	args.GroupRule = NewcomerGroupMajority
+	assert.True(t, args.IsNewcomerGroup(mixed, now))
+	assert.False(t, args.IsNewcomerGroup([]Player{newer("1"), veteran("2")}, now))
+
+	args.GroupRule = NewcomerGroupAny
+	assert.True(t, args.IsNewcomerGroup([]Player{newer("1"), veteran("2"), veteran("3")}, now))
+	assert.False(t, args.IsNewcomerGroup([]Player{veteran("1"), veteran("2")}, now))
+}
+
+func TestNewcomerArgs_CanFillAi(t *testing.T) {
+	var args *NewcomerArgs
+	assert.False(t, args.CanFillAi(true, 100))
+
+	args = &NewcomerArgs{}
+	assert.False(t, args.CanFillAi(true, 100))
+
+	args.AiFillAfterSec = 30
+	assert.False(t, args.CanFillAi(false, 100))
+	assert.False(t, args.CanFillAi(true, 29))
+	assert.True(t, args.CanFillAi(true, 30))
+}
+
+func TestQueue_fillTeamsWithAi(t *testing.T) {
+	q := newQueue()
+	now := q.nowUnixFunc()
+
+	// 等待超过 60s 的未满员阵营也可以和 AI 组成房间
+	g1 := newGroupWithMMR(1, 2, 100)
+	g1.SetStartMatchTimeSec(now - 61)
+	g2 := newGroupWithMMR(2, 2, 100)
+	g2.SetStartMatchTimeSec(now)
+	g3 := newGroupWithMMR(3, 5, 100)
+	g3.SetStartMatchTimeSec(now - 61)
+	t1, t2, t3 := NewTeam(g1), NewTeam(g2), NewTeam(g3)
+	q.TmpTeam = []Team{t1, t2}
+	q.FullTeam = []Team{t3}
+
+	q.fillTeamsWithAi()
+	assert.Equal(t, []Team{t2}, q.TmpTeam)
+	assert.Equal(t, 0, len(q.FullTeam))
+	for i := 0; i < 2; i++ {
+		assert.True(t, (<-q.roomChan).HasAi())
+	}
+}
@@ -25,4 +25,13 @@ type Player interface {
 
 	// Get the player's rank within their team after the match
 	GetRank() int
+
+	// Get the player's rating deviation
+	GetRD() float64
+
+	// Get the number of matches the player has played
+	GetMatchCount() int64
+
+	// Get the time the player's account was created, 0 means unknown
+	GetAccountCreateTimeSec() int64
 }
@@ -0,0 +1,100 @@
+package glicko2
+
+import (
+	"math"
+	"sort"
+)
+
+// WaitStats is the wait time percentiles of the groups in a queue.
+type WaitStats struct {
+	Count int   `json:"count"`
+	P50   int64 `json:"p50"`
+	P95   int64 `json:"p95"`
+	P99   int64 `json:"p99"`
+}
+
+// priority returns the priority score of the group to seed a team, the bigger the earlier.
+func (q *Queue) priority(g Group, now int64) float64 {
+	waitSec := now - g.GetStartMatchTimeSec()
+	weight := q.PriorityWaitWeight
+	if weight == 0 {
+		weight = 1
+	}
+	score := float64(waitSec) * weight
+	if q.PriorityBoostAfterSec > 0 && waitSec >= q.PriorityBoostAfterSec {
+		score += q.PriorityBoost
+	}
+	if g.IsPenalized(now) {
+		score -= q.PriorityPenalty
+	}
+	return score
+}
+
+// seedOrder returns the queuing groups in the order to seed teams.
+// The GuaranteedSeedCount oldest groups come first regardless of their score,
+// and then the others by the priority score.
+// If MaxSeedPerTick is set, only that many groups would be returned.
+func (q *Queue) seedOrder(groups []Group) []Group {
+	now := q.nowUnixFunc()
+	seeds := make([]Group, 0, len(groups))
+	scores := make(map[Group]float64, len(groups))
+	for _, g := range groups {
+		if g.GetState() == GroupStateQueuing {
+			seeds = append(seeds, g)
+			scores[g] = q.priority(g, now)
+		}
+	}
+
+	guaranteed := min(q.GuaranteedSeedCount, len(seeds))
+	if guaranteed > 0 {
+		sort.SliceStable(seeds, func(i, j int) bool {
+			return seeds[i].GetStartMatchTimeSec() < seeds[j].GetStartMatchTimeSec()
+		})
+	}
+	rest := seeds[guaranteed:]
+	sort.SliceStable(rest, func(i, j int) bool {
+		return scores[rest[i]] > scores[rest[j]]
+	})
+
+	if q.MaxSeedPerTick > 0 && len(seeds) > max(q.MaxSeedPerTick, guaranteed) {
+		seeds = seeds[:max(q.MaxSeedPerTick, guaranteed)]
+	}
+	return seeds
+}
+
+// recordWaitStats records the wait time percentiles of the groups matched in this tick.
+func (q *Queue) recordWaitStats(groups []Group) {
+	now := q.nowUnixFunc()
+	waits := make([]int64, len(groups))
+	for i, g := range groups {
+		waits[i] = now - g.GetStartMatchTimeSec()
+	}
+	sort.Slice(waits, func(i, j int) bool {
+		return waits[i] < waits[j]
+	})
+	q.waitStats = WaitStats{
+		Count: len(waits),
+		P50:   percentile(waits, 0.50), //nolint:mnd
+		P95:   percentile(waits, 0.95), //nolint:mnd
+		P99:   percentile(waits, 0.99), //nolint:mnd
+	}
+}
+
+// WaitStats returns the wait time percentiles of the groups in the last tick.
+func (q *Queue) WaitStats() WaitStats {
+	q.Lock()
+	defer q.Unlock()
+	return q.waitStats
+}
+
+// percentile returns the p-th percentile of sorted values by the nearest-rank method.
+func percentile(sorted []int64, p float64) int64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
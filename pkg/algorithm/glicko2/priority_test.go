@@ -0,0 +1,86 @@
+package glicko2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueue_seedOrder(t *testing.T) {
+	q := newQueue()
+	now := q.nowUnixFunc()
+
+	g1 := newGroupWithMMR(1, 1, 100)
+	g1.SetStartMatchTimeSec(now - 10)
+	g2 := newGroupWithMMR(2, 1, 200)
+	g2.SetStartMatchTimeSec(now - 30)
+	g3 := newGroupWithMMR(3, 1, 300)
+	g3.SetStartMatchTimeSec(now - 20)
+	g4 := newGroupWithMMR(4, 1, 400)
+	g4.SetStartMatchTimeSec(now - 40)
+	g4.(*GroupMock).PenaltyUntilSec = now + 60
+	g5 := newGroupWithMMR(5, 1, 500)
+	g5.SetState(GroupStateUnready)
+	groups := []Group{g1, g2, g3, g4, g5}
+
+	// 默认按等待时长排序，不在匹配中的 group 不参与
+	assert.Equal(t, []Group{g4, g2, g3, g1}, q.seedOrder(groups))
+
+	// 被惩罚的 group 优先级降低
+	q.PriorityPenalty = 100
+	assert.Equal(t, []Group{g2, g3, g1, g4}, q.seedOrder(groups))
+	// 按队列时钟判断惩罚是否已过期
+	q.nowUnixFunc = func() int64 { return now + 60 }
+	assert.Equal(t, []Group{g4, g2, g3, g1}, q.seedOrder(groups))
+	q.nowUnixFunc = func() int64 { return now }
+
+	// 等待超过一定时长的 group 获得额外加成
+	q.PriorityBoostAfterSec = 15
+	q.PriorityBoost = 15
+	q.PriorityWaitWeight = 0.5
+	assert.Equal(t, []Group{g2, g3, g1, g4}, q.seedOrder(groups))
+	q.PriorityBoostAfterSec = 10
+	q.PriorityBoost = 30
+	assert.Equal(t, []Group{g2, g3, g1, g4}, q.seedOrder(groups))
+
+	// 最老的 group 每轮都保证有机会作为种子
+	q.MaxSeedPerTick = 2
+	assert.Equal(t, []Group{g2, g3}, q.seedOrder(groups))
+	q.GuaranteedSeedCount = 1
+	assert.Equal(t, []Group{g4, g2}, q.seedOrder(groups))
+}
+
+func TestQueue_buildNewTeams_priority(t *testing.T) {
+	q := newQueue()
+	now := q.nowUnixFunc()
+
+	// g1 和 g2 都可以与 g3 组队，等待时间更长的 g2 先作为种子组队
+	g1 := newGroupWithMMR(1, 3, 100)
+	g2 := newGroupWithMMR(2, 3, 104)
+	g2.SetStartMatchTimeSec(now - 10)
+	g3 := newGroupWithMMR(3, 2, 102)
+	groups := []Group{g1, g3, g2}
+	rest := q.buildNewTeams(groups)
+	assert.Equal(t, 0, len(rest))
+	assert.Equal(t, 1, len(q.FullTeam))
+	assert.Equal(t, []Group{g2, g3}, q.FullTeam[0].GetGroups())
+	assert.Equal(t, 1, len(q.TmpTeam))
+	assert.Equal(t, []Group{g1}, q.TmpTeam[0].GetGroups())
+}
+
+func TestQueue_WaitStats(t *testing.T) {
+	q := newQueue()
+	assert.Equal(t, WaitStats{}, q.WaitStats())
+
+	now := q.nowUnixFunc()
+	groups := make([]Group, 100)
+	for i := range groups {
+		groups[i] = newGroupWithMMR(i+1, 1, 100)
+		groups[i].SetStartMatchTimeSec(now - int64(i+1))
+	}
+	q.recordWaitStats(groups)
+	assert.Equal(t, WaitStats{Count: 100, P50: 50, P95: 95, P99: 99}, q.WaitStats())
+
+	q.recordWaitStats(groups[:1])
+	assert.Equal(t, WaitStats{Count: 1, P50: 1, P95: 1, P99: 1}, q.WaitStats())
+}
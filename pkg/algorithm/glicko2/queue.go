@@ -5,6 +5,8 @@ import (
 	"math"
 	"sort"
 	"sync"
+
+	"github.com/rs/zerolog/log"
 )
 
 const (
@@ -24,6 +26,9 @@ const (
 	// and when the quantity is large, use binary search to find the local optimal solution.
 	useBinarySearchThreshold = 1000
 
+	// Default range of local linear search around the point located by binary search
+	defaultSearchRange = 10
+
 	CancelMatchByServerStop = "Failed to match. Please try again later"
 	CancelMatchByTimeout    = "No team found, please try again later"
 )
@@ -49,6 +54,11 @@ type Queue struct {
 	newRoomWithAi func(team Team) Room   // Method to create a new room with AI
 	nowUnixFunc   func() int64           // Function to return the current timestamp
 	matchTurn     int                    // Match turn, modulus 5, used to periodically refresh the configuration
+	waitStats     WaitStats              // Wait time percentiles of the groups in the last tick
+	backfills     []*Backfill            // Open backfill requests, called only with the lock held
+	backfillChan  chan BackfillResult    // Filled backfill requests are sent to this channel, nil means disabled
+	metrics       Metrics                // Records the statistics of the queue
+	roomsFormed   int                    // Number of rooms formed in the current tick, called only in Match
 	*QueueArgs                           // Queue parameters
 	getQueueArgs  func() *QueueArgs      // Method to get queue parameters, used to periodically refresh the configuration
 }
@@ -61,6 +71,8 @@ type QueueArgs struct {
 
 	// Whether beginners can only match with beginners
 	NewerWithNewer bool `json:"newer_with_newer" yaml:"newer_with_newer"`
+	// Newcomer definition, nil means there is no newcomer
+	Newcomer *NewcomerArgs `json:"newcomer" yaml:"newcomer"`
 
 	// Minimum MMR variance for unfriendly teams
 	UnfriendlyTeamMMRVarianceMin int `json:"unfriendly_team_mmr_variance_min" yaml:"unfriendly_team_mmr_variance_min"`
@@ -77,6 +89,35 @@ type QueueArgs struct {
 
 	// Match range strategies
 	MatchRanges []MatchRange `json:"match_ranges" yaml:"match_ranges"`
+
+	// Expansion curves of the MMR gap percent and the star gap, evaluated per group by its own wait time.
+	// nil or CurveStep means using MMRGapPercent and StarGap in MatchRanges.
+	MMRGapCurve  *ExpansionCurve `json:"mmr_gap_curve" yaml:"mmr_gap_curve"`
+	StarGapCurve *ExpansionCurve `json:"star_gap_curve" yaml:"star_gap_curve"`
+
+	// Whether to redistribute groups across the teams of a room to minimize the team MMR spread
+	BalanceRoomTeams bool `json:"balance_room_teams" yaml:"balance_room_teams"`
+	// Max swap attempts when balancing the teams of a room, 0 means using the default budget
+	BalanceSwapBudget int `json:"balance_swap_budget" yaml:"balance_swap_budget"`
+
+	// Solver used to form teams and rooms, empty means SolverGreedy
+	Solver Solver `json:"solver" yaml:"solver"`
+	// Range of local linear search around the point located by binary search, 0 means using the default range
+	SearchRange int `json:"search_range" yaml:"search_range"`
+	// Max search nodes of SolverOptimize when looking for teammates for a group, 0 means using the default budget
+	OptimizeSearchBudget int `json:"optimize_search_budget" yaml:"optimize_search_budget"`
+
+	// Priority score of seeding teams: wait seconds * PriorityWaitWeight
+	// + PriorityBoost (if waited PriorityBoostAfterSec) - PriorityPenalty (if penalized).
+	// PriorityWaitWeight 0 means 1.
+	PriorityWaitWeight    float64 `json:"priority_wait_weight" yaml:"priority_wait_weight"`
+	PriorityBoost         float64 `json:"priority_boost" yaml:"priority_boost"`
+	PriorityBoostAfterSec int64   `json:"priority_boost_after_sec" yaml:"priority_boost_after_sec"`
+	PriorityPenalty       float64 `json:"priority_penalty" yaml:"priority_penalty"`
+	// Number of the oldest groups guaranteed a seeding attempt each tick regardless of their score
+	GuaranteedSeedCount int `json:"guaranteed_seed_count" yaml:"guaranteed_seed_count"`
+	// Max seeding attempts each tick, 0 means no limit
+	MaxSeedPerTick int `json:"max_seed_per_tick" yaml:"max_seed_per_tick"`
 }
 
 type MatchRange struct {
@@ -88,6 +129,10 @@ type MatchRange struct {
 	CanJoinTeam bool `json:"can_join_team" yaml:"can_join_team"`
 	// Allowed rank difference (inclusive), 0 means no restriction
 	StarGap int `json:"star_gap" yaml:"star_gap"`
+	// Allowed ping(ms) to the shared region (inclusive), 0 means no restriction
+	MaxPingMs int64 `json:"max_ping_ms" yaml:"max_ping_ms"`
+	// Allowed difference of the biggest party size between teams in a room (inclusive), 0 means no restriction
+	PremadeSizeGap int `json:"premade_size_gap" yaml:"premade_size_gap"`
 }
 
 var defaultMatchRange = MatchRange{
@@ -126,6 +171,7 @@ func NewQueue(
 		getQueueArgs:  getQueueArgs,
 		QueueArgs:     args,
 		nowUnixFunc:   nowUnixFunc,
+		metrics:       nopMetrics{},
 	}, nil
 }
 
@@ -186,6 +232,7 @@ func (q *Queue) GetAndClearGroups() []Group {
 				go func() {
 					tmpG.ForceCancelMatch(CancelMatchByTimeout, waitSec)
 				}()
+				q.metrics.IncTimeout(q.Name)
 				continue
 			}
 			res = append(res, g)
@@ -237,16 +284,28 @@ func (q *Queue) clearTmp() []Group {
 func (q *Queue) Match(groups []Group) []Group {
 	q.Lock()
 	defer q.Unlock()
+	q.roomsFormed = 0
+	q.recordWaitStats(groups)
 	// Sort groups for later binary search to improve efficiency
 	sortGroupsByMMR(groups)
+	// Fill the open slots of in-progress rooms first
+	groups = q.fillBackfills(groups)
 	// Build new teams
-	groups = q.buildNewTeams(groups)
+	if q.Solver == SolverOptimize {
+		groups = q.buildNewTeamsOptimize(groups)
+	} else {
+		groups = q.buildNewTeams(groups)
+	}
 	// Prioritize filling AI
 	q.fillTeamsWithAi()
 	// Sort teams by MMR
 	q.sortFullTeamsByMMR()
 	// Create new rooms
-	q.buildNewRooms()
+	if q.Solver == SolverOptimize {
+		q.buildNewRoomsOptimize()
+	} else {
+		q.buildNewRooms()
+	}
 	// Shuffle and reset every turn, refresh QueueArgs every refreshTurn
 	q.refreshMatchTurn()
 	// Clear temporary data, not keeping temporary data is to prevent groups from canceling matches in later turns
@@ -256,21 +315,16 @@ func (q *Queue) Match(groups []Group) []Group {
 }
 
 func (q *Queue) buildNewTeams(groups []Group) []Group {
-	// Build new teams
-	tryTeamCounts := len(groups)
+	// Build new teams, seeded by priority
 	var found bool
-	for i := 0; len(groups) > 0 && i < tryTeamCounts; i++ {
-		var team Team
-		for gPos, g := range groups {
-			if g.GetState() == GroupStateQueuing {
-				team = q.newTeam(g)
-				groups = append(groups[:gPos], groups[gPos+1:]...)
-				break
-			}
-		}
-		if team == nil {
-			break
+	for _, seed := range q.seedOrder(groups) {
+		gPos := indexOfGroup(groups, seed)
+		if gPos == -1 {
+			// Already joined a team
+			continue
 		}
+		team := q.newTeam(seed)
+		groups = append(groups[:gPos], groups[gPos+1:]...)
 		for team.PlayerCount() < q.TeamPlayerLimit {
 			groups, found = q.findGroupForTeam(team, groups)
 			if !found {
@@ -286,6 +340,19 @@ func (q *Queue) buildNewTeams(groups []Group) []Group {
 	return groups
 }
 
+// indexOfGroup returns the index of g in groups sorted by MMR, -1 if not found
+func indexOfGroup(groups []Group, g Group) int {
+	mmr := g.GetMMR()
+	for i := sort.Search(len(groups), func(i int) bool {
+		return groups[i].GetMMR() >= mmr
+	}); i < len(groups) && groups[i].GetMMR() == mmr; i++ {
+		if groups[i] == g {
+			return i
+		}
+	}
+	return -1
+}
+
 func (q *Queue) buildNewRooms() {
 	tryRoomTimes := len(q.FullTeam)
 	for l := 0; len(q.FullTeam) > 0 && l < tryRoomTimes; l++ {
@@ -297,6 +364,7 @@ func (q *Queue) buildNewRooms() {
 			}
 		}
 		if len(room.GetTeams()) >= q.RoomTeamLimit {
+			room.SetMMRSpread(q.balanceRoomTeams(room))
 			q.roomMatchSuccess(room)
 			continue
 		}
@@ -304,28 +372,50 @@ func (q *Queue) buildNewRooms() {
 	}
 }
 
-// fillTeamsWithAi fills AI
+// fillTeamsWithAi fills AI, both the full teams and the unfilled ones which can be filled with AI
+// are matched with AI into new rooms
 func (q *Queue) fillTeamsWithAi() {
-	newFullTeam := make([]Team, 0, len(q.FullTeam))
-	for _, team := range q.FullTeam {
-		if team.CanFillAi() {
+	q.FullTeam = q.fillWithAi(q.FullTeam)
+	q.TmpTeam = q.fillWithAi(q.TmpTeam)
+}
+
+// fillWithAi matches the teams which can be filled with AI and returns the rest
+func (q *Queue) fillWithAi(teams []Team) []Team {
+	rest := make([]Team, 0, len(teams))
+	now := q.nowUnixFunc()
+	for _, team := range teams {
+		if team.CanFillAi(now) {
 			newRoom := q.newRoomWithAi(team)
 			q.roomMatchSuccess(newRoom)
 			continue
 		}
-		newFullTeam = append(newFullTeam, team)
+		rest = append(rest, team)
 	}
-	q.FullTeam = newFullTeam
+	return rest
 }
 
 func (q *Queue) refreshMatchTurn() {
 	q.matchTurn = (q.matchTurn + 1) % refreshTurn
 	if q.matchTurn == 0 && q.getQueueArgs != nil {
 		newQueueArgs := q.getQueueArgs()
-		if newQueueArgs != nil {
-			q.QueueArgs = newQueueArgs
+		if newQueueArgs == nil {
+			return
+		}
+		// keep matching with the current args if the new ones are invalid
+		if err := newQueueArgs.Validate(); err != nil {
+			log.Error().Str("queue", q.Name).Err(err).Msg("invalid queue args, keep the current ones")
+			return
 		}
+		q.QueueArgs = newQueueArgs
+	}
+}
+
+// searchRange returns the range of local linear search around the point located by binary search
+func (q *Queue) searchRange() int {
+	if q.SearchRange <= 0 {
+		return defaultSearchRange
 	}
+	return q.SearchRange
 }
 
 // sortGroupsByMMR sorts groups by MMR
@@ -446,7 +536,7 @@ func (q *Queue) findGroupForTeamBinary(team Team, groups []Group) ([]Group, bool
 	}
 
 	// Local linear search to confirm if there is a closer match
-	searchRange := 10 // Adjust this range based on actual conditions
+	searchRange := q.searchRange()
 	start := int(math.Max(0, float64(closestIndex-searchRange)))
 	end := int(math.Min(float64(len(groups)-1), float64(closestIndex+searchRange)))
 	for i := start; i <= end; i++ {
@@ -588,7 +678,7 @@ func (q *Queue) findTeamForRoomBinary(room Room) bool {
 	}
 
 	// Local linear search to confirm if there is a closer match
-	searchRange := 10 // Adjust this range based on actual conditions
+	searchRange := q.searchRange()
 	start := int(math.Max(0, float64(closestIndex-searchRange)))
 	end := int(math.Min(float64(len(q.FullTeam)-1), float64(closestIndex+searchRange)))
 
@@ -612,49 +702,61 @@ func (q *Queue) findTeamForRoomBinary(room Room) bool {
 
 // canGroupTogether determines whether groups can form a team
 func (q *Queue) canGroupTogether(team Team, group Group) bool {
+	return q.canGroupJoin(team.GetGroups(), group)
+}
+
+// canGroupJoin determines whether the group can form a team with the groups
+func (q *Queue) canGroupJoin(groups []Group, group Group) bool {
 	ngMMR := group.GetMMR()
 	ngStar := group.GetStar()
-	ngCanFillAi := group.CanFillAi()
-	ngIsNewer := group.IsNewer()
+	now := q.nowUnixFunc()
+	ngCanFillAi := group.CanFillAi(now)
+	ngIsNewer := group.IsNewer(now)
 
-	for _, g := range team.GetGroups() {
+	for _, g := range groups {
 		// Prioritize matching groups that can fill AI together
-		if g.CanFillAi() != ngCanFillAi {
+		if g.CanFillAi(now) != ngCanFillAi {
 			return false
 		}
 
-		if q.NewerWithNewer && (ngIsNewer != g.IsNewer()) {
+		if q.NewerWithNewer && (ngIsNewer != g.IsNewer(now)) {
 			return false
 		}
 
-		// Get match range configuration
-		mr := q.getMatchRange(g.GetStartMatchTimeSec(), group.GetStartMatchTimeSec())
-
 		// Check if MMR matches
-		gMMR := g.GetMMR()
-		if mr.MMRGapPercent != 0 && math.Abs(gMMR-ngMMR) > gMMR*float64(mr.MMRGapPercent)/100 {
+		if !q.mmrMatched(g.GetMMR(), g.GetStartMatchTimeSec(), ngMMR, group.GetStartMatchTimeSec()) {
 			return false
 		}
 
 		// Check if rank matches
-		if mr.StarGap != 0 && int(math.Abs(float64(g.GetStar()-ngStar))) > mr.StarGap {
+		if !q.starMatched(g.GetStar(), g.GetStartMatchTimeSec(), ngStar, group.GetStartMatchTimeSec()) {
 			return false
 		}
 	}
-	return true
+
+	// Check if all groups share an acceptable region
+	mr := q.getMatchRange(earliestStartMatchTimeSec(groups), group.GetStartMatchTimeSec())
+	return shareRegion(append(groups[:len(groups):len(groups)], group), mr.MaxPingMs)
 }
 
 // canTeamTogether determines whether teams can form a room
 func (q *Queue) canTeamTogether(room Room, tt Team) bool {
+	return q.canTeamJoin(room.GetTeams(), tt)
+}
+
+// canTeamJoin determines whether the team can form a room with the teams
+func (q *Queue) canTeamJoin(teams []Team, tt Team) bool {
 	ttMMR := tt.GetMMR()
 	ttStar := tt.GetStar()
-	ttNewer := tt.IsNewer()
+	now := q.nowUnixFunc()
+	ttNewer := tt.IsNewer(now)
 	// Check if tt meets the matching conditions with all teams in the current room
 	// If any condition is not met, return false
-	for _, t := range room.GetTeams() {
+	groups := make([]Group, 0, len(teams))
+	for _, t := range teams {
 		mr := q.getMatchRange(t.GetStartMatchTimeSec(), tt.GetStartMatchTimeSec())
 
-		if q.NewerWithNewer && (ttNewer != t.IsNewer()) {
+		if q.NewerWithNewer && (ttNewer != t.IsNewer(now)) {
 			return false
 		}
 
@@ -663,30 +765,60 @@ func (q *Queue) canTeamTogether(room Room, tt Team) bool {
 			return false
 		}
 
+		// Check if premade parties are of similar size
+		if mr.PremadeSizeGap != 0 && int(math.Abs(float64(premadeSize(t.GetGroups())-premadeSize(tt.GetGroups())))) > mr.PremadeSizeGap {
+			return false
+		}
+
 		// Check if MMR matches
-		tMMR := t.GetMMR()
-		if mr.MMRGapPercent != 0 && math.Abs(tMMR-ttMMR) > tMMR*float64(mr.MMRGapPercent)/float64(100) {
+		if !q.mmrMatched(t.GetMMR(), t.GetStartMatchTimeSec(), ttMMR, tt.GetStartMatchTimeSec()) {
 			return false
 		}
 
 		// Check if rank matches
-		if mr.StarGap != 0 && int(math.Abs(float64(t.GetStar()-ttStar))) > mr.StarGap {
+		if !q.starMatched(t.GetStar(), t.GetStartMatchTimeSec(), ttStar, tt.GetStartMatchTimeSec()) {
 			return false
 		}
+		groups = append(groups, t.GetGroups()...)
 	}
-	return true
+
+	// Check if all groups share an acceptable region
+	mr := q.getMatchRange(earliestStartMatchTimeSec(groups), tt.GetStartMatchTimeSec())
+	return shareRegion(append(groups, tt.GetGroups()...), mr.MaxPingMs)
+}
+
+// premadeSize returns the player count of the biggest group of a team
+func premadeSize(groups []Group) int {
+	res := 0
+	for _, g := range groups {
+		res = max(res, g.PlayerCount())
+	}
+	return res
 }
 
-// getMatchRange gets the match range
+// earliestStartMatchTimeSec returns the earliest start match time of the groups, 0 if groups is empty
+func earliestStartMatchTimeSec(groups []Group) int64 {
+	var res int64
+	for i, g := range groups {
+		if i == 0 || g.GetStartMatchTimeSec() < res {
+			res = g.GetStartMatchTimeSec()
+		}
+	}
+	return res
+}
+
+// getMatchRange gets the match range of two sides, using the shorter match duration as the standard
 func (q *Queue) getMatchRange(mst1, mst2 int64) MatchRange {
 	now := q.nowUnixFunc()
+	return q.matchRangeOf(int64(math.Min(float64(now-mst1), float64(now-mst2))))
+}
 
+// matchRangeOf gets the match range of the match duration
+func (q *Queue) matchRangeOf(mt int64) MatchRange {
 	if len(q.MatchRanges) == 0 {
 		return defaultMatchRange
 	}
 
-	// Use the shorter match duration as the standard
-	mt := int64(math.Min(float64(now-mst1), float64(now-mst2)))
 	for _, mr := range q.MatchRanges {
 		if mt < mr.MaxMatchSec {
 			return mr
@@ -724,6 +856,7 @@ func (q *Queue) StopMatch() []Group {
 
 // roomMatchSuccess indicates a successful room match
 func (q *Queue) roomMatchSuccess(room Room) {
+	q.recordRoom(room)
 	go func() {
 		q.setRoomReady(room)
 		q.roomChan <- room
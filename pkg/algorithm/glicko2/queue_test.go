@@ -3,6 +3,7 @@ package glicko2
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"sync"
@@ -487,6 +488,201 @@ func TestQueue_findTeamForRoom(t *testing.T) {
 	assert.Equal(t, 3, len(r3.teams))
 }
 
+func TestQueue_canGroupTogether_region(t *testing.T) {
+	q := newQueue()
+	q.QueueArgs = &QueueArgs{
+		TeamPlayerLimit: TeamPlayerLimit,
+		RoomTeamLimit:   RoomTeamLimit,
+		MatchRanges: []MatchRange{
+			{MaxMatchSec: 15, MaxPingMs: 50},
+			{MaxMatchSec: 30, MaxPingMs: 150},
+		},
+	}
+
+	g1 := newGroupWithMMR(1, 2, 100).(*GroupMock)
+	g1.RegionPings = map[string]int64{"sh": 20, "sg": 120}
+	g2 := newGroupWithMMR(2, 2, 100).(*GroupMock)
+	g2.RegionPings = map[string]int64{"sh": 140, "sg": 30}
+	g3 := newGroupWithMMR(3, 1, 100).(*GroupMock)
+
+	// 没有上报延迟的 group 可以和任意 group 组队
+	assert.True(t, q.canGroupTogether(NewTeam(g1), g3))
+	// 刚开始匹配，没有共同的可接受区域
+	assert.False(t, q.canGroupTogether(NewTeam(g1), g2))
+	// 随着匹配时间增长，逐渐放开延迟限制
+	g1.SetStartMatchTimeSec(q.nowUnixFunc() - 20)
+	g2.SetStartMatchTimeSec(q.nowUnixFunc() - 20)
+	assert.True(t, q.canGroupTogether(NewTeam(g1), g2))
+
+	// room 中的所有 group 都需要有共同的可接受区域
+	g4 := newGroupWithMMR(4, 5, 100).(*GroupMock)
+	g4.RegionPings = map[string]int64{"sh": 10}
+	g5 := newGroupWithMMR(5, 5, 100).(*GroupMock)
+	g5.RegionPings = map[string]int64{"sg": 10}
+	g6 := newGroupWithMMR(6, 5, 100).(*GroupMock)
+	g6.RegionPings = map[string]int64{"sh": 40, "sg": 10}
+	r := NewRoom(NewTeam(g4))
+	assert.False(t, q.canTeamTogether(r, NewTeam(g5)))
+	assert.True(t, q.canTeamTogether(r, NewTeam(g6)))
+	assert.Equal(t, "sh", ChooseRegion(append(RoomGroups(r), g6)))
+}
+
+func TestMergeRegionPings(t *testing.T) {
+	assert.Nil(t, MergeRegionPings())
+	assert.Nil(t, MergeRegionPings(nil, map[string]int64{}))
+	assert.Equal(t, map[string]int64{"sh": 30}, MergeRegionPings(
+		map[string]int64{"sh": 30, "sg": 80},
+		nil,
+		map[string]int64{"sh": 10, "hk": 20},
+	))
+
+	// 最低延迟的区域总是可以接受的
+	assert.Equal(t, map[string]struct{}{"sg": {}}, acceptableRegions(map[string]int64{"sh": 300, "sg": 200}, 50))
+	assert.Equal(t, map[string]struct{}{"sh": {}, "sg": {}}, acceptableRegions(map[string]int64{"sh": 300, "sg": 200}, 0))
+	assert.Nil(t, acceptableRegions(nil, 50))
+	assert.Equal(t, "", ChooseRegion([]Group{newGroupWithMMR(1, 1, 100)}))
+}
+
+func TestQueue_balanceRoomTeams(t *testing.T) {
+	q := newQueue()
+	q.QueueArgs = GetQueueArgs()
+	q.RoomTeamLimit = 2
+
+	newTeamWithMMRs := func(startID int, mmrs ...float64) Team {
+		team := NewTeam(newGroupWithMMR(startID, 1, mmrs[0]))
+		for i, mmr := range mmrs[1:] {
+			team.AddGroup(newGroupWithMMR(startID+i+1, 1, mmr))
+		}
+		return team
+	}
+	teamMMR := func(team Team) float64 {
+		total := 0.0
+		for _, g := range team.GetGroups() {
+			total += g.GetMMR() * float64(g.PlayerCount())
+		}
+		return total / float64(team.PlayerCount())
+	}
+
+	// 1. 没有开启平衡，只上报 mmr 差值
+	r := NewRoom(newTeamWithMMRs(1, 100, 200, 300, 400, 500))
+	r.AddTeam(newTeamWithMMRs(6, 600, 700, 800, 900, 1000))
+	assert.Equal(t, 500.0, q.balanceRoomTeams(r))
+	assert.Equal(t, 300.0, teamMMR(r.GetTeams()[0]))
+
+	// 2. 开启平衡后，重新分配 group，使得阵营 mmr 尽可能接近
+	// 匹配 90 秒后不再限制 mmr 差值，且可以加入车队
+	q.nowUnixFunc = func() int64 { return 90 }
+	q.BalanceRoomTeams = true
+	spread := q.balanceRoomTeams(r)
+	assert.Equal(t, 20.0, spread)
+	assert.Equal(t, 5, r.GetTeams()[0].PlayerCount())
+	assert.Equal(t, 5, r.GetTeams()[1].PlayerCount())
+	assert.InDelta(t, spread, math.Abs(teamMMR(r.GetTeams()[0])-teamMMR(r.GetTeams()[1])), 0.0001)
+
+	// 3. group 不会被拆散
+	g1 := newGroupWithMMR(11, 3, 100)
+	g2 := newGroupWithMMR(12, 3, 1000)
+	t1 := NewTeam(g1)
+	t1.AddGroup(newGroupWithMMR(13, 2, 1000))
+	t2 := NewTeam(g2)
+	t2.AddGroup(newGroupWithMMR(14, 2, 100))
+	r = NewRoom(t1)
+	r.AddTeam(t2)
+	assert.Equal(t, 180.0, q.balanceRoomTeams(r))
+	for _, team := range r.GetTeams() {
+		assert.Equal(t, 2, len(team.GetGroups()))
+		assert.Equal(t, 5, team.PlayerCount())
+	}
+
+	// 4. 移动 group 后队友无法组队的话，不进行平衡
+	newStarTeam := func(startID int, star int, mmrs ...float64) Team {
+		team := newTeamWithMMRs(startID, mmrs...)
+		for _, g := range team.GetGroups() {
+			for _, p := range g.(*GroupMock).Players {
+				p.star = star
+			}
+		}
+		return team
+	}
+	r = NewRoom(newStarTeam(31, 10, 100, 200))
+	r.AddTeam(newStarTeam(33, 20, 900, 1000))
+	q.StarGapCurve = &ExpansionCurve{Type: CurvePiecewise, Points: []CurvePoint{{WaitSec: 0, Value: 2}}}
+	assert.Equal(t, 800.0, q.balanceRoomTeams(r))
+	assert.Equal(t, 150.0, teamMMR(r.GetTeams()[0]))
+	q.StarGapCurve = nil
+	assert.Equal(t, 0.0, q.balanceRoomTeams(r))
+
+	// 新手只能和新手组队
+	r = NewRoom(newTeamWithMMRs(35, 100, 200))
+	r.AddTeam(newTeamWithMMRs(37, 900, 1000))
+	for _, g := range r.GetTeams()[0].GetGroups() {
+		g.(*GroupMock).Newer = true
+	}
+	q.NewerWithNewer = true
+	assert.Equal(t, 800.0, q.balanceRoomTeams(r))
+	assert.Equal(t, 150.0, teamMMR(r.GetTeams()[0]))
+	q.NewerWithNewer = false
+
+	// 5. 组成房间时上报 mmr 差值
+	q.FullTeam = append(q.FullTeam, newTeamWithMMRs(21, 100, 100, 100, 100, 150),
+		newTeamWithMMRs(26, 100, 100, 100, 100, 100))
+	q.buildNewRooms()
+	room := <-q.roomChan
+	assert.Equal(t, 10.0, room.(*RoomMock).MMRSpread)
+}
+
+func TestClassifyGroup(t *testing.T) {
+	args := &QueueArgs{
+		UnfriendlyTeamMMRVarianceMin: UnfriendlyTeamVarianceMin,
+		MaliciousTeamMMRVarianceMin:  MaliciousTeamVarianceMin,
+	}
+	newPlayers := func(mmrs ...float64) []Player {
+		res := make([]Player, len(mmrs))
+		for i, mmr := range mmrs {
+			res[i] = newPlayerWithMMR(cast.ToString(i), mmr)
+		}
+		return res
+	}
+
+	// 单人不是车队
+	assert.Equal(t, GroupTypeNotTeam, ClassifyGroup(newPlayers(100), args))
+	assert.Equal(t, GroupTypeNotTeam, ClassifyGroup(nil, args))
+	// 没有配置时，都是普通车队
+	assert.Equal(t, GroupTypeNormalTeam, ClassifyGroup(newPlayers(100, 10000), nil))
+	// 根据 mmr 方差区分车队类型
+	assert.Equal(t, GroupTypeNormalTeam, ClassifyGroup(newPlayers(100, 120), args))
+	assert.Equal(t, GroupTypeUnfriendlyTeam, ClassifyGroup(newPlayers(100, 200), args))
+	assert.Equal(t, GroupTypeMaliciousTeam, ClassifyGroup(newPlayers(100, 1000), args))
+	// 配置为 0 表示不启用
+	args.MaliciousTeamMMRVarianceMin = 0
+	assert.Equal(t, GroupTypeUnfriendlyTeam, ClassifyGroup(newPlayers(100, 1000), args))
+}
+
+func TestQueue_canTeamTogether_premadeSize(t *testing.T) {
+	q := newQueue()
+	q.QueueArgs = GetQueueArgs()
+	q.MatchRanges[0].PremadeSizeGap = 1
+	q.MatchRanges[1].PremadeSizeGap = 3
+
+	t1 := NewTeam(newGroupWithMMR(1, 5, 100))
+	t2 := NewTeam(newGroupWithMMR(2, 4, 100))
+	t2.AddGroup(newGroupWithMMR(3, 1, 100))
+	t3 := NewTeam(newGroupWithMMR(4, 2, 100))
+	t3.AddGroup(newGroupWithMMR(5, 2, 100))
+	t3.AddGroup(newGroupWithMMR(6, 1, 100))
+
+	// 车队优先匹配规模相近的车队
+	r := NewRoom(t1)
+	assert.True(t, q.canTeamTogether(r, t2))
+	assert.False(t, q.canTeamTogether(r, t3))
+
+	// 随着匹配时间增长，逐渐放开限制
+	for _, team := range []Team{t1, t3} {
+		team.(*TeamMock).StartMatchTimeSec = q.nowUnixFunc() - 20
+	}
+	assert.True(t, q.canTeamTogether(r, t3))
+}
+
 // ==================================================
 //                  下面的接口的 native
 // ==================================================
@@ -551,6 +747,9 @@ type PlayerMock struct {
 	rank int
 	star int
 
+	MatchCount           int64 `json:"-"`
+	AccountCreateTimeSec int64 `json:"-"`
+
 	startMatchTime  int64
 	finishMatchTime int64
 
@@ -661,6 +860,20 @@ func (p *PlayerMock) SetRank(rank int) {
 	p.rank = rank
 }
 
+func (p *PlayerMock) GetRD() float64 {
+	p.RLock()
+	defer p.RUnlock()
+	return p.RD
+}
+
+func (p *PlayerMock) GetMatchCount() int64 {
+	return p.MatchCount
+}
+
+func (p *PlayerMock) GetAccountCreateTimeSec() int64 {
+	return p.AccountCreateTimeSec
+}
+
 const (
 	// 车队方差阈值
 	MaliciousTeamVarianceMin  = 100000
@@ -679,10 +892,22 @@ type GroupMock struct {
 	platform    int
 
 	startMatchTimeSec int64
+
+	RegionPings     map[string]int64 `json:"region_pings"`
+	PenaltyUntilSec int64            `json:"penalty_until_sec"`
+	Newer           bool             `json:"newer"`
 }
 
-func (g *GroupMock) IsNewer() bool {
-	return false
+func (g *GroupMock) IsNewer(int64) bool {
+	return g.Newer
+}
+
+func (g *GroupMock) IsPenalized(now int64) bool {
+	return g.PenaltyUntilSec > now
+}
+
+func (g *GroupMock) GetRegionPings() map[string]int64 {
+	return g.RegionPings
 }
 
 func NewGroup(id string, players []*PlayerMock) *GroupMock {
@@ -854,8 +1079,7 @@ func (g *GroupMock) Type() GroupType {
 	}
 }
 
-func (g *GroupMock) CanFillAi() bool {
-	now := zeroNowTime()
+func (g *GroupMock) CanFillAi(now int64) bool {
 	if now-g.GetStartMatchTimeSec() > 60 {
 		return true
 	}
@@ -896,6 +1120,7 @@ type RoomMock struct {
 	teams           []Team
 	StartMatchTime  int64
 	FinishMatchTime int64
+	MMRSpread       float64
 }
 
 func NewRoom(team Team) Room {
@@ -992,6 +1217,10 @@ func (r *RoomMock) SetFinishMatchTimeSec(t int64) {
 	r.FinishMatchTime = t
 }
 
+func (r *RoomMock) SetMMRSpread(spread float64) {
+	r.MMRSpread = spread
+}
+
 func (r *RoomMock) HasAi() bool {
 	for _, t := range r.teams {
 		if t.IsAi() {
@@ -1013,7 +1242,12 @@ func (t *TeamMock) IsFull(teamPlayerLimit int) bool {
 	return t.PlayerCount() >= teamPlayerLimit
 }
 
-func (t *TeamMock) IsNewer() bool {
+func (t *TeamMock) IsNewer(now int64) bool {
+	for _, g := range t.GetGroups() {
+		if g.IsNewer(now) {
+			return true
+		}
+	}
 	return false
 }
 
@@ -1058,6 +1292,12 @@ func (t *TeamMock) AddGroup(g Group) {
 	}
 }
 
+func (t *TeamMock) RemoveGroup(g Group) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.groups, g.GetID())
+}
+
 func (t *TeamMock) PlayerCount() int {
 	t.RLock()
 	defer t.RUnlock()
@@ -1149,11 +1389,11 @@ func (t *TeamMock) SortPlayerByRank() []Player {
 	return players
 }
 
-func (t *TeamMock) CanFillAi() bool {
+func (t *TeamMock) CanFillAi(now int64) bool {
 	t.RLock()
 	defer t.RUnlock()
 	for _, g := range t.groups {
-		if !g.CanFillAi() {
+		if !g.CanFillAi(now) {
 			return false
 		}
 	}
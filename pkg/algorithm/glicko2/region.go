@@ -0,0 +1,116 @@
+package glicko2
+
+import (
+	"math"
+	"sort"
+)
+
+// MergeRegionPings merges the region pings of several players or groups into one.
+// Only the regions reported by all the non-empty inputs are kept,
+// and the ping of each region is the worst (biggest) one among the inputs.
+// An empty input means no preference and is ignored.
+func MergeRegionPings(pings ...map[string]int64) map[string]int64 {
+	var res map[string]int64
+	for _, ps := range pings {
+		if len(ps) == 0 {
+			continue
+		}
+		if res == nil {
+			res = make(map[string]int64, len(ps))
+			for region, ping := range ps {
+				res[region] = ping
+			}
+			continue
+		}
+		for region, ping := range res {
+			p, ok := ps[region]
+			if !ok {
+				delete(res, region)
+				continue
+			}
+			if p > ping {
+				res[region] = p
+			}
+		}
+	}
+	return res
+}
+
+// acceptableRegions returns the regions whose ping is within maxPingMs.
+// The region with the lowest ping is always acceptable, so that a group far away from all regions would not starve.
+// nil means the group has no region preference and can be matched with any region.
+func acceptableRegions(pings map[string]int64, maxPingMs int64) map[string]struct{} {
+	if len(pings) == 0 {
+		return nil
+	}
+	res := make(map[string]struct{}, len(pings))
+	bestRegion, bestPing := "", int64(math.MaxInt64)
+	for region, ping := range pings {
+		if maxPingMs == 0 || ping <= maxPingMs {
+			res[region] = struct{}{}
+		}
+		if ping < bestPing || (ping == bestPing && region < bestRegion) {
+			bestRegion, bestPing = region, ping
+		}
+	}
+	res[bestRegion] = struct{}{}
+	return res
+}
+
+// shareRegion checks whether all the groups share at least one acceptable region.
+func shareRegion(groups []Group, maxPingMs int64) bool {
+	var common map[string]struct{}
+	for _, g := range groups {
+		regions := acceptableRegions(g.GetRegionPings(), maxPingMs)
+		if regions == nil {
+			continue
+		}
+		if common == nil {
+			common = regions
+			continue
+		}
+		for region := range common {
+			if _, ok := regions[region]; !ok {
+				delete(common, region)
+			}
+		}
+		if len(common) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ChooseRegion chooses the region for the groups to play in,
+// which is the common region with the lowest worst ping.
+// Returns empty string if none of the groups reports region pings or there is no common region.
+func ChooseRegion(groups []Group) string {
+	pings := make([]map[string]int64, len(groups))
+	for i, g := range groups {
+		pings[i] = g.GetRegionPings()
+	}
+	merged := MergeRegionPings(pings...)
+	if len(merged) == 0 {
+		return ""
+	}
+	regions := make([]string, 0, len(merged))
+	for region := range merged {
+		regions = append(regions, region)
+	}
+	sort.Slice(regions, func(i, j int) bool {
+		if merged[regions[i]] != merged[regions[j]] {
+			return merged[regions[i]] < merged[regions[j]]
+		}
+		return regions[i] < regions[j]
+	})
+	return regions[0]
+}
+
+// RoomGroups returns all the groups in the room.
+func RoomGroups(room Room) []Group {
+	groups := make([]Group, 0, len(room.GetTeams()))
+	for _, t := range room.GetTeams() {
+		groups = append(groups, t.GetGroups()...)
+	}
+	return groups
+}
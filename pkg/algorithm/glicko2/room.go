@@ -16,4 +16,7 @@ type Room interface {
 
 	// Check if the room contains an AI
 	HasAi() bool
+
+	// Set the difference between the biggest and the smallest team MMR of the room
+	SetMMRSpread(spread float64)
 }
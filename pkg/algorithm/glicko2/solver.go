@@ -0,0 +1,261 @@
+package glicko2
+
+import (
+	"math"
+	"sort"
+)
+
+// Solver is the algorithm used by the queue to form teams and rooms in each tick.
+type Solver string
+
+const (
+	// SolverGreedy takes the first queuing group and repeatedly grabs the group with the closest MMR,
+	// then does the same for teams to form rooms. It is the default solver.
+	SolverGreedy Solver = "greedy"
+
+	// SolverOptimize considers all the candidates of a tick.
+	// It seeds teams from the biggest and longest waiting groups,
+	// fills each of them with a bounded size knapsack search over the groups in the nearby MMR bucket,
+	// and then forms rooms from the MMR sorted full teams with dynamic programming,
+	// which maximizes the rooms formed and minimizes the total MMR spread.
+	// All the MatchRange constraints are respected.
+	SolverOptimize Solver = "optimize"
+)
+
+// defaultOptimizeSearchBudget is the default max search nodes of SolverOptimize
+// when looking for teammates for a group.
+const defaultOptimizeSearchBudget = 1000
+
+// buildNewTeamsOptimize builds full teams from groups by SolverOptimize,
+// groups must be sorted by MMR, and the groups not in any team are returned.
+// The queuing groups left out of the full teams are carried in TmpTeam like SolverGreedy,
+// so that they can be filled with AI.
+func (q *Queue) buildNewTeamsOptimize(groups []Group) []Group {
+	// Seed teams from the biggest groups first, they are the hardest to be placed,
+	// and the higher priority ones first among the same size.
+	now := q.nowUnixFunc()
+	order := make([]int, 0, len(groups))
+	scores := make([]float64, len(groups))
+	for i, g := range groups {
+		if g.GetState() == GroupStateQueuing {
+			order = append(order, i)
+			scores[i] = q.priority(g, now)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		gi, gj := groups[order[i]], groups[order[j]]
+		if gi.PlayerCount() != gj.PlayerCount() {
+			return gi.PlayerCount() > gj.PlayerCount()
+		}
+		return scores[order[i]] > scores[order[j]]
+	})
+
+	used := make([]bool, len(groups))
+	for _, ai := range order {
+		if used[ai] {
+			continue
+		}
+		members, ok := q.findTeammates(groups, used, ai)
+		if !ok {
+			continue
+		}
+		team := q.newTeam(groups[ai])
+		used[ai] = true
+		for _, mi := range members {
+			team.AddGroup(groups[mi])
+			used[mi] = true
+		}
+		q.FullTeam = append(q.FullTeam, team)
+	}
+	q.buildPartialTeamsOptimize(groups, used, order)
+
+	rest := make([]Group, 0, len(groups))
+	for i, g := range groups {
+		if !used[i] {
+			rest = append(rest, g)
+		}
+	}
+	return rest
+}
+
+// buildPartialTeamsOptimize puts the unused groups into partial teams in the seed order,
+// each of them takes the closest unused groups in MMR which fit in the team.
+func (q *Queue) buildPartialTeamsOptimize(groups []Group, used []bool, order []int) {
+	for _, ai := range order {
+		if used[ai] {
+			continue
+		}
+		team := q.newTeam(groups[ai])
+		used[ai] = true
+		for _, mi := range q.nearbyCandidates(groups, used, ai, q.TeamPlayerLimit-groups[ai].PlayerCount()) {
+			if team.PlayerCount()+groups[mi].PlayerCount() <= q.TeamPlayerLimit && q.canGroupTogether(team, groups[mi]) {
+				team.AddGroup(groups[mi])
+				used[mi] = true
+			}
+		}
+		q.TmpTeam = append(q.TmpTeam, team)
+	}
+}
+
+// findTeammates finds the groups which can form a full team with groups[ai],
+// the sum of the MMR differences to groups[ai] weighted by player count is minimized within the search budget.
+func (q *Queue) findTeammates(groups []Group, used []bool, ai int) ([]int, bool) {
+	anchor := groups[ai]
+	need := q.TeamPlayerLimit - anchor.PlayerCount()
+	if need < 0 {
+		return nil, false
+	}
+	if need == 0 {
+		return nil, true
+	}
+
+	budget := q.OptimizeSearchBudget
+	if budget <= 0 {
+		budget = defaultOptimizeSearchBudget
+	}
+	s := &teammateSearch{
+		q:         q,
+		groups:    groups,
+		cands:     q.nearbyCandidates(groups, used, ai, need),
+		anchorMMR: anchor.GetMMR(),
+		budget:    budget,
+		members:   []Group{anchor},
+	}
+	s.search(0, need, 0)
+	return s.best, s.best != nil
+}
+
+// nearbyCandidates returns the indexes of the unused queuing groups
+// which are the closest to groups[ai] in MMR and can form a team with it,
+// at most twice the search range would be returned, ordered by MMR difference.
+func (q *Queue) nearbyCandidates(groups []Group, used []bool, ai, need int) []int {
+	limit := q.searchRange() * 2 //nolint:mnd
+	anchor := groups[ai]
+	anchorMMR := anchor.GetMMR()
+	res := make([]int, 0, limit)
+	accept := func(i int) {
+		g := groups[i]
+		if used[i] || g.GetState() != GroupStateQueuing || g.PlayerCount() > need {
+			return
+		}
+		if q.canGroupJoin([]Group{anchor}, g) {
+			res = append(res, i)
+		}
+	}
+	for l, r := ai-1, ai+1; (l >= 0 || r < len(groups)) && len(res) < limit; {
+		if r >= len(groups) || (l >= 0 && anchorMMR-groups[l].GetMMR() <= groups[r].GetMMR()-anchorMMR) {
+			accept(l)
+			l--
+		} else {
+			accept(r)
+			r++
+		}
+	}
+	return res
+}
+
+// teammateSearch is a bounded depth-first search for a subset of candidates
+// whose player count sums up to the need of a team.
+type teammateSearch struct {
+	q         *Queue
+	groups    []Group
+	cands     []int
+	anchorMMR float64
+	budget    int
+
+	members  []Group
+	path     []int
+	best     []int
+	bestCost float64
+}
+
+func (s *teammateSearch) search(from, need int, cost float64) {
+	if need == 0 {
+		if s.best == nil || cost < s.bestCost {
+			s.best = append(s.best[:0], s.path...)
+			s.bestCost = cost
+		}
+		return
+	}
+	for i := from; i < len(s.cands) && s.budget > 0; i++ {
+		s.budget--
+		g := s.groups[s.cands[i]]
+		size := g.PlayerCount()
+		c := cost + math.Abs(g.GetMMR()-s.anchorMMR)*float64(size)
+		if size > need || (s.best != nil && c >= s.bestCost) || !s.q.canGroupJoin(s.members, g) {
+			continue
+		}
+		s.members = append(s.members, g)
+		s.path = append(s.path, s.cands[i])
+		s.search(i+1, need-size, c)
+		s.members = s.members[:len(s.members)-1]
+		s.path = s.path[:len(s.path)-1]
+	}
+}
+
+// buildNewRoomsOptimize builds rooms from FullTeam by SolverOptimize, FullTeam must be sorted by MMR.
+// Every RoomTeamLimit consecutive teams are a candidate room,
+// and the candidates are chosen to maximize the rooms formed with the lowest total MMR spread.
+func (q *Queue) buildNewRoomsOptimize() {
+	k, teams := q.RoomTeamLimit, q.FullTeam
+	n := len(teams)
+	if k <= 0 || n < k {
+		return
+	}
+
+	// rooms[i] is the max rooms formed by the first i teams, and spreads[i] is the total MMR spread of them,
+	// take[i] indicates whether the last k teams of the first i teams form a room.
+	rooms := make([]int, n+1)
+	spreads := make([]float64, n+1)
+	take := make([]bool, n+1)
+	for i := 1; i <= n; i++ {
+		rooms[i], spreads[i] = rooms[i-1], spreads[i-1]
+		if i < k || !q.canTeamsFormRoom(teams[i-k:i]) {
+			continue
+		}
+		r, s := rooms[i-k]+1, spreads[i-k]+teams[i-1].GetMMR()-teams[i-k].GetMMR()
+		if r > rooms[i] || (r == rooms[i] && s < spreads[i]) {
+			rooms[i], spreads[i], take[i] = r, s, true
+		}
+	}
+
+	rest := make([]Team, 0, n)
+	for i := n; i > 0; {
+		if !take[i] {
+			rest = append(rest, teams[i-1])
+			i--
+			continue
+		}
+		room := q.newRoom(teams[i-k])
+		for _, t := range teams[i-k+1 : i] {
+			room.AddTeam(t)
+		}
+		room.SetMMRSpread(q.balanceRoomTeams(room))
+		q.roomMatchSuccess(room)
+		i -= k
+	}
+	q.FullTeam = rest
+}
+
+// canTeamsFormRoom determines whether the teams can form a room.
+// Single group teams are put first, since a team formed by several groups
+// can always join a room with them while the reverse is limited by MatchRange.CanJoinTeam.
+func (q *Queue) canTeamsFormRoom(teams []Team) bool {
+	ordered := make([]Team, 0, len(teams))
+	for _, t := range teams {
+		if len(t.GetGroups()) == 1 {
+			ordered = append(ordered, t)
+		}
+	}
+	for _, t := range teams {
+		if len(t.GetGroups()) != 1 {
+			ordered = append(ordered, t)
+		}
+	}
+	for i := 1; i < len(ordered); i++ {
+		if !q.canTeamJoin(ordered[:i], ordered[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+package glicko2
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGroupWithSize(id, playerCount int, mmr float64) Group {
+	players := make([]*PlayerMock, playerCount)
+	for i := 0; i < playerCount; i++ {
+		players[i] = newPlayerWithMMR(cast.ToString(id*10+i), mmr)
+	}
+	g := NewGroup(cast.ToString(id), players)
+	g.SetState(GroupStateQueuing)
+	return g
+}
+
+func TestQueue_Match_SolverOptimize(t *testing.T) {
+	newGroups := func() []Group {
+		return []Group{
+			newGroupWithSize(1, 2, 100),
+			newGroupWithSize(2, 2, 101),
+			newGroupWithSize(3, 3, 102),
+			newGroupWithSize(4, 3, 103),
+		}
+	}
+
+	// 贪心匹配时，2 人组先组到一起，导致 3 人组无法组成满员阵营
+	q := newQueue()
+	q.RoomTeamLimit = 2
+	rest := q.Match(newGroups())
+	assert.Equal(t, 4, len(rest))
+
+	// 全局优化时，先为 3 人组寻找队友，可以组成 2 个满员阵营，进而组成一个房间
+	q = newQueue()
+	q.RoomTeamLimit = 2
+	q.Solver = SolverOptimize
+	rest = q.Match(newGroups())
+	assert.Equal(t, 0, len(rest))
+	room := <-q.roomChan
+	assert.Equal(t, 2, len(room.GetTeams()))
+	for _, team := range room.GetTeams() {
+		assert.Equal(t, 5, team.PlayerCount())
+		assert.Equal(t, 2, len(team.GetGroups()))
+	}
+
+	// 全局优化同样需要满足 MatchRange 的限制
+	q = newQueue()
+	q.RoomTeamLimit = 2
+	q.Solver = SolverOptimize
+	rest = q.Match([]Group{
+		newGroupWithSize(1, 2, 100),
+		newGroupWithSize(2, 2, 200),
+		newGroupWithSize(3, 3, 300),
+		newGroupWithSize(4, 3, 400),
+	})
+	assert.Equal(t, 4, len(rest))
+}
+
+func TestQueue_buildNewRoomsOptimize(t *testing.T) {
+	q := newQueue()
+	q.RoomTeamLimit = 2
+	q.Solver = SolverOptimize
+
+	// 只有满足 MatchRange 限制的相邻阵营可以组成房间，其余阵营留在 FullTeam 中
+	t1 := NewTeam(newGroupWithSize(1, 5, 100))
+	t2 := NewTeam(newGroupWithSize(2, 5, 105))
+	t3 := NewTeam(newGroupWithSize(3, 5, 150))
+	t4 := NewTeam(newGroupWithSize(4, 5, 200))
+	q.FullTeam = []Team{t1, t2, t3, t4}
+	q.buildNewRoomsOptimize()
+	assert.ElementsMatch(t, []Team{t3, t4}, q.FullTeam)
+	room := <-q.roomChan
+	assert.ElementsMatch(t, []Team{t1, t2}, room.GetTeams())
+	assert.Equal(t, 5.0, room.(*RoomMock).MMRSpread)
+
+	// 在组成房间数量相同时，选择 mmr 差值最小的组合
+	t5 := NewTeam(newGroupWithSize(5, 5, 100))
+	t6 := NewTeam(newGroupWithSize(6, 5, 108))
+	t7 := NewTeam(newGroupWithSize(7, 5, 109))
+	q.FullTeam = []Team{t5, t6, t7}
+	q.buildNewRoomsOptimize()
+	assert.Equal(t, []Team{t5}, q.FullTeam)
+	room = <-q.roomChan
+	assert.ElementsMatch(t, []Team{t6, t7}, room.GetTeams())
+}
+
+func TestQueue_searchRange(t *testing.T) {
+	q := newQueue()
+	assert.Equal(t, defaultSearchRange, q.searchRange())
+	q.SearchRange = 20
+	assert.Equal(t, 20, q.searchRange())
+}
+
+// benchmarkQueueMatch runs one tick of matching over random groups,
+// and reports the room yield and the average MMR spread of the rooms.
+func benchmarkQueueMatch(b *testing.B, solver Solver, groupCount int) {
+	r := rand.New(rand.NewSource(1))
+	var rooms, players, spread float64
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		q := newQueue()
+		q.Solver = solver
+		q.roomChan = make(chan Room, groupCount)
+		groups := make([]Group, groupCount)
+		total := 0
+		for j := range groups {
+			size := r.Intn(TeamPlayerLimit) + 1
+			groups[j] = newGroupWithSize(j+1, size, float64(1000+r.Intn(1000)))
+			total += size
+		}
+		b.StartTimer()
+
+		rest := q.Match(groups)
+
+		b.StopTimer()
+		left := 0
+		for _, g := range rest {
+			left += g.PlayerCount()
+		}
+		n := (total - left) / (q.TeamPlayerLimit * q.RoomTeamLimit)
+		for j := 0; j < n; j++ {
+			spread += (<-q.roomChan).(*RoomMock).MMRSpread
+		}
+		rooms += float64(n)
+		players += float64(total)
+		b.StartTimer()
+	}
+	b.ReportMetric(rooms/float64(b.N), "rooms/op")
+	b.ReportMetric(players/float64(b.N), "players/op")
+	if rooms > 0 {
+		b.ReportMetric(spread/rooms, "mmr_spread/room")
+	}
+}
+
+func BenchmarkQueue_Match_Greedy_100(b *testing.B)   { benchmarkQueueMatch(b, SolverGreedy, 100) }
+func BenchmarkQueue_Match_Optimize_100(b *testing.B) { benchmarkQueueMatch(b, SolverOptimize, 100) }
+func BenchmarkQueue_Match_Greedy_2000(b *testing.B)  { benchmarkQueueMatch(b, SolverGreedy, 2000) }
+func BenchmarkQueue_Match_Optimize_2000(b *testing.B) {
+	benchmarkQueueMatch(b, SolverOptimize, 2000)
+}
+
+func TestQueue_Match_SolverOptimize_fillAi(t *testing.T) {
+	q := newQueue()
+	q.RoomTeamLimit = 2
+	q.Solver = SolverOptimize
+	now := q.nowUnixFunc()
+
+	// 未能组成满员阵营的组也会进入临时阵营，等待足够久后可以和 AI 组成房间
+	g1 := newGroupWithSize(1, 2, 100)
+	g1.SetStartMatchTimeSec(now - 61)
+	g2 := newGroupWithSize(2, 2, 101)
+	g2.SetStartMatchTimeSec(now - 61)
+	g3 := newGroupWithSize(3, 2, 500)
+	g3.SetStartMatchTimeSec(now)
+	rest := q.Match([]Group{g1, g2, g3})
+	assert.Equal(t, []Group{g3}, rest)
+
+	room := <-q.roomChan
+	assert.True(t, room.HasAi())
+	var team Team
+	for _, rt := range room.GetTeams() {
+		if !rt.IsAi() {
+			team = rt
+		}
+	}
+	assert.ElementsMatch(t, []Group{g1, g2}, team.GetGroups())
+	assert.Equal(t, 0, len(q.TmpTeam))
+}
@@ -8,6 +8,9 @@ type Team interface {
 	// Add a group to the team
 	AddGroup(group Group)
 
+	// Remove a group from the team
+	RemoveGroup(group Group)
+
 	// Get the number of players in the team
 	PlayerCount() int
 
@@ -27,12 +30,12 @@ type Team interface {
 	// Check if the team is an AI team
 	IsAi() bool
 
-	// Check if the team can be filled with AI
-	CanFillAi() bool
+	// Check if the team can be filled with AI at now
+	CanFillAi(now int64) bool
 
 	// Check if the team is full
 	IsFull(teamPlayerLimit int) bool
 
-	// Check if the team is considered a newcomer
-	IsNewer() bool
+	// Check if the team is considered a newcomer at now
+	IsNewer(now int64) bool
 }
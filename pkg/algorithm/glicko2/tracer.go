@@ -0,0 +1,14 @@
+package glicko2
+
+// Tracer traces the match ticks of a matcher.
+// It is injected by Matcher.SetTracer, so that this package does not depend on any tracing library.
+type Tracer interface {
+	// StartTick is called at the start of a tick with the number of the groups to be matched,
+	// the returned func is called at the end of the tick with the rooms formed and the groups carried over.
+	StartTick(groups int) (end func(rooms, carriedOver int))
+}
+
+// nopTracer is the default tracer which traces nothing.
+type nopTracer struct{}
+
+func (nopTracer) StartTick(int) func(int, int) { return func(int, int) {} }
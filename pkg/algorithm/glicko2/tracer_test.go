@@ -0,0 +1,54 @@
+package glicko2
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type tickTrace struct {
+	groups, rooms, carriedOver int
+}
+
+type tracerMock struct {
+	sync.Mutex
+	ticks []tickTrace
+}
+
+func (m *tracerMock) StartTick(groups int) func(rooms, carriedOver int) {
+	return func(rooms, carriedOver int) {
+		m.Lock()
+		defer m.Unlock()
+		m.ticks = append(m.ticks, tickTrace{groups: groups, rooms: rooms, carriedOver: carriedOver})
+	}
+}
+
+func Test_Matcher_Tracer(t *testing.T) {
+	errChan := make(chan error, 128)
+	roomChan := make(chan Room, 128)
+	qm, _ := NewMatcher(errChan, roomChan, GetQueueArgs, NewTeam, NewRoom, NewRoomWithAi)
+	m := new(tracerMock)
+	qm.SetTracer(m)
+
+	// one more group than a room needs, it is carried over to the next tick
+	const count = TeamPlayerLimit*RoomTeamLimit + 1
+	for i := 0; i < count; i++ {
+		_ = qm.AddGroups(NewGroup(fmt.Sprintf("Group%d", i+1), []*PlayerMock{newPlayerWithMMR(fmt.Sprint(i), 1000)}))
+	}
+	go qm.Match(10 * time.Millisecond)
+	defer func() { _, _ = qm.Stop() }()
+
+	assert.Eventually(t, func() bool {
+		m.Lock()
+		defer m.Unlock()
+		return len(m.ticks) >= 2
+	}, time.Second, 10*time.Millisecond)
+
+	m.Lock()
+	defer m.Unlock()
+	assert.Equal(t, tickTrace{groups: count, rooms: 1, carriedOver: 1}, m.ticks[0])
+	assert.Equal(t, tickTrace{groups: 1, rooms: 0, carriedOver: 1}, m.ticks[1])
+}
@@ -0,0 +1,127 @@
+package glicko2
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validate checks the queue args, all the problems found are joined into the returned error.
+func (qa *QueueArgs) Validate() error {
+	var errs []error
+	if qa.TeamPlayerLimit <= 0 {
+		errs = append(errs, fmt.Errorf("team_player_limit should be positive, got %d", qa.TeamPlayerLimit))
+	}
+	if qa.RoomTeamLimit <= 0 {
+		errs = append(errs, fmt.Errorf("room_team_limit should be positive, got %d", qa.RoomTeamLimit))
+	}
+	if qa.MatchTimeoutSec < 0 {
+		errs = append(errs, fmt.Errorf("match_timeout_sec should not be negative, got %d", qa.MatchTimeoutSec))
+	}
+	for name, v := range map[string]int64{
+		"normal_team_wait_time_sec":     qa.NormalTeamWaitTimeSec,
+		"unfriendly_team_wait_time_sec": qa.UnfriendlyTeamWaitTimeSec,
+		"malicious_team_wait_time_sec":  qa.MaliciousTeamWaitTimeSec,
+		"priority_boost_after_sec":      qa.PriorityBoostAfterSec,
+		"search_range":                  int64(qa.SearchRange),
+		"optimize_search_budget":        int64(qa.OptimizeSearchBudget),
+		"balance_swap_budget":           int64(qa.BalanceSwapBudget),
+		"guaranteed_seed_count":         int64(qa.GuaranteedSeedCount),
+		"max_seed_per_tick":             int64(qa.MaxSeedPerTick),
+	} {
+		if v < 0 {
+			errs = append(errs, fmt.Errorf("%s should not be negative, got %d", name, v))
+		}
+	}
+	switch qa.Solver {
+	case "", SolverGreedy, SolverOptimize:
+	default:
+		errs = append(errs, fmt.Errorf("unknown solver %q", qa.Solver))
+	}
+
+	for i := range qa.MatchRanges {
+		mr := qa.MatchRanges[i]
+		if err := mr.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("match_ranges[%d]: %w", i, err))
+		}
+		if i > 0 && mr.MaxMatchSec <= qa.MatchRanges[i-1].MaxMatchSec {
+			errs = append(errs, fmt.Errorf("match_ranges[%d]: max_match_sec should be greater than the previous one %d, got %d",
+				i, qa.MatchRanges[i-1].MaxMatchSec, mr.MaxMatchSec))
+		}
+	}
+	if err := qa.MMRGapCurve.validate(); err != nil {
+		errs = append(errs, fmt.Errorf("mmr_gap_curve: %w", err))
+	}
+	if err := qa.StarGapCurve.validate(); err != nil {
+		errs = append(errs, fmt.Errorf("star_gap_curve: %w", err))
+	}
+	if err := qa.Newcomer.validate(); err != nil {
+		errs = append(errs, fmt.Errorf("newcomer: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+func (mr *MatchRange) validate() error {
+	var errs []error
+	if mr.MaxMatchSec <= 0 {
+		errs = append(errs, fmt.Errorf("max_match_sec should be positive, got %d", mr.MaxMatchSec))
+	}
+	if mr.MMRGapPercent < 0 || mr.MMRGapPercent > 100 {
+		errs = append(errs, fmt.Errorf("mmr_gap_percent should be in [0, 100], got %d", mr.MMRGapPercent))
+	}
+	if mr.StarGap < 0 {
+		errs = append(errs, fmt.Errorf("star_gap should not be negative, got %d", mr.StarGap))
+	}
+	if mr.MaxPingMs < 0 {
+		errs = append(errs, fmt.Errorf("max_ping_ms should not be negative, got %d", mr.MaxPingMs))
+	}
+	if mr.PremadeSizeGap < 0 {
+		errs = append(errs, fmt.Errorf("premade_size_gap should not be negative, got %d", mr.PremadeSizeGap))
+	}
+	return errors.Join(errs...)
+}
+
+func (c *ExpansionCurve) validate() error {
+	if c == nil {
+		return nil
+	}
+	var errs []error
+	switch c.Type {
+	case "", CurveStep:
+		return nil
+	case CurveLinear, CurveExponential:
+		if c.Initial < 0 || c.Rate < 0 {
+			errs = append(errs, fmt.Errorf("initial and rate should not be negative, got %v and %v", c.Initial, c.Rate))
+		}
+	case CurvePiecewise:
+		if len(c.Points) == 0 {
+			errs = append(errs, errors.New("points should not be empty"))
+		}
+		for i := 1; i < len(c.Points); i++ {
+			if c.Points[i].WaitSec <= c.Points[i-1].WaitSec {
+				errs = append(errs, fmt.Errorf("points[%d]: wait_sec should be greater than the previous one", i))
+			}
+		}
+	default:
+		errs = append(errs, fmt.Errorf("unknown type %q", c.Type))
+	}
+	if c.Max < 0 {
+		errs = append(errs, fmt.Errorf("max should not be negative, got %v", c.Max))
+	}
+	return errors.Join(errs...)
+}
+
+func (a *NewcomerArgs) validate() error {
+	if a == nil {
+		return nil
+	}
+	var errs []error
+	if a.MaxMatchCount < 0 || a.MaxAccountAgeSec < 0 || a.MinRD < 0 || a.AiFillAfterSec < 0 {
+		errs = append(errs, errors.New("max_match_count, max_account_age_sec, min_rd and ai_fill_after_sec should not be negative"))
+	}
+	switch a.GroupRule {
+	case "", NewcomerGroupAll, NewcomerGroupAny, NewcomerGroupMajority:
+	default:
+		errs = append(errs, fmt.Errorf("unknown group_rule %q", a.GroupRule))
+	}
+	return errors.Join(errs...)
+}
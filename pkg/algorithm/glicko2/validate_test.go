@@ -0,0 +1,38 @@
+package glicko2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueueArgs_Validate(t *testing.T) {
+	assert.Nil(t, GetQueueArgs().Validate())
+
+	qa := *GetQueueArgs()
+	qa.RoomTeamLimit = 0
+	qa.Solver = "unknown"
+	qa.MatchRanges = []MatchRange{{MaxMatchSec: 10, MMRGapPercent: 10}, {MaxMatchSec: 10, MMRGapPercent: 101}}
+	qa.MMRGapCurve = &ExpansionCurve{Type: CurvePiecewise}
+	qa.Newcomer = &NewcomerArgs{GroupRule: "unknown"}
+
+	err := qa.Validate()
+	assert.ErrorContains(t, err, "room_team_limit should be positive, got 0")
+	assert.ErrorContains(t, err, `unknown solver "unknown"`)
+	assert.ErrorContains(t, err, "match_ranges[1]: mmr_gap_percent should be in [0, 100], got 101")
+	assert.ErrorContains(t, err, "match_ranges[1]: max_match_sec should be greater than the previous one 10, got 10")
+	assert.ErrorContains(t, err, "mmr_gap_curve: points should not be empty")
+	assert.ErrorContains(t, err, `newcomer: unknown group_rule "unknown"`)
+}
+
+func TestQueue_refreshMatchTurn_invalidArgs(t *testing.T) {
+	q := newQueue()
+	old := q.QueueArgs
+	q.getQueueArgs = func() *QueueArgs {
+		return &QueueArgs{TeamPlayerLimit: 0, RoomTeamLimit: 2}
+	}
+	for i := 0; i < refreshTurn; i++ {
+		q.refreshMatchTurn()
+	}
+	assert.Equal(t, old, q.QueueArgs)
+}
@@ -0,0 +1,117 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidTransition is returned when a transition is not declared in the transition table.
+var ErrInvalidTransition = errors.New("invalid state transition")
+
+// TransitionError describes a rejected transition, it wraps ErrInvalidTransition.
+type TransitionError[S comparable] struct {
+	Machine string
+	From    S
+	To      S
+}
+
+func (e *TransitionError[S]) Error() string {
+	return fmt.Sprintf("%s: %s from %v to %v", e.Machine, ErrInvalidTransition, e.From, e.To)
+}
+
+func (e *TransitionError[S]) Unwrap() error {
+	return ErrInvalidTransition
+}
+
+// Hook is called when the subject transits from one state to another.
+type Hook[S comparable, T any] func(ctx context.Context, subject T, from, to S)
+
+// Machine is a finite state machine with a declared transition table.
+// The state is held by the subject, and read and written by the getter and setter of the machine.
+//
+// The transition table and hooks should be set up before the machine is used,
+// they are not safe to be modified concurrently with Transit.
+type Machine[S comparable, T any] struct {
+	name string
+	get  func(T) S
+	set  func(T, S)
+
+	transitions map[S]map[S]struct{}
+	onExit      map[S][]Hook[S, T]
+	onEnter     map[S][]Hook[S, T]
+	onTransit   []Hook[S, T]
+}
+
+// New creates a state machine named name, get and set are used to access the state of the subjects.
+func New[S comparable, T any](name string, get func(T) S, set func(T, S)) *Machine[S, T] {
+	return &Machine[S, T]{
+		name:        name,
+		get:         get,
+		set:         set,
+		transitions: make(map[S]map[S]struct{}),
+		onExit:      make(map[S][]Hook[S, T]),
+		onEnter:     make(map[S][]Hook[S, T]),
+	}
+}
+
+// Permit declares the transitions from the state to each of the target states.
+func (m *Machine[S, T]) Permit(from S, tos ...S) *Machine[S, T] {
+	if m.transitions[from] == nil {
+		m.transitions[from] = make(map[S]struct{}, len(tos))
+	}
+	for _, to := range tos {
+		m.transitions[from][to] = struct{}{}
+	}
+	return m
+}
+
+// OnExit registers a hook called when the subject leaves the state.
+func (m *Machine[S, T]) OnExit(s S, h Hook[S, T]) *Machine[S, T] {
+	m.onExit[s] = append(m.onExit[s], h)
+	return m
+}
+
+// OnEnter registers a hook called when the subject enters the state.
+func (m *Machine[S, T]) OnEnter(s S, h Hook[S, T]) *Machine[S, T] {
+	m.onEnter[s] = append(m.onEnter[s], h)
+	return m
+}
+
+// OnTransit registers a hook called on every transition.
+func (m *Machine[S, T]) OnTransit(h Hook[S, T]) *Machine[S, T] {
+	m.onTransit = append(m.onTransit, h)
+	return m
+}
+
+// Can checks whether the transition is declared.
+func (m *Machine[S, T]) Can(from, to S) bool {
+	_, ok := m.transitions[from][to]
+	return ok
+}
+
+// Transit moves the subject to the state.
+// Staying in the current state is a no-op, and an undeclared transition is rejected with a *TransitionError.
+// The exit hooks of the current state are called before the state is set,
+// and then the enter hooks of the new state and the transit hooks.
+func (m *Machine[S, T]) Transit(ctx context.Context, subject T, to S) error {
+	from := m.get(subject)
+	if from == to {
+		return nil
+	}
+	if !m.Can(from, to) {
+		return &TransitionError[S]{Machine: m.name, From: from, To: to}
+	}
+
+	for _, h := range m.onExit[from] {
+		h(ctx, subject, from, to)
+	}
+	m.set(subject, to)
+	for _, h := range m.onEnter[to] {
+		h(ctx, subject, from, to)
+	}
+	for _, h := range m.onTransit {
+		h(ctx, subject, from, to)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type door struct {
+	state string
+}
+
+func newDoorMachine() *Machine[string, *door] {
+	return New("door",
+		func(d *door) string { return d.state },
+		func(d *door, s string) { d.state = s },
+	).
+		Permit("closed", "open", "locked").
+		Permit("open", "closed").
+		Permit("locked", "closed")
+}
+
+func TestMachine_Transit(t *testing.T) {
+	m := newDoorMachine()
+	var events []string
+	m.OnExit("closed", func(_ context.Context, d *door, from, to string) {
+		assert.Equal(t, "closed", d.state)
+		events = append(events, "exit "+from)
+	})
+	m.OnEnter("open", func(_ context.Context, d *door, from, to string) {
+		assert.Equal(t, "open", d.state)
+		events = append(events, "enter "+to)
+	})
+	m.OnTransit(func(_ context.Context, _ *door, from, to string) {
+		events = append(events, from+"->"+to)
+	})
+
+	d := &door{state: "closed"}
+	assert.Nil(t, m.Transit(context.Background(), d, "open"))
+	assert.Equal(t, "open", d.state)
+	assert.Equal(t, []string{"exit closed", "enter open", "closed->open"}, events)
+
+	// staying in the same state calls no hooks
+	events = nil
+	assert.Nil(t, m.Transit(context.Background(), d, "open"))
+	assert.Equal(t, 0, len(events))
+
+	// undeclared transition is rejected and the state is kept
+	err := m.Transit(context.Background(), d, "locked")
+	assert.True(t, errors.Is(err, ErrInvalidTransition))
+	assert.Equal(t, "door: invalid state transition from open to locked", err.Error())
+	assert.Equal(t, "open", d.state)
+	assert.Equal(t, 0, len(events))
+}
+
+func TestMachine_Can(t *testing.T) {
+	m := newDoorMachine()
+	assert.True(t, m.Can("closed", "locked"))
+	assert.True(t, m.Can("locked", "closed"))
+	assert.False(t, m.Can("open", "locked"))
+	assert.False(t, m.Can("unknown", "open"))
+}
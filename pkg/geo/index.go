@@ -0,0 +1,178 @@
+// Package geo provides a coarse grid index to find the nearby points.
+package geo
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+const (
+	earthRadiusKm = 6371.0
+	kmPerDegree   = 111.32
+
+	// DefaultCellDegree is the default cell size of the grid, about 11km at the equator.
+	DefaultCellDegree = 0.1
+)
+
+// Point is a position on the earth in degrees.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Valid checks whether the point is in the range of latitude and longitude.
+func (p Point) Valid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lng >= -180 && p.Lng <= 180
+}
+
+// DistanceKm returns the great-circle distance between the two points by haversine formula.
+func DistanceKm(a, b Point) float64 {
+	lat1, lat2 := a.Lat*math.Pi/180, b.Lat*math.Pi/180
+	dLat, dLng := lat2-lat1, (b.Lng-a.Lng)*math.Pi/180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Neighbor is a point found by Index.Nearby.
+type Neighbor struct {
+	ID           string
+	Point        Point
+	DistanceKm   float64
+	UpdatedAtSec int64
+}
+
+type cell struct {
+	x, y int64
+}
+
+type entry struct {
+	point        Point
+	cell         cell
+	updatedAtSec int64
+}
+
+// Index is a concurrent safe grid index of the points,
+// the points are bucketed into cells of cellDegree size,
+// and only the cells covering the radius are scanned when looking for the nearby points.
+type Index struct {
+	sync.RWMutex
+	cellDegree float64
+	entries    map[string]entry
+	cells      map[cell]map[string]struct{}
+}
+
+// NewIndex creates an index with the cell size in degrees,
+// DefaultCellDegree is used if cellDegree is not positive.
+func NewIndex(cellDegree float64) *Index {
+	if cellDegree <= 0 {
+		cellDegree = DefaultCellDegree
+	}
+	return &Index{
+		cellDegree: cellDegree,
+		entries:    make(map[string]entry),
+		cells:      make(map[cell]map[string]struct{}),
+	}
+}
+
+func (idx *Index) cellOf(p Point) cell {
+	return cell{x: int64(math.Floor(p.Lat / idx.cellDegree)), y: idx.wrapY(int64(math.Floor(p.Lng / idx.cellDegree)))}
+}
+
+// lngCells returns the number of cells around a latitude circle.
+func (idx *Index) lngCells() int64 {
+	return int64(math.Ceil(360 / idx.cellDegree))
+}
+
+// wrapY wraps the longitude cell into the cells of [-180, 180),
+// so the cells across the antimeridian are neighbors.
+func (idx *Index) wrapY(y int64) int64 {
+	minY, n := int64(math.Floor(-180/idx.cellDegree)), idx.lngCells()
+	return ((y-minY)%n+n)%n + minY
+}
+
+// Update sets the point of id, the old one is replaced.
+func (idx *Index) Update(id string, p Point, nowSec int64) {
+	idx.Lock()
+	defer idx.Unlock()
+
+	idx.remove(id)
+	c := idx.cellOf(p)
+	idx.entries[id] = entry{point: p, cell: c, updatedAtSec: nowSec}
+	if idx.cells[c] == nil {
+		idx.cells[c] = make(map[string]struct{})
+	}
+	idx.cells[c][id] = struct{}{}
+}
+
+// Remove removes the point of id.
+func (idx *Index) Remove(id string) {
+	idx.Lock()
+	defer idx.Unlock()
+	idx.remove(id)
+}
+
+func (idx *Index) remove(id string) {
+	e, ok := idx.entries[id]
+	if !ok {
+		return
+	}
+	delete(idx.entries, id)
+	delete(idx.cells[e.cell], id)
+	if len(idx.cells[e.cell]) == 0 {
+		delete(idx.cells, e.cell)
+	}
+}
+
+// Get returns the point of id and when it was updated.
+func (idx *Index) Get(id string) (Point, int64, bool) {
+	idx.RLock()
+	defer idx.RUnlock()
+	e, ok := idx.entries[id]
+	return e.point, e.updatedAtSec, ok
+}
+
+// Nearby returns at most limit points within radiusKm from p ordered by distance,
+// the points rejected by filter are skipped, nil filter accepts all, and non-positive limit means no limit.
+func (idx *Index) Nearby(p Point, radiusKm float64, limit int, filter func(n Neighbor) bool) []Neighbor {
+	idx.RLock()
+	defer idx.RUnlock()
+
+	center := idx.cellOf(p)
+	spanX := int64(math.Ceil(radiusKm / (kmPerDegree * idx.cellDegree)))
+	// the longitude cells are wrapped, no need to scan more cells than the whole circle
+	n := idx.lngCells()
+	fromY, toY := center.y, center.y+n-1
+	if cos := math.Cos(p.Lat * math.Pi / 180); cos > 0 {
+		if spanY := math.Ceil(radiusKm / (kmPerDegree * cos * idx.cellDegree)); 2*spanY+1 < float64(n) {
+			fromY, toY = center.y-int64(spanY), center.y+int64(spanY)
+		}
+	}
+
+	var res []Neighbor
+	fromX := max(center.x-spanX, int64(math.Floor(-90/idx.cellDegree)))
+	toX := min(center.x+spanX, int64(math.Floor(90/idx.cellDegree)))
+	for x := fromX; x <= toX; x++ {
+		for y := fromY; y <= toY; y++ {
+			for id := range idx.cells[cell{x: x, y: idx.wrapY(y)}] {
+				e := idx.entries[id]
+				n := Neighbor{ID: id, Point: e.point, DistanceKm: DistanceKm(p, e.point), UpdatedAtSec: e.updatedAtSec}
+				if n.DistanceKm > radiusKm || (filter != nil && !filter(n)) {
+					continue
+				}
+				res = append(res, n)
+			}
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].DistanceKm != res[j].DistanceKm {
+			return res[i].DistanceKm < res[j].DistanceKm
+		}
+		return res[i].ID < res[j].ID
+	})
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res
+}
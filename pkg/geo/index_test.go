@@ -0,0 +1,80 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistanceKm(t *testing.T) {
+	shanghai := Point{Lat: 31.2304, Lng: 121.4737}
+	beijing := Point{Lat: 39.9042, Lng: 116.4074}
+	assert.InDelta(t, 1067, DistanceKm(shanghai, beijing), 5)
+	assert.Equal(t, 0.0, DistanceKm(shanghai, shanghai))
+}
+
+func TestPoint_Valid(t *testing.T) {
+	assert.True(t, Point{Lat: 90, Lng: -180}.Valid())
+	assert.False(t, Point{Lat: 91, Lng: 0}.Valid())
+	assert.False(t, Point{Lat: 0, Lng: 181}.Valid())
+}
+
+func TestIndex_Nearby(t *testing.T) {
+	idx := NewIndex(0)
+	center := Point{Lat: 31.2304, Lng: 121.4737}
+	idx.Update("a", Point{Lat: 31.2310, Lng: 121.4740}, 1)
+	idx.Update("b", Point{Lat: 31.3000, Lng: 121.5000}, 2) // about 8.1km, in another cell
+	idx.Update("c", Point{Lat: 39.9042, Lng: 116.4074}, 3) // beijing
+	idx.Update("d", Point{Lat: 31.2000, Lng: 121.4000}, 4) // about 7.8km
+
+	res := idx.Nearby(center, 10, 0, nil)
+	ids := make([]string, 0, len(res))
+	for _, n := range res {
+		ids = append(ids, n.ID)
+	}
+	assert.Equal(t, []string{"a", "d", "b"}, ids)
+	assert.Equal(t, int64(1), res[0].UpdatedAtSec)
+
+	// limit and filter
+	res = idx.Nearby(center, 10, 1, func(n Neighbor) bool { return n.ID != "a" })
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "d", res[0].ID)
+
+	// update moves the point to another cell
+	idx.Update("c", Point{Lat: 31.2305, Lng: 121.4738}, 5)
+	assert.Equal(t, "c", idx.Nearby(center, 1, 1, nil)[0].ID)
+	assert.Equal(t, 4, len(idx.Nearby(center, 10, 0, nil)))
+
+	idx.Remove("c")
+	idx.Remove("not exists")
+	_, _, ok := idx.Get("c")
+	assert.False(t, ok)
+	assert.Equal(t, 3, len(idx.Nearby(center, 10, 0, nil)))
+	assert.Equal(t, 3, len(idx.entries))
+}
+
+func TestIndex_Nearby_antimeridian(t *testing.T) {
+	idx := NewIndex(0)
+	idx.Update("east", Point{Lat: 0, Lng: 179.99}, 1)
+	idx.Update("west", Point{Lat: 0, Lng: -179.99}, 2)
+	idx.Update("edge", Point{Lat: 0, Lng: 180}, 3)
+
+	// the points across the antimeridian are found from both sides
+	res := idx.Nearby(Point{Lat: 0, Lng: 179.95}, 10, 0, nil)
+	assert.Equal(t, 3, len(res))
+	assert.Equal(t, "east", res[0].ID)
+	res = idx.Nearby(Point{Lat: 0, Lng: -179.95}, 10, 0, nil)
+	assert.Equal(t, 3, len(res))
+	assert.Equal(t, "west", res[0].ID)
+
+	// the whole circle is scanned only once near the pole
+	idx.Update("pole", Point{Lat: 89.99, Lng: 179}, 4)
+	res = idx.Nearby(Point{Lat: 89.99, Lng: -1}, 10, 0, nil)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "pole", res[0].ID)
+
+	point, updatedAt, ok := idx.Get("edge")
+	assert.True(t, ok)
+	assert.Equal(t, Point{Lat: 0, Lng: 180}, point)
+	assert.Equal(t, int64(3), updatedAt)
+}
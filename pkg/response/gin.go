@@ -27,6 +27,17 @@ func GinError(c *gin.Context, err error) {
 	})
 }
 
+// GinAbort aborts the request with the http status code, e.g. rejected by the middlewares.
+func GinAbort(c *gin.Context, code int, err error) {
+	c.AbortWithStatusJSON(code, HTTPResponse{
+		RequestID: c.GetHeader(XRequestID),
+		TraceID:   c.GetString(TraceIDKey),
+		Code:      code,
+		Message:   err.Error(),
+		Data:      nil,
+	})
+}
+
 func GinSuccess(c *gin.Context, data any) {
 	c.JSON(http.StatusOK, HTTPResponse{
 		RequestID: c.GetHeader(XRequestID),
@@ -5,4 +5,8 @@ type IMessage interface {
 	GetMsgID() uint32
 	GetData() []byte
 	SetData([]byte)
+
+	// GetMeta returns the metadata carried along with the data, such as the trace context.
+	GetMeta() map[string]string
+	SetMeta(map[string]string)
 }
@@ -1,7 +1,16 @@
 package ziface
 
+import "context"
+
 type IRequest interface {
 	GetConnection() IConnection
 	GetData() []byte
 	GetMsgID() uint32
+
+	// GetMeta returns the metadata of the request message.
+	GetMeta() map[string]string
+
+	// Context returns the context of the request, it is context.Background() unless SetContext is called.
+	Context() context.Context
+	SetContext(ctx context.Context)
 }
@@ -146,7 +146,10 @@ func (c *Connection) startReader(ctx context.Context) {
 					return
 				}
 			}
-			msg.SetData(data)
+			if err := dp.UnpackBody(msg, data); err != nil {
+				fmt.Println("unpack body error ", err)
+				return
+			}
 
 			// handle request
 			req := Request{conn: c, msg: msg}
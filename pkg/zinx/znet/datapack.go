@@ -3,7 +3,11 @@ package znet
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"sort"
 
 	"github.com/hedon954/go-matcher/pkg/zinx/zconfig"
 	"github.com/hedon954/go-matcher/pkg/zinx/ziface"
@@ -14,6 +18,16 @@ const (
 	dataLen = 4
 )
 
+// metaFlag is set in the msg id of the head if the body starts with the metadata,
+// the messages without metadata are packed as before so that the old clients are not affected.
+//
+// The metadata is packed as:
+//
+//	| count(uint16) | key len(uint16) | key | value len(uint16) | value | ... |
+const metaFlag uint32 = 1 << 31
+
+var errInvalidMeta = errors.New("invalid msg metadata")
+
 type DataPack struct {
 	config *zconfig.ZConfig
 }
@@ -27,17 +41,27 @@ func (dp *DataPack) GetHeadLen() uint32 {
 }
 
 func (dp *DataPack) Pack(msg ziface.IMessage) ([]byte, error) {
+	body := bytes.NewBuffer([]byte{})
+	id := msg.GetMsgID()
+	if len(msg.GetMeta()) > 0 {
+		if err := packMeta(body, msg.GetMeta()); err != nil {
+			return nil, fmt.Errorf("write msg meta occurs error %w", err)
+		}
+		id |= metaFlag
+	}
+	body.Write(msg.GetData())
+
 	dataBuff := bytes.NewBuffer([]byte{})
 
-	if err := binary.Write(dataBuff, binary.LittleEndian, msg.GetDataLen()); err != nil {
+	if err := binary.Write(dataBuff, binary.LittleEndian, uint32(body.Len())); err != nil {
 		return nil, fmt.Errorf("write data len occurs error %w", err)
 	}
 
-	if err := binary.Write(dataBuff, binary.LittleEndian, msg.GetMsgID()); err != nil {
+	if err := binary.Write(dataBuff, binary.LittleEndian, id); err != nil {
 		return nil, fmt.Errorf("write msg id occurs error %w", err)
 	}
 
-	if err := binary.Write(dataBuff, binary.LittleEndian, msg.GetData()); err != nil {
+	if err := binary.Write(dataBuff, binary.LittleEndian, body.Bytes()); err != nil {
 		return nil, fmt.Errorf("write msg data occurs error %w", err)
 	}
 
@@ -55,6 +79,8 @@ func (dp *DataPack) Unpack(data []byte) (ziface.IMessage, error) {
 	if err := binary.Read(dataBuff, binary.LittleEndian, &msg.ID); err != nil {
 		return nil, fmt.Errorf("read msg id occurs error %w", err)
 	}
+	msg.withMeta = msg.ID&metaFlag != 0
+	msg.ID &^= metaFlag
 
 	if dp.config.MaxPacketSize > 0 && msg.DataLen > dp.config.MaxPacketSize {
 		return nil, fmt.Errorf("too large msg data len %d, limit %d", msg.DataLen, dp.config.MaxPacketSize)
@@ -64,3 +90,78 @@ func (dp *DataPack) Unpack(data []byte) (ziface.IMessage, error) {
 
 	return msg, nil
 }
+
+// UnpackBody fills the msg unpacked from the head with the body read according to its data len,
+// the metadata is split from the body if the head is flagged with it.
+func (dp *DataPack) UnpackBody(msg ziface.IMessage, body []byte) error {
+	m, ok := msg.(*Message)
+	if !ok || !m.withMeta {
+		msg.SetData(body)
+		return nil
+	}
+
+	buff := bytes.NewBuffer(body)
+	meta, err := unpackMeta(buff)
+	if err != nil {
+		return fmt.Errorf("read msg meta occurs error %w", err)
+	}
+	m.SetMeta(meta)
+	m.SetData(buff.Bytes())
+	return nil
+}
+
+func packMeta(buff *bytes.Buffer, meta map[string]string) error {
+	if len(meta) > math.MaxUint16 {
+		return fmt.Errorf("%w: too many entries %d", errInvalidMeta, len(meta))
+	}
+	keys := make([]string, 0, len(meta))
+	for k := range meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	_ = binary.Write(buff, binary.LittleEndian, uint16(len(keys)))
+	for _, k := range keys {
+		for _, s := range []string{k, meta[k]} {
+			if len(s) > math.MaxUint16 {
+				return fmt.Errorf("%w: too long entry of key %q", errInvalidMeta, k)
+			}
+			_ = binary.Write(buff, binary.LittleEndian, uint16(len(s)))
+			buff.WriteString(s)
+		}
+	}
+	return nil
+}
+
+func unpackMeta(buff *bytes.Buffer) (map[string]string, error) {
+	var count uint16
+	if err := binary.Read(buff, binary.LittleEndian, &count); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidMeta, err)
+	}
+
+	readString := func() (string, error) {
+		var l uint16
+		if err := binary.Read(buff, binary.LittleEndian, &l); err != nil {
+			return "", err
+		}
+		s := make([]byte, l)
+		if _, err := io.ReadFull(buff, s); err != nil {
+			return "", err
+		}
+		return string(s), nil
+	}
+
+	meta := make(map[string]string, count)
+	for i := 0; i < int(count); i++ {
+		k, err := readString()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidMeta, err)
+		}
+		v, err := readString()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errInvalidMeta, err)
+		}
+		meta[k] = v
+	}
+	return meta, nil
+}
@@ -0,0 +1,70 @@
+package znet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hedon954/go-matcher/pkg/zinx/zconfig"
+)
+
+func TestDataPack(t *testing.T) {
+	dp := NewDataPack(zconfig.DefaultConfig)
+
+	unpack := func(bs []byte) (*Message, error) {
+		head, err := dp.Unpack(bs[:dp.GetHeadLen()])
+		if err != nil {
+			return nil, err
+		}
+		assert.Equal(t, int(head.GetDataLen()), len(bs)-int(dp.GetHeadLen()))
+		if err := dp.UnpackBody(head, bs[dp.GetHeadLen():]); err != nil {
+			return nil, err
+		}
+		return head.(*Message), nil
+	}
+
+	t.Run("msg without meta should keep the old format", func(t *testing.T) {
+		bs, err := dp.Pack(NewMsgPackage(1, []byte("hello")))
+		assert.Nil(t, err)
+		assert.Equal(t, int(dp.GetHeadLen())+len("hello"), len(bs))
+
+		msg, err := unpack(bs)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(1), msg.GetMsgID())
+		assert.Equal(t, []byte("hello"), msg.GetData())
+		assert.Nil(t, msg.GetMeta())
+	})
+
+	t.Run("msg with meta should carry it along with the data", func(t *testing.T) {
+		meta := map[string]string{"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01", "k": ""}
+		bs, err := dp.Pack(NewMsgPackageWithMeta(2, []byte("hello"), meta))
+		assert.Nil(t, err)
+
+		msg, err := unpack(bs)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(2), msg.GetMsgID())
+		assert.Equal(t, []byte("hello"), msg.GetData())
+		assert.Equal(t, meta, msg.GetMeta())
+	})
+
+	t.Run("msg with meta and empty data should work", func(t *testing.T) {
+		bs, err := dp.Pack(NewMsgPackageWithMeta(3, nil, map[string]string{"a": "b"}))
+		assert.Nil(t, err)
+
+		msg, err := unpack(bs)
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(3), msg.GetMsgID())
+		assert.Empty(t, msg.GetData())
+		assert.Equal(t, map[string]string{"a": "b"}, msg.GetMeta())
+	})
+
+	t.Run("truncated meta should return error", func(t *testing.T) {
+		bs, err := dp.Pack(NewMsgPackageWithMeta(4, nil, map[string]string{"key": "value"}))
+		assert.Nil(t, err)
+
+		head, err := dp.Unpack(bs[:dp.GetHeadLen()])
+		assert.Nil(t, err)
+		err = dp.UnpackBody(head, bs[dp.GetHeadLen():len(bs)-2])
+		assert.ErrorIs(t, err, errInvalidMeta)
+	})
+}
@@ -4,6 +4,10 @@ type Message struct {
 	ID      uint32
 	DataLen uint32
 	Data    []byte
+	Meta    map[string]string
+
+	// withMeta indicates the body unpacked from the head starts with the metadata.
+	withMeta bool
 }
 
 func NewMsgPackage(id uint32, data []byte) *Message {
@@ -14,6 +18,13 @@ func NewMsgPackage(id uint32, data []byte) *Message {
 	}
 }
 
+// NewMsgPackageWithMeta creates a message carrying the metadata along with the data.
+func NewMsgPackageWithMeta(id uint32, data []byte, meta map[string]string) *Message {
+	msg := NewMsgPackage(id, data)
+	msg.Meta = meta
+	return msg
+}
+
 func (m *Message) GetDataLen() uint32 {
 	return m.DataLen
 }
@@ -29,3 +40,11 @@ func (m *Message) GetData() []byte {
 func (m *Message) SetData(data []byte) {
 	m.Data = data
 }
+
+func (m *Message) GetMeta() map[string]string {
+	return m.Meta
+}
+
+func (m *Message) SetMeta(meta map[string]string) {
+	m.Meta = meta
+}
@@ -1,10 +1,15 @@
 package znet
 
-import "github.com/hedon954/go-matcher/pkg/zinx/ziface"
+import (
+	"context"
+
+	"github.com/hedon954/go-matcher/pkg/zinx/ziface"
+)
 
 type Request struct {
 	conn ziface.IConnection
 	msg  ziface.IMessage
+	ctx  context.Context
 }
 
 func (r *Request) GetConnection() ziface.IConnection {
@@ -18,3 +23,18 @@ func (r *Request) GetData() []byte {
 func (r *Request) GetMsgID() uint32 {
 	return r.msg.GetMsgID()
 }
+
+func (r *Request) GetMeta() map[string]string {
+	return r.msg.GetMeta()
+}
+
+func (r *Request) Context() context.Context {
+	if r.ctx == nil {
+		return context.Background()
+	}
+	return r.ctx
+}
+
+func (r *Request) SetContext(ctx context.Context) {
+	r.ctx = ctx
+}